@@ -19,6 +19,9 @@ contract Bridge is IBridge, Ownable {
     using TMSignature for TMSignature.Data;
     using SafeMath for uint256;
 
+    /// The max number of data verifications in one call of relayAndMultiVerify.
+    uint256 public constant MAX_MULTI_VERIFY_COUNT = 16;
+
     /// Mapping from block height to the hash of "zoracle" iAVL Merkle tree.
     mapping(uint256 => bytes32) public oracleStates;
     /// Mapping from an address to its voting power.
@@ -152,6 +155,54 @@ contract Bridge is IBridge, Ownable {
         return (_requestPacket, _responsePacket);
     }
 
+    /// Verifies that the given key and value is an entry of the oracle store on BandChain as of the
+    /// given block height, such as a data source or an oracle script. Those can be edited later, so
+    /// to refer to the exact code, verify the entry of one of their versions, which never changes.
+    /// @param _blockHeight The block height. Someone must already relay this block.
+    /// @param _key The key of the entry in the oracle store.
+    /// @param _value The value of the entry, as stored on BandChain.
+    /// @param _version Lastest block height that the data node was updated.
+    /// @param _merklePaths Merkle proof that shows how the data leave is part of the oracle iAVL.
+    function verifyOracleStoreValue(
+        uint256 _blockHeight,
+        bytes memory _key,
+        bytes memory _value,
+        uint256 _version,
+        IAVLMerklePath.Data[] memory _merklePaths
+    ) public view returns (bytes memory) {
+        bytes32 oracleStateRoot = oracleStates[_blockHeight];
+        require(
+            oracleStateRoot != bytes32(uint256(0)),
+            "NO_ORACLE_ROOT_STATE_DATA"
+        );
+        require(_key.length < 128, "KEY_TOO_LONG");
+        // Computes the hash of leaf node for iAVL oracle tree.
+        bytes32 currentMerkleHash = sha256(
+            abi.encodePacked(
+                uint8(0), // Height of tree (only leaf node) is 0 (signed-varint encode)
+                uint8(2), // Size of subtree is 1 (signed-varint encode)
+                Utils.encodeVarintSigned(_version),
+                uint8(_key.length), // Size of the key (less than 128, so 1-byte varint)
+                _key,
+                uint8(32), // Size of data hash
+                sha256(_value)
+            )
+        );
+        // Goes step-by-step computing hash of parent nodes until reaching root node.
+        for (uint256 idx = 0; idx < _merklePaths.length; ++idx) {
+            currentMerkleHash = _merklePaths[idx].getParentHash(
+                currentMerkleHash
+            );
+        }
+        // Verifies that the computed Merkle root matches what currently exists.
+        require(
+            currentMerkleHash == oracleStateRoot,
+            "INVALID_ORACLE_DATA_PROOF"
+        );
+
+        return _value;
+    }
+
     /// Performs oracle state relay and oracle data verification in one go. The caller submits
     /// the encoded proof and receives back the decoded data, ready to be validated and used.
     /// @param _data The encoded data for oracle state relay and data verification.
@@ -173,4 +224,78 @@ contract Bridge is IBridge, Ownable {
         require(verifyOk, "VERIFY_ORACLE_DATA_FAILED");
         return abi.decode(verifyResult, (RequestPacket, ResponsePacket));
     }
+
+    /// Performs oracle state relay and oracle store entry verification in one go. The caller
+    /// submits the encoded proof and receives back the value of the entry, ready to be decoded.
+    /// @param _data The encoded data for oracle state relay and store entry verification.
+    function relayAndVerifyStoreValue(bytes calldata _data)
+        external
+        returns (bytes memory)
+    {
+        (bytes memory relayData, bytes memory verifyData) = abi.decode(
+            _data,
+            (bytes, bytes)
+        );
+        (bool relayOk, ) = address(this).call(
+            abi.encodePacked(this.relayOracleState.selector, relayData)
+        );
+        require(relayOk, "RELAY_ORACLE_STATE_FAILED");
+        (bool verifyOk, bytes memory verifyResult) = address(this).staticcall(
+            abi.encodePacked(this.verifyOracleStoreValue.selector, verifyData)
+        );
+        require(verifyOk, "VERIFY_ORACLE_STORE_VALUE_FAILED");
+        return abi.decode(verifyResult, (bytes));
+    }
+
+    /// Performs oracle state relay and many times of oracle data verification in one go. The caller
+    /// submits the encoded proof and receives back the decoded data, ready to be validated and used.
+    /// All data must be verified against the relayed block, and there can be at most
+    /// MAX_MULTI_VERIFY_COUNT of them.
+    /// @param _data The encoded data for oracle state relay and an array of data verification.
+    function relayAndMultiVerify(bytes calldata _data)
+        external
+        returns (RequestPacket[] memory, ResponsePacket[] memory)
+    {
+        (bytes memory relayData, bytes[] memory manyVerifyData) = abi.decode(
+            _data,
+            (bytes, bytes[])
+        );
+        require(
+            manyVerifyData.length > 0 &&
+                manyVerifyData.length <= MAX_MULTI_VERIFY_COUNT,
+            "INVALID_VERIFY_DATA_COUNT"
+        );
+        // The block height is the first argument of both relay and verification data.
+        uint256 blockHeight = abi.decode(relayData, (uint256));
+        (bool relayOk, ) = address(this).call(
+            abi.encodePacked(this.relayOracleState.selector, relayData)
+        );
+        require(relayOk, "RELAY_ORACLE_STATE_FAILED");
+
+        RequestPacket[] memory requests = new RequestPacket[](
+            manyVerifyData.length
+        );
+        ResponsePacket[] memory responses = new ResponsePacket[](
+            manyVerifyData.length
+        );
+        for (uint256 i = 0; i < manyVerifyData.length; i++) {
+            require(
+                abi.decode(manyVerifyData[i], (uint256)) == blockHeight,
+                "BLOCK_HEIGHT_MISMATCH"
+            );
+            (bool verifyOk, bytes memory verifyResult) = address(this)
+                .staticcall(
+                abi.encodePacked(
+                    this.verifyOracleData.selector,
+                    manyVerifyData[i]
+                )
+            );
+            require(verifyOk, "VERIFY_ORACLE_DATA_FAILED");
+            (requests[i], responses[i]) = abi.decode(
+                verifyResult,
+                (RequestPacket, ResponsePacket)
+            );
+        }
+        return (requests, responses);
+    }
 }
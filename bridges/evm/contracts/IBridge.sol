@@ -29,4 +29,18 @@ interface IBridge {
     function relayAndVerify(bytes calldata _data)
         external
         returns (RequestPacket memory, ResponsePacket memory);
+
+    /// Performs oracle state relay and oracle store entry verification in one go. The caller
+    /// submits the encoded proof and receives back the value of the entry, ready to be decoded.
+    /// @param _data The encoded data for oracle state relay and store entry verification.
+    function relayAndVerifyStoreValue(bytes calldata _data)
+        external
+        returns (bytes memory);
+
+    /// Performs oracle state relay and many times of oracle data verification in one go. The caller
+    /// submits the encoded proof and receives back the decoded data, ready to be validated and used.
+    /// @param _data The encoded data for oracle state relay and an array of data verification.
+    function relayAndMultiVerify(bytes calldata _data)
+        external
+        returns (RequestPacket[] memory, ResponsePacket[] memory);
 }
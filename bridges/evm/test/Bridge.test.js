@@ -1,9 +1,90 @@
+const crypto = require("crypto");
 const { expectRevert } = require("openzeppelin-test-helpers");
 const Bridge = artifacts.require("BridgeMock");
 const ReceiverMock = artifacts.require("ReceiverMock");
+const PacketsMock = artifacts.require("PacketsMock");
 
 require("chai").should();
 
+// Encoded relay and verify data of a request result at block 446.
+const RELAY_AND_VERIFY_446 =
+  "0x0000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000064000000000000000000000000000000000000000000000000000000000000005E000000000000000000000000000000000000000000000000000000000000001BEB0CC616F4E76EBA2C1845FD2321AD881991A2990F17CD278EB5F6D17DA032FAF9459616C154A23567A7281FB577BBFA1AA8D36382C4F64362968202FD32A0B65CBADB1694A5152C2B03F4960E1229745B39D7C41B32DC54E0C207799AE471981B1F2FD852E790E735CA2D3014F96A2A53C60393E9C6BBF941B9A6DD6A05CF6F93D913423122F2237A9A046D7EBFD13C3A6F1DF93A1517A9F0B60A492DBC369D832FA694879095840619F5E49380612BD296FF7E950EAFB66FF654D99CA70869EEEA5D164B32B494765EB256279014366747DB8941C23F23B2FB09A9BBBF721762C4FD636ADF805490129912F7FC18A2766AABD9FD4807F2FF18ED4CCD23F2583004209A161040AB1778E2F2C00EE482F205B28EFBA439FCB04EA283F619478D96E340B9CFFB37A989CA544E6BB780A2C78901D3FB33738768511A30617AFA01D7F4BE7E5A1EB872AD44103360DDC190410331280C42A54D829A5D752C796685D00000000000000000000000000000000000000000000000000000000000001A00000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000001A000000000000000000000000000000000000000000000000000000000000002E0B0EFF36D1214C35F785D82A7947589DBA3F7D6AE35DCB92B1B3ACA1DF441E1E77BE19DD7F54C0AB0726CC5074FE65A887C2F2CD736CDAB1ADE1B7BD5C378ABE1000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211BE0100000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A201E0D38AC4548746D650A022463529C1F64777038AEFDD2D16BF358DAA6E41B8010012A0C08CE87C7F705108C9FA29B01320962616E64636861696E00FC6A4AAE096D2606050269FDE7AC02F58C23FE10DD219F8805696C8AA3B1BB656C324293F560B082923F9F1F1CC0307A78633095B87E4F4047A57AD650FD971B000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211BE0100000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A201E0D38AC4548746D650A022463529C1F64777038AEFDD2D16BF358DAA6E41B8010012A0C08CE87C7F70510F6A5FD9C01320962616E64636861696E00D039E1D13E1837731A5719626075F1EF92FF48C6FBBEC68B2EF9B3B34A6086DB278405F72CA62F7FF67C28B896CA38AB0534974D96A24ADCEFC509E052530187000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211BE0100000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A201E0D38AC4548746D650A022463529C1F64777038AEFDD2D16BF358DAA6E41B8010012A0C08CE87C7F70510D3D5DD9B01320962616E64636861696E00000000000000000000000000000000000000000000000000000000000000070000000000000000000000000000000000000000000000000000000000000001BE00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000001C000000000000000000000000000000000000000000000000000000000000001BC000000000000000000000000000000000000000000000000000000000000032000000000000000000000000000000000000000000000000000000000000000A0000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000E00000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000047465737400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000F000000034254430000000000000064000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000005EF1C3C2000000000000000000000000000000000000000000000000000000005EF1C3C90000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000012000000000000000000000000000000000000000000000000000000000000000047465737400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000EAAE6000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000001BC1D3927941AEC0602E1FCAB94B4D3E57DA16A2455165CB097ADFDFDF34A3E168800000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000001BC17480C9A0FACA2C2ECEF5FDC7627B11F898F5652F3022E8D0B2DA40356D6D8E400000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000000700000000000000000000000000000000000000000000000000000000000001BCD90FCF3847CCD9EC012100F251F6E258D1E6B4E5E03A94FB06927DBB11F3862300000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000D00000000000000000000000000000000000000000000000000000000000001BC5332E6182A047D2B63DF9C4A5EDBB34D0760A5EEA2816BFCC50EF0A316267A4900000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000005000000000000000000000000000000000000000000000000000000000000001200000000000000000000000000000000000000000000000000000000000001BCFDD7908F23BA8FBE99C7F91C660A94A96231E807566E89FE524E739B1F6252C400000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000007000000000000000000000000000000000000000000000000000000000000003800000000000000000000000000000000000000000000000000000000000001BDEFDF574E7D863CF4D8B8B08ED1558A6E6F47D5091667F29B7B2D37DC44D5F156";
+// Encoded relay and verify data of a request result at block 1412.
+const RELAY_AND_VERIFY_1412 =
+  "0x0000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000064000000000000000000000000000000000000000000000000000000000000005E00000000000000000000000000000000000000000000000000000000000000584811032F41A5918CFC860EBCEA1B7678564C622B4E3F04E28C9D8B195F04661E11E06EA167A89DE60ABF52C60350793C3823A0A4B9681F59DAEB8F20942B8BCF3FF2B888760FFA9EFA09E35763D8A5BA3EC794D00BCD1F9A901FA007F1F16E2EBB1F2FD852E790E735CA2D3014F96A2A53C60393E9C6BBF941B9A6DD6A05CF6F9C6507DE937080730702A2C39AD8AA4666FD24E12A9496E4546A0BD4031E6D4EE32FA694879095840619F5E49380612BD296FF7E950EAFB66FF654D99CA70869E2A448C96300F1CB90567D815C6B2F6EC0BBA29615FD88DEAB57299C76F0B30FA3EBF2264C2C941DF347EB97F55B4ADCD4784F4F0A8E66D9F2E3FDA413115E085004209A161040AB1778E2F2C00EE482F205B28EFBA439FCB04EA283F619478D96E340B9CFFB37A989CA544E6BB780A2C78901D3FB33738768511A30617AFA01D0EFE3E12F46363C7779140D4CE659925DB52F19053E114D7CC4EFD666B37F79F00000000000000000000000000000000000000000000000000000000000001A00000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000001A000000000000000000000000000000000000000000000000000000000000002E0628716AC49023DE84ADDDDDCBEF8007C2E41E5B58306CE87A0AFAD5447BC62102F520DB2BFF3003D5612E03B7AAA99472164C73922A977AF95E1FFC2A67C53B4000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211840500000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A20B8AAC9C5F107C71EACDA8CCDDD2506F30ECFA75685E12E403DDCC6411F6822FF10012A0C088D92C7F70510CBE1CFBE02320962616E64636861696E00FF2BA7E2BD2175827997C706451B5DA768B6873D7BA4129FC6EE54E62BA9C5933C7F7E5B08D1733D430658431545C9A2F57E4641B3B4CD52E567F27BE9485E60000000000000000000000000000000000000000000000000000000000000001C00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211840500000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A20B8AAC9C5F107C71EACDA8CCDDD2506F30ECFA75685E12E403DDCC6411F6822FF10012A0C088D92C7F70510A3F0E5BE02320962616E64636861696E005A2F66B4D62D905B98277CD2807A324F0651340E80AE0249E500BEB5DDCDCE113C1ED3D960B19E0CA7D321215874C6E91407AE3D2748F2E3B617FAD833C30B6D000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211840500000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A20B8AAC9C5F107C71EACDA8CCDDD2506F30ECFA75685E12E403DDCC6411F6822FF10012A0C088D92C7F70510A394A6BF02320962616E64636861696E0000000000000000000000000000000000000000000000000000000000000006C0000000000000000000000000000000000000000000000000000000000000058400000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000001A0000000000000000000000000000000000000000000000000000000000000058100000000000000000000000000000000000000000000000000000000000002E000000000000000000000000000000000000000000000000000000000000000A0000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000C0000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000F0000000342544300000000000003E8000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000005EF1C903000000000000000000000000000000000000000000000000000000005EF1C907000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000009269B3000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000005818D09A67A6AEBB1498F3D104AE86C23D0C8E2E86DCB0A96F1088ACA50B6D5E403000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000058121C6CCA538BE5E9673479BA5694886335CDBBD0FCCCD8D62376728C1133F0027000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000030000000000000000000000000000000000000000000000000000000000000005000000000000000000000000000000000000000000000000000000000000058157C9A0C6D2089B3911DC3BFD173CCD88922ED48030686BA3B3F8E4325B5F3CAC00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000C000000000000000000000000000000000000000000000000000000000000058114DC21A48E352EC5D08B52473DA16CC6CA515E9E94124EA68B84A011B5DA400C00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000005000000000000000000000000000000000000000000000000000000000000001700000000000000000000000000000000000000000000000000000000000005810FCACF91A581CF180802122ABB9D02A67590FE38C7AEA5BAAA53416E91BF30E20000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000700000000000000000000000000000000000000000000000000000000000000430000000000000000000000000000000000000000000000000000000000000583C0062B4FF613ECBCF16E37A4423961AFEBF0302A208702D67E583622AD4A3DDA";
+
+// Splits the encoded relay and verify data into its relay data and verify data.
+const splitRelayAndVerify = (data) => {
+  const values = web3.eth.abi.decodeParameters(["bytes", "bytes"], data);
+  return [values[0], values[1]];
+};
+
+// Returns the given verify data with its version (fourth argument) plus one.
+const tamperVersion = (verifyData) => {
+  const start = 2 + 64 * 3;
+  const version = web3.utils.toBN("0x" + verifyData.slice(start, start + 64));
+  return (
+    verifyData.slice(0, start) +
+    web3.utils.padLeft(version.addn(1).toString(16), 64) +
+    verifyData.slice(start + 64)
+  );
+};
+
+const encodeRelayAndMultiVerify = (relayData, manyVerifyData) =>
+  web3.eth.abi.encodeParameters(
+    ["bytes", "bytes[]"],
+    [relayData, manyVerifyData],
+  );
+
+const REQUEST_PACKET = "tuple(string,uint64,bytes,uint64,uint64)";
+const RESPONSE_PACKET = "tuple(string,uint64,uint64,uint64,uint64,uint8,bytes)";
+const MERKLE_PATHS = "tuple(bool,uint8,uint256,uint256,bytes32)[]";
+
+// Returns the store value verify data of the result proven by the given data.
+const toStoreValueVerifyData = async (verifyData) => {
+  const values = web3.eth.abi.decodeParameters(
+    ["uint256", REQUEST_PACKET, RESPONSE_PACKET, "uint256", MERKLE_PATHS],
+    verifyData,
+  );
+  const req = [0, 1, 2, 3, 4].map((i) => values[1][i]);
+  const res = [0, 1, 2, 3, 4, 5, 6].map((i) => values[2][i]);
+  const packets = await PacketsMock.new();
+  // The result of a request is stored with 0xff prefix and its request ID.
+  const key =
+    "0xff" + web3.utils.padLeft(web3.utils.toBN(res[1]).toString(16), 16);
+  const value = await packets.getEncodedResult(req, res);
+  const paths = values[4].map((path) => [0, 1, 2, 3, 4].map((i) => path[i]));
+  return [values[0], key, value, values[3], paths];
+};
+
+const sha256 = (data) => crypto.createHash("sha256").update(data).digest();
+
+// Returns the hash of the iAVL leaf node of the given key, value and version.
+const leafHash = (key, value, version) => {
+  const varint = [];
+  for (let v = version * 2; ; v = Math.floor(v / 128)) {
+    if (v < 128) {
+      varint.push(v);
+      break;
+    }
+    varint.push((v % 128) + 128);
+  }
+  const keyBytes = Buffer.from(key.slice(2), "hex");
+  return (
+    "0x" +
+    sha256(
+      Buffer.concat([
+        Buffer.from([0, 2]),
+        Buffer.from(varint),
+        Buffer.from([keyBytes.length]),
+        keyBytes,
+        Buffer.from([32]),
+        sha256(Buffer.from(value.slice(2), "hex")),
+      ]),
+    ).toString("hex")
+  );
+};
+
 contract("Bridge", ([_, owner, alice, bob]) => {
   context("Checking oracle state relay (4 validators)", () => {
     beforeEach(async () => {
@@ -392,7 +473,7 @@ contract("Bridge", ([_, owner, alice, bob]) => {
 
     it("should accept valid relay and verify", async () => {
       await this.receiver.relayAndSafe(
-        "0x0000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000064000000000000000000000000000000000000000000000000000000000000005E000000000000000000000000000000000000000000000000000000000000001BEB0CC616F4E76EBA2C1845FD2321AD881991A2990F17CD278EB5F6D17DA032FAF9459616C154A23567A7281FB577BBFA1AA8D36382C4F64362968202FD32A0B65CBADB1694A5152C2B03F4960E1229745B39D7C41B32DC54E0C207799AE471981B1F2FD852E790E735CA2D3014F96A2A53C60393E9C6BBF941B9A6DD6A05CF6F93D913423122F2237A9A046D7EBFD13C3A6F1DF93A1517A9F0B60A492DBC369D832FA694879095840619F5E49380612BD296FF7E950EAFB66FF654D99CA70869EEEA5D164B32B494765EB256279014366747DB8941C23F23B2FB09A9BBBF721762C4FD636ADF805490129912F7FC18A2766AABD9FD4807F2FF18ED4CCD23F2583004209A161040AB1778E2F2C00EE482F205B28EFBA439FCB04EA283F619478D96E340B9CFFB37A989CA544E6BB780A2C78901D3FB33738768511A30617AFA01D7F4BE7E5A1EB872AD44103360DDC190410331280C42A54D829A5D752C796685D00000000000000000000000000000000000000000000000000000000000001A00000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000001A000000000000000000000000000000000000000000000000000000000000002E0B0EFF36D1214C35F785D82A7947589DBA3F7D6AE35DCB92B1B3ACA1DF441E1E77BE19DD7F54C0AB0726CC5074FE65A887C2F2CD736CDAB1ADE1B7BD5C378ABE1000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211BE0100000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A201E0D38AC4548746D650A022463529C1F64777038AEFDD2D16BF358DAA6E41B8010012A0C08CE87C7F705108C9FA29B01320962616E64636861696E00FC6A4AAE096D2606050269FDE7AC02F58C23FE10DD219F8805696C8AA3B1BB656C324293F560B082923F9F1F1CC0307A78633095B87E4F4047A57AD650FD971B000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211BE0100000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A201E0D38AC4548746D650A022463529C1F64777038AEFDD2D16BF358DAA6E41B8010012A0C08CE87C7F70510F6A5FD9C01320962616E64636861696E00D039E1D13E1837731A5719626075F1EF92FF48C6FBBEC68B2EF9B3B34A6086DB278405F72CA62F7FF67C28B896CA38AB0534974D96A24ADCEFC509E052530187000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211BE0100000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A201E0D38AC4548746D650A022463529C1F64777038AEFDD2D16BF358DAA6E41B8010012A0C08CE87C7F70510D3D5DD9B01320962616E64636861696E00000000000000000000000000000000000000000000000000000000000000070000000000000000000000000000000000000000000000000000000000000001BE00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000001C000000000000000000000000000000000000000000000000000000000000001BC000000000000000000000000000000000000000000000000000000000000032000000000000000000000000000000000000000000000000000000000000000A0000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000E00000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000047465737400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000F000000034254430000000000000064000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000005EF1C3C2000000000000000000000000000000000000000000000000000000005EF1C3C90000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000012000000000000000000000000000000000000000000000000000000000000000047465737400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000EAAE6000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000001BC1D3927941AEC0602E1FCAB94B4D3E57DA16A2455165CB097ADFDFDF34A3E168800000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000001BC17480C9A0FACA2C2ECEF5FDC7627B11F898F5652F3022E8D0B2DA40356D6D8E400000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000000700000000000000000000000000000000000000000000000000000000000001BCD90FCF3847CCD9EC012100F251F6E258D1E6B4E5E03A94FB06927DBB11F3862300000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000D00000000000000000000000000000000000000000000000000000000000001BC5332E6182A047D2B63DF9C4A5EDBB34D0760A5EEA2816BFCC50EF0A316267A4900000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000005000000000000000000000000000000000000000000000000000000000000001200000000000000000000000000000000000000000000000000000000000001BCFDD7908F23BA8FBE99C7F91C660A94A96231E807566E89FE524E739B1F6252C400000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000007000000000000000000000000000000000000000000000000000000000000003800000000000000000000000000000000000000000000000000000000000001BDEFDF574E7D863CF4D8B8B08ED1558A6E6F47D5091667F29B7B2D37DC44D5F156",
+        RELAY_AND_VERIFY_446,
       );
       (await this.bridge.oracleStates(446))
         .toString()
@@ -417,7 +498,7 @@ contract("Bridge", ([_, owner, alice, bob]) => {
 
     it("should accept valid relay and verify case 2", async () => {
       await this.receiver.relayAndSafe(
-        "0x0000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000064000000000000000000000000000000000000000000000000000000000000005E00000000000000000000000000000000000000000000000000000000000000584811032F41A5918CFC860EBCEA1B7678564C622B4E3F04E28C9D8B195F04661E11E06EA167A89DE60ABF52C60350793C3823A0A4B9681F59DAEB8F20942B8BCF3FF2B888760FFA9EFA09E35763D8A5BA3EC794D00BCD1F9A901FA007F1F16E2EBB1F2FD852E790E735CA2D3014F96A2A53C60393E9C6BBF941B9A6DD6A05CF6F9C6507DE937080730702A2C39AD8AA4666FD24E12A9496E4546A0BD4031E6D4EE32FA694879095840619F5E49380612BD296FF7E950EAFB66FF654D99CA70869E2A448C96300F1CB90567D815C6B2F6EC0BBA29615FD88DEAB57299C76F0B30FA3EBF2264C2C941DF347EB97F55B4ADCD4784F4F0A8E66D9F2E3FDA413115E085004209A161040AB1778E2F2C00EE482F205B28EFBA439FCB04EA283F619478D96E340B9CFFB37A989CA544E6BB780A2C78901D3FB33738768511A30617AFA01D0EFE3E12F46363C7779140D4CE659925DB52F19053E114D7CC4EFD666B37F79F00000000000000000000000000000000000000000000000000000000000001A00000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000001A000000000000000000000000000000000000000000000000000000000000002E0628716AC49023DE84ADDDDDCBEF8007C2E41E5B58306CE87A0AFAD5447BC62102F520DB2BFF3003D5612E03B7AAA99472164C73922A977AF95E1FFC2A67C53B4000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211840500000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A20B8AAC9C5F107C71EACDA8CCDDD2506F30ECFA75685E12E403DDCC6411F6822FF10012A0C088D92C7F70510CBE1CFBE02320962616E64636861696E00FF2BA7E2BD2175827997C706451B5DA768B6873D7BA4129FC6EE54E62BA9C5933C7F7E5B08D1733D430658431545C9A2F57E4641B3B4CD52E567F27BE9485E60000000000000000000000000000000000000000000000000000000000000001C00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211840500000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A20B8AAC9C5F107C71EACDA8CCDDD2506F30ECFA75685E12E403DDCC6411F6822FF10012A0C088D92C7F70510A3F0E5BE02320962616E64636861696E005A2F66B4D62D905B98277CD2807A324F0651340E80AE0249E500BEB5DDCDCE113C1ED3D960B19E0CA7D321215874C6E91407AE3D2748F2E3B617FAD833C30B6D000000000000000000000000000000000000000000000000000000000000001B00000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000106E080211840500000000000022480A2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003F12240A20B8AAC9C5F107C71EACDA8CCDDD2506F30ECFA75685E12E403DDCC6411F6822FF10012A0C088D92C7F70510A394A6BF02320962616E64636861696E0000000000000000000000000000000000000000000000000000000000000006C0000000000000000000000000000000000000000000000000000000000000058400000000000000000000000000000000000000000000000000000000000000A000000000000000000000000000000000000000000000000000000000000001A0000000000000000000000000000000000000000000000000000000000000058100000000000000000000000000000000000000000000000000000000000002E000000000000000000000000000000000000000000000000000000000000000A0000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000C0000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000F0000000342544300000000000003E8000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000E000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000005EF1C903000000000000000000000000000000000000000000000000000000005EF1C907000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000009269B3000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000005818D09A67A6AEBB1498F3D104AE86C23D0C8E2E86DCB0A96F1088ACA50B6D5E403000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000058121C6CCA538BE5E9673479BA5694886335CDBBD0FCCCD8D62376728C1133F0027000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000030000000000000000000000000000000000000000000000000000000000000005000000000000000000000000000000000000000000000000000000000000058157C9A0C6D2089B3911DC3BFD173CCD88922ED48030686BA3B3F8E4325B5F3CAC00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000C000000000000000000000000000000000000000000000000000000000000058114DC21A48E352EC5D08B52473DA16CC6CA515E9E94124EA68B84A011B5DA400C00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000005000000000000000000000000000000000000000000000000000000000000001700000000000000000000000000000000000000000000000000000000000005810FCACF91A581CF180802122ABB9D02A67590FE38C7AEA5BAAA53416E91BF30E20000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000700000000000000000000000000000000000000000000000000000000000000430000000000000000000000000000000000000000000000000000000000000583C0062B4FF613ECBCF16E37A4423961AFEBF0302A208702D67E583622AD4A3DDA",
+        RELAY_AND_VERIFY_1412,
       );
       (await this.bridge.oracleStates(1412))
         .toString()
@@ -457,6 +538,205 @@ contract("Bridge", ([_, owner, alice, bob]) => {
     });
   });
 
+  context("Relay and multi verify data", () => {
+    beforeEach(async () => {
+      this.bridge = await Bridge.new([
+        ["0x88e1cd00710495EEB93D4f522d16bC8B87Cb00FE", 100],
+        ["0x652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5", 100],
+        ["0xB956589b6fC5523eeD0d9eEcfF06262Ce84ff260", 100],
+        ["0xaAA22E077492CbaD414098EBD98AA8dc1C7AE8D9", 100],
+      ]);
+      [this.relay446, this.verify446] = splitRelayAndVerify(
+        RELAY_AND_VERIFY_446,
+      );
+      [this.relay1412, this.verify1412] = splitRelayAndVerify(
+        RELAY_AND_VERIFY_1412,
+      );
+    });
+
+    it("should accept valid relay and multi verify", async () => {
+      const data = encodeRelayAndMultiVerify(this.relay446, [
+        this.verify446,
+        this.verify446,
+      ]);
+      const [reqs, ress] = await this.bridge.relayAndMultiVerify.call(data);
+      reqs.length.should.eq(2);
+      ress.length.should.eq(2);
+      for (let i = 0; i < 2; i++) {
+        reqs[i][0].toString().should.eq("test");
+        reqs[i][2].toString().should.eq("0x000000034254430000000000000064");
+        ress[i][1].toString().should.eq("1");
+        ress[i][6].toString().should.eq("0x00000000000eaae6");
+      }
+      await this.bridge.relayAndMultiVerify(data);
+      (await this.bridge.oracleStates(446))
+        .toString()
+        .should.eq(
+          "0xcbadb1694a5152c2b03f4960e1229745b39d7c41b32dc54e0c207799ae471981",
+        );
+    });
+
+    it("should revert if any verify data is tampered", async () => {
+      await expectRevert(
+        this.bridge.relayAndMultiVerify(
+          encodeRelayAndMultiVerify(this.relay446, [
+            this.verify446,
+            tamperVersion(this.verify446),
+          ]),
+        ),
+        "VERIFY_ORACLE_DATA_FAILED",
+      );
+    });
+
+    it("should revert if verify data is of another block", async () => {
+      // All data must be of the relayed block, even if the other is relayed.
+      await this.bridge.relayAndVerify(RELAY_AND_VERIFY_1412);
+      await expectRevert(
+        this.bridge.relayAndMultiVerify(
+          encodeRelayAndMultiVerify(this.relay446, [
+            this.verify446,
+            this.verify1412,
+          ]),
+        ),
+        "BLOCK_HEIGHT_MISMATCH",
+      );
+    });
+
+    it("should revert on empty or too many verify data", async () => {
+      await expectRevert(
+        this.bridge.relayAndMultiVerify(
+          encodeRelayAndMultiVerify(this.relay446, []),
+        ),
+        "INVALID_VERIFY_DATA_COUNT",
+      );
+      const max = (await this.bridge.MAX_MULTI_VERIFY_COUNT()).toNumber();
+      await expectRevert(
+        this.bridge.relayAndMultiVerify(
+          encodeRelayAndMultiVerify(
+            this.relay446,
+            Array(max + 1).fill(this.verify446),
+          ),
+        ),
+        "INVALID_VERIFY_DATA_COUNT",
+      );
+    });
+  });
+
+  context("Relay and verify store value", () => {
+    beforeEach(async () => {
+      this.bridge = await Bridge.new([
+        ["0x88e1cd00710495EEB93D4f522d16bC8B87Cb00FE", 100],
+        ["0x652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5", 100],
+        ["0xB956589b6fC5523eeD0d9eEcfF06262Ce84ff260", 100],
+        ["0xaAA22E077492CbaD414098EBD98AA8dc1C7AE8D9", 100],
+      ]);
+      [this.relay446, this.verify446] = splitRelayAndVerify(
+        RELAY_AND_VERIFY_446,
+      );
+      this.storeArgs = await toStoreValueVerifyData(this.verify446);
+    });
+
+    it("should accept valid relay and verify store value", async () => {
+      const data = web3.eth.abi.encodeParameters(
+        ["bytes", "bytes"],
+        [
+          this.relay446,
+          web3.eth.abi.encodeParameters(
+            ["uint256", "bytes", "bytes", "uint256", MERKLE_PATHS],
+            this.storeArgs,
+          ),
+        ],
+      );
+      (await this.bridge.relayAndVerifyStoreValue.call(data)).should.eq(
+        this.storeArgs[2],
+      );
+      await this.bridge.relayAndVerifyStoreValue(data);
+      (await this.bridge.verifyOracleStoreValue(...this.storeArgs)).should.eq(
+        this.storeArgs[2],
+      );
+    });
+
+    it("should not accept tampered store value", async () => {
+      await this.bridge.relayAndVerify(RELAY_AND_VERIFY_446);
+      const [height, key, value, version, paths] = this.storeArgs;
+      await expectRevert(
+        this.bridge.verifyOracleStoreValue(height, key, "0x00", version, paths),
+        "INVALID_ORACLE_DATA_PROOF",
+      );
+      await expectRevert(
+        this.bridge.verifyOracleStoreValue(
+          height,
+          "0xff0000000000000002",
+          value,
+          version,
+          paths,
+        ),
+        "INVALID_ORACLE_DATA_PROOF",
+      );
+      await expectRevert(
+        this.bridge.verifyOracleStoreValue(height, key, value, 445, paths),
+        "INVALID_ORACLE_DATA_PROOF",
+      );
+    });
+
+    it("should not accept unrelayed block or too long key", async () => {
+      const [height, key, value, version, paths] = this.storeArgs;
+      await expectRevert(
+        this.bridge.verifyOracleStoreValue(height, key, value, version, paths),
+        "NO_ORACLE_ROOT_STATE_DATA",
+      );
+      await this.bridge.setOracleState(1, leafHash(key, value, 1));
+      await expectRevert(
+        this.bridge.verifyOracleStoreValue(
+          1,
+          "0x" + "ff".repeat(128),
+          value,
+          1,
+          [],
+        ),
+        "KEY_TOO_LONG",
+      );
+    });
+
+    it("should accept data source version", async () => {
+      // The key of version 2 of data source 1, with 0x09 prefix.
+      const key = "0x0900000000000000010000000000000002";
+      const value = "0x0a0568656c6c6f";
+      await this.bridge.setOracleState(1, leafHash(key, value, 42));
+      (
+        await this.bridge.verifyOracleStoreValue(1, key, value, 42, [])
+      ).should.eq(value);
+      await expectRevert(
+        this.bridge.verifyOracleStoreValue(
+          1,
+          "0x0900000000000000010000000000000001",
+          value,
+          42,
+          [],
+        ),
+        "INVALID_ORACLE_DATA_PROOF",
+      );
+    });
+
+    it("should revert invalid relay and verify store value", async () => {
+      await expectRevert(
+        this.bridge.relayAndVerifyStoreValue(
+          web3.eth.abi.encodeParameters(
+            ["bytes", "bytes"],
+            [
+              this.relay446,
+              web3.eth.abi.encodeParameters(
+                ["uint256", "bytes", "bytes", "uint256", MERKLE_PATHS],
+                [446, this.storeArgs[1], "0x00", 444, this.storeArgs[4]],
+              ),
+            ],
+          ),
+        ),
+        "VERIFY_ORACLE_STORE_VALUE_FAILED",
+      );
+    });
+  });
+
   context("Update provider powers", () => {
     beforeEach(async () => {
       this.bridge = await Bridge.new(
@@ -44,7 +44,7 @@ func AddGenesisDataSourceCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeH
 			}
 			oracleGenState := oracle.GetGenesisStateFromAppState(cdc, appState)
 			oracleGenState.DataSources = append(oracleGenState.DataSources, types.NewDataSource(
-				owner, args[0], args[1], filename,
+				owner, args[0], args[1], filename, "",
 			))
 			appState[oracle.ModuleName] = cdc.MustMarshalJSON(oracleGenState)
 			appStateJSON := cdc.MustMarshalJSON(appState)
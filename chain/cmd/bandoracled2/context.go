@@ -15,4 +15,9 @@ type Context struct {
 	keys      chan keys.Info
 	executor  executor
 	fileCache filecache.Cache
+	reveals   *revealQueue
+	jobs      *jobStore
+	submitter *txSubmitter
+	// blockResultsFailures is the number of times in a row that getting block results failed.
+	blockResultsFailures int
 }
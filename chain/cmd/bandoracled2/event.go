@@ -6,6 +6,7 @@ import (
 
 	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 type rawRequest struct {
@@ -51,6 +52,25 @@ func GetRawRequests(log sdk.ABCIMessageLog) ([]rawRequest, error) {
 	return reqs, nil
 }
 
+// GetRequestLogs groups the given block events into one log per request. Each log contains a
+// request event followed by all of its raw request events, as emitted by PrepareRequest.
+func GetRequestLogs(events []abci.Event) (logs []sdk.ABCIMessageLog) {
+	for _, ev := range events {
+		if ev.Type == otypes.EventTypeRequest {
+			logs = append(logs, sdk.ABCIMessageLog{})
+		} else if ev.Type != otypes.EventTypeRawRequest || len(logs) == 0 {
+			continue
+		}
+		attrs := make([]sdk.Attribute, 0, len(ev.Attributes))
+		for _, attr := range ev.Attributes {
+			attrs = append(attrs, sdk.NewAttribute(string(attr.Key), string(attr.Value)))
+		}
+		log := &logs[len(logs)-1]
+		log.Events = append(log.Events, sdk.StringEvent{Type: ev.Type, Attributes: attrs})
+	}
+	return logs
+}
+
 // GetEventValues returns the list of all values in the given log with the given type and key.
 func GetEventValues(log sdk.ABCIMessageLog, evType string, evKey string) (res []string) {
 	for _, ev := range log.Events {
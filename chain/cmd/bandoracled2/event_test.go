@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+
+	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func newEvent(ty string, key string, value string) abci.Event {
+	return abci.Event{Type: ty, Attributes: []kv.Pair{{Key: []byte(key), Value: []byte(value)}}}
+}
+
+func TestGetRequestLogs(t *testing.T) {
+	logs := GetRequestLogs([]abci.Event{
+		newEvent("transfer", "amount", "10uband"),
+		newEvent(otypes.EventTypeRequest, otypes.AttributeKeyID, "1"),
+		newEvent(otypes.EventTypeRawRequest, otypes.AttributeKeyExternalID, "1"),
+		newEvent(otypes.EventTypeRawRequest, otypes.AttributeKeyExternalID, "2"),
+		newEvent(otypes.EventTypeRequest, otypes.AttributeKeyID, "2"),
+		newEvent(otypes.EventTypeRawRequest, otypes.AttributeKeyExternalID, "3"),
+		newEvent("transfer", "amount", "10uband"),
+	})
+	require.Len(t, logs, 2)
+	id, err := GetEventValue(logs[0], otypes.EventTypeRequest, otypes.AttributeKeyID)
+	require.NoError(t, err)
+	require.Equal(t, "1", id)
+	require.Equal(t, []string{"1", "2"}, GetEventValues(logs[0], otypes.EventTypeRawRequest, otypes.AttributeKeyExternalID))
+	id, err = GetEventValue(logs[1], otypes.EventTypeRequest, otypes.AttributeKeyID)
+	require.NoError(t, err)
+	require.Equal(t, "2", id)
+	require.Equal(t, []string{"3"}, GetEventValues(logs[1], otypes.EventTypeRawRequest, otypes.AttributeKeyExternalID))
+	require.Equal(t, []sdk.ABCIMessageLog(nil), GetRequestLogs(nil))
+}
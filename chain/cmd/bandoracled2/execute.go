@@ -1,12 +1,11 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
-	sdkCtx "github.com/cosmos/cosmos-sdk/client/context"
-	ckeys "github.com/cosmos/cosmos-sdk/client/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth"
 
 	"github.com/bandprotocol/bandchain/chain/app"
 	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
@@ -17,56 +16,117 @@ var (
 	cdc = app.MakeCodec()
 )
 
-func SubmitReport(c *Context, l *Logger, id otypes.RequestID, reps []otypes.RawReport) {
-	key := <-c.keys
-	defer func() {
-		c.keys <- key
-	}()
+const (
+	// RetryBackoffMin is the waiting time before retrying a failed step of a job for the first time.
+	RetryBackoffMin = 1 * time.Second
+	// RetryBackoffMax caps the waiting time between retries, which doubles after every failure.
+	RetryBackoffMax = 1 * time.Minute
+)
+
+// SubmitReport broadcasts the given raw reports to the given request in a plain report.
+func SubmitReport(c *Context, l *Logger, id otypes.RequestID, reps []otypes.RawReport) error {
+	return submitMsg(c, l, func(reporter sdk.AccAddress) sdk.Msg {
+		return otypes.NewMsgReportData(id, reps, c.validator, reporter)
+	})
+}
+
+// SubmitCommitReport broadcasts the commit hash of a report to the given request.
+func SubmitCommitReport(c *Context, l *Logger, id otypes.RequestID, hash []byte) error {
+	return submitMsg(c, l, func(reporter sdk.AccAddress) sdk.Msg {
+		return otypes.NewMsgCommitReport(id, hash, c.validator, reporter)
+	})
+}
+
+// SubmitRevealReport broadcasts the previously committed raw reports to the given request.
+func SubmitRevealReport(c *Context, l *Logger, id otypes.RequestID, reps []otypes.RawReport, salt []byte) error {
+	return submitMsg(c, l, func(reporter sdk.AccAddress) sdk.Msg {
+		return otypes.NewMsgRevealReport(id, reps, salt, c.validator, reporter)
+	})
+}
+
+// isAlreadyDone returns whether the given error is of a transaction that does the same thing as an
+// earlier transaction of this validator, which happens when a job resumes after a restart.
+func isAlreadyDone(err error) bool {
+	txErr, ok := err.(txError)
+	return ok && txErr.codespace == otypes.ModuleName &&
+		(txErr.code == otypes.ErrValidatorAlreadyReported.ABCICode() || txErr.code == otypes.ErrAlreadyCommitted.ABCICode())
+}
+
+// isTerminal returns whether the given transaction error means that retrying cannot succeed, such
+// as committing after the commit window closes.
+func isTerminal(err error) bool {
+	txErr, ok := err.(txError)
+	return ok && txErr.codespace == otypes.ModuleName && txErr.code == otypes.ErrCommitWindowClosed.ABCICode()
+}
 
-	msg := otypes.NewMsgReportData(otypes.RequestID(id), reps, c.validator, key.GetAddress())
-	if err := msg.ValidateBasic(); err != nil {
-		l.Error(":exploding_head: Failed to validate basic with error: %s", err.Error())
-		return
+// submitMsg queues the message created with the reporter address of a key to be broadcast in a
+// transaction, and waits until the transaction is included in a block. Returns an error if the
+// transaction fails.
+func submitMsg(c *Context, l *Logger, newMsg func(reporter sdk.AccAddress) sdk.Msg) error {
+	err := c.submitter.Submit(l, newMsg)
+	if isAlreadyDone(err) {
+		l.Info(":ok_hand: Skipping message already done with error: %s", err.Error())
+		return nil
 	}
+	return err
+}
 
-	cliCtx := sdkCtx.CLIContext{Client: c.client}
-	acc, err := auth.NewAccountRetriever(cliCtx).GetAccount(key.GetAddress())
-	if err != nil {
-		l.Error(":exploding_head: Failed to retreive account with error: %s", err.Error())
-		return
+// nextBackoff returns the waiting time before the next retry after waiting for the given time.
+func nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > RetryBackoffMax {
+		return RetryBackoffMax
 	}
+	return backoff
+}
 
-	txBldr := auth.NewTxBuilder(
-		auth.DefaultTxEncoder(cdc), acc.GetAccountNumber(), acc.GetSequence(),
-		200000, 1, false, cfg.ChainID, "", sdk.NewCoins(), c.gasPrices,
-	)
-	// txBldr, err = authclient.EnrichWithGas(txBldr, cliCtx, []sdk.Msg{msg})
-	// if err != nil {
-	// 	l.Error(":exploding_head: Failed to enrich with gas with error: %s", err.Error())
-	// 	return
-	// }
-	out, err := txBldr.WithKeybase(keybase).BuildAndSign(key.GetName(), ckeys.DefaultKeyPass, []sdk.Msg{msg})
-	if err != nil {
-		l.Error(":exploding_head: Failed to build tx with error: %s", err.Error())
-		return
+// retryUntilExpired calls the given function until it succeeds, waiting with exponential backoff
+// between attempts. Returns false if the request of the given job expires before that, or if the
+// function fails with a terminal error.
+func retryUntilExpired(c *Context, l *Logger, j job, fn func() error) bool {
+	backoff := RetryBackoffMin
+	for {
+		err := fn()
+		if err == nil {
+			return true
+		}
+		if isTerminal(err) {
+			l.Error(":no_entry: Giving up on request with terminal error: %s", err.Error())
+			return false
+		}
+		status, statusErr := c.client.Status()
+		if statusErr == nil && status.SyncInfo.LatestBlockHeight >= j.ExpirationHeight {
+			l.Error(":hourglass: Giving up on request expired at block %d with last error: %s", j.ExpirationHeight, err.Error())
+			return false
+		}
+		l.Error(":exploding_head: %s, retrying in %s", err.Error(), backoff)
+		time.Sleep(backoff)
+		backoff = nextBackoff(backoff)
 	}
+}
 
-	res, err := cliCtx.BroadcastTxCommit(out)
+// GetParams fetches the current oracle module parameters using the provided client.
+func GetParams(c *Context) (otypes.Params, error) {
+	res, err := c.client.ABCIQuery(fmt.Sprintf("custom/%s/%s", otypes.StoreKey, otypes.QueryParams), nil)
 	if err != nil {
-		l.Error(":exploding_head: Failed to broadcast tx with error: %s", err.Error())
-		return
+		return otypes.Params{}, err
+	}
+	var result otypes.QueryResult
+	if err := json.Unmarshal(res.Response.GetValue(), &result); err != nil {
+		return otypes.Params{}, err
 	}
-	if res.Code != 0 {
-		l.Error(":exploding_head: Tx returned nonzero code %d with log %s, tx hash: %s", res.Code, res.RawLog, res.TxHash)
-		return
+	var params otypes.Params
+	if err := cdc.UnmarshalJSON(result.Result, &params); err != nil {
+		return otypes.Params{}, err
 	}
-	l.Info(":smiling_face_with_sunglasses: Successfully broadcast tx with hash: %s", res.TxHash)
+	return params, nil
 }
 
 // GetExecutable fetches data source executable using the provided client.
 func GetExecutable(c *Context, l *Logger, hash string) ([]byte, error) {
 	resValue, err := c.fileCache.GetFile(hash)
 	if err != nil {
+		executableCacheLookups.WithLabelValues("miss").Inc()
 		l.Debug(":magnifying_glass_tilted_left: Fetching data source hash: %s from bandchain querier", hash)
 		res, err := c.client.ABCIQueryWithOptions(fmt.Sprintf("custom/%s/%s/%s", otypes.StoreKey, otypes.QueryData, hash), nil, rpcclient.ABCIQueryOptions{})
 		if err != nil {
@@ -76,6 +136,7 @@ func GetExecutable(c *Context, l *Logger, hash string) ([]byte, error) {
 		resValue = res.Response.GetValue()
 		c.fileCache.AddFile(resValue)
 	} else {
+		executableCacheLookups.WithLabelValues("hit").Inc()
 		l.Debug(":card_file_box: Found data source hash: %s in cache file", hash)
 	}
 
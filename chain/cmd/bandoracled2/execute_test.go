@@ -0,0 +1,15 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextBackoff(t *testing.T) {
+	require.Equal(t, 2*time.Second, nextBackoff(RetryBackoffMin))
+	require.Equal(t, 32*time.Second, nextBackoff(16*time.Second))
+	require.Equal(t, RetryBackoffMax, nextBackoff(32*time.Second))
+	require.Equal(t, RetryBackoffMax, nextBackoff(RetryBackoffMax))
+}
@@ -9,10 +9,34 @@ import (
 	"github.com/levigross/grequests"
 )
 
+// RestExecutorTimeoutMargin is added to the execution timeout to get the timeout of the whole HTTP
+// request to a REST executor, so that an unresponsive executor cannot block the daemon.
+const RestExecutorTimeoutMargin = 2 * time.Second
+
 type executor interface {
 	Execute(l *Logger, exec []byte, timeout time.Duration, arg string) ([]byte, uint32)
 }
 
+// backendExecutor is an executor that returns failures to run the executable as errors instead of
+// execution results, so that the caller can retry or fail over to another executor.
+type backendExecutor interface {
+	executor
+	TryExecute(l *Logger, exec []byte, timeout time.Duration, arg string) ([]byte, uint32, error)
+}
+
+// executeOrError runs the given executable with the given backend executor, turning a failure to
+// run it into an execution error result.
+func executeOrError(
+	e backendExecutor, l *Logger, exec []byte, timeout time.Duration, arg string,
+) ([]byte, uint32) {
+	result, exitCode, err := e.TryExecute(l, exec, timeout, arg)
+	if err != nil {
+		l.Error(":skull: %s", err.Error())
+		return []byte("EXECUTION_ERROR"), 255
+	}
+	return result, exitCode
+}
+
 type restExecutor struct {
 	Name string
 	URL  string
@@ -27,6 +51,12 @@ type externalExecutionResponse struct {
 func (e *restExecutor) Execute(
 	l *Logger, exec []byte, timeout time.Duration, arg string,
 ) ([]byte, uint32) {
+	return executeOrError(e, l, exec, timeout, arg)
+}
+
+func (e *restExecutor) TryExecute(
+	l *Logger, exec []byte, timeout time.Duration, arg string,
+) ([]byte, uint32, error) {
 	var executable string
 	if e.Name == "cloud-function" {
 		executable = base64.StdEncoding.EncodeToString([]byte(exec))
@@ -45,36 +75,34 @@ func (e *restExecutor) Execute(
 				"calldata":   arg,
 				"timeout":    timeout.Milliseconds(),
 			},
+			RequestTimeout: timeout + RestExecutorTimeoutMargin,
 		},
 	)
 
 	if err != nil {
-		l.Error(":skull: RestExecutor failed with error: %s", err.Error())
-		return []byte("EXECUTION_ERROR"), 255
+		return nil, 0, fmt.Errorf("RestExecutor failed with error: %s", err.Error())
 	}
 
 	if resp.Ok != true {
-		l.Error(":skull: RestExecutor failed with error: %s", resp.Error)
-		return []byte("EXECUTION_ERROR"), 255
+		return nil, 0, fmt.Errorf("RestExecutor failed with status code: %d", resp.StatusCode)
 	}
 
 	r := externalExecutionResponse{}
 	err = resp.JSON(&r)
 
 	if err != nil {
-		l.Error(":skull: RestExecutor failed with error: %s", err.Error())
-		return []byte("EXECUTION_ERROR"), 255
+		return nil, 0, fmt.Errorf("RestExecutor failed with error: %s", err.Error())
 	}
 
 	if r.Returncode == 0 {
-		return []byte(r.Stdout), r.Returncode
+		return []byte(r.Stdout), r.Returncode, nil
 	} else {
-		return []byte(r.Stderr), r.Returncode
+		return []byte(r.Stderr), r.Returncode, nil
 	}
 }
 
 // NewExecutor returns executor by name and executor URL
-func NewExecutor(executor string) (executor, error) {
+func NewExecutor(executor string) (backendExecutor, error) {
 	name, url, err := parseExecutor(executor)
 	if err != nil {
 		return nil, err
@@ -82,6 +110,8 @@ func NewExecutor(executor string) (executor, error) {
 	switch name {
 	case "lambda", "cloud-function":
 		return &restExecutor{Name: name, URL: url}, nil
+	case "local":
+		return newLocalExecutor(url)
 	default:
 		return nil, fmt.Errorf("Invalid executor name: %s, url: %s", name, url)
 	}
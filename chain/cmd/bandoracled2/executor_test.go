@@ -64,6 +64,23 @@ func TestExecuteSuccess(t *testing.T) {
 	require.Equal(t, []byte("BEEB"), res)
 }
 
+func TestExecuteRequestTimeout(t *testing.T) {
+	// The server does not respond until the test finishes.
+	done := make(chan struct{})
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		<-done
+	}))
+	defer func() { testServer.Close() }()
+	defer close(done)
+
+	executor := &restExecutor{Name: "lambda", URL: testServer.URL}
+	start := time.Now()
+	_, _, err := executor.TryExecute(getLog(), []byte("executable"), 100*time.Millisecond, "calldata")
+
+	require.Error(t, err)
+	require.Less(t, int64(time.Since(start)), int64(100*time.Millisecond+RestExecutorTimeoutMargin+time.Second))
+}
+
 func TestExecuteBadUrlFail(t *testing.T) {
 	testServer := creatDefaultServer()
 	defer func() { testServer.Close() }()
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ExecutorMaxAttempts is the number of times to try an executor before failing over to the next.
+	ExecutorMaxAttempts = 2
+	// ExecutorRetryDelay is the waiting time before trying the same executor again.
+	ExecutorRetryDelay = 200 * time.Millisecond
+	// BreakerFailureThreshold is the number of consecutive failed calls that opens the circuit of
+	// an executor, so that it is skipped until the cooldown passes.
+	BreakerFailureThreshold = 3
+	// BreakerCooldown is how long an executor is skipped after its circuit opens. After that, one
+	// call is let through to decide whether to close the circuit again.
+	BreakerCooldown = 30 * time.Second
+	// HealthCheckInterval is the interval between health probes of the executors.
+	HealthCheckInterval = 30 * time.Second
+	// HealthCheckTimeout is the timeout of the health probe executable.
+	HealthCheckTimeout = 5 * time.Second
+)
+
+// HealthCheckExecutable is the executable run on every executor to probe that it works.
+var HealthCheckExecutable = []byte("#!/bin/sh\necho ok\n")
+
+// executorBackend is an executor in the failover list with its health and circuit breaker state.
+type executorBackend struct {
+	name     string
+	executor backendExecutor
+
+	mtx       sync.Mutex
+	healthy   bool      // Whether the last health probe passed
+	failures  int       // Number of consecutive failed calls
+	openUntil time.Time // Time until which the circuit is open
+	trial     bool      // Whether a call is let through the half-open circuit
+}
+
+// acquire returns whether a call can go to this executor now. Once the cooldown of an open circuit
+// passes, only one call is let through until its result is recorded.
+func (b *executorBackend) acquire(now time.Time) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if !b.healthy {
+		return false
+	}
+	if b.failures < BreakerFailureThreshold {
+		return true
+	}
+	if now.Before(b.openUntil) || b.trial {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+// recordSuccess closes the circuit after a successful call or health probe.
+func (b *executorBackend) recordSuccess() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.healthy = true
+	b.failures = 0
+	b.trial = false
+}
+
+// recordFailure counts a failed call, opening the circuit if there are too many in a row.
+func (b *executorBackend) recordFailure(now time.Time) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.failures++
+	b.trial = false
+	if b.failures >= BreakerFailureThreshold {
+		b.openUntil = now.Add(BreakerCooldown)
+	}
+}
+
+// setUnhealthy marks the executor as failing its health probe, so it is skipped until a probe
+// passes.
+func (b *executorBackend) setUnhealthy() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.healthy = false
+}
+
+// failoverExecutor runs executables on the first available executor of a prioritized list. Each
+// executor is tried a few times before failing over to the next one, and executors that keep
+// failing or fail their health probes are skipped for a while.
+type failoverExecutor struct {
+	backends []*executorBackend
+}
+
+// NewFailoverExecutor returns a failover executor with the given whitespace-separated list of
+// executors in the form of "name:url", in priority order.
+func NewFailoverExecutor(executors string) (*failoverExecutor, error) {
+	names := strings.Fields(executors)
+	if len(names) == 0 {
+		return nil, errors.New("At least one executor is required")
+	}
+	e := &failoverExecutor{}
+	for _, name := range names {
+		exec, err := NewExecutor(name)
+		if err != nil {
+			return nil, err
+		}
+		e.backends = append(e.backends, &executorBackend{name: name, executor: exec, healthy: true})
+		executorHealthy.WithLabelValues(name).Set(1)
+	}
+	return e, nil
+}
+
+func (e *failoverExecutor) Execute(
+	l *Logger, exec []byte, timeout time.Duration, arg string,
+) ([]byte, uint32) {
+	tried := false
+	for _, b := range e.backends {
+		if !b.acquire(time.Now()) {
+			continue
+		}
+		tried = true
+		if result, exitCode, ok := e.tryBackend(l, b, exec, timeout, arg); ok {
+			return result, exitCode
+		}
+	}
+	if !tried {
+		// Rather than failing right away, try all executors in case any of them recovers.
+		l.Error(":warning: No executor is available, trying all executors")
+		for _, b := range e.backends {
+			if result, exitCode, ok := e.tryBackend(l, b, exec, timeout, arg); ok {
+				return result, exitCode
+			}
+		}
+	}
+	l.Error(":skull: All executors failed")
+	return []byte("EXECUTION_ERROR"), 255
+}
+
+// tryBackend runs the executable on the given executor, retrying on failure. Returns false if all
+// attempts fail.
+func (e *failoverExecutor) tryBackend(
+	l *Logger, b *executorBackend, exec []byte, timeout time.Duration, arg string,
+) ([]byte, uint32, bool) {
+	for attempt := 1; attempt <= ExecutorMaxAttempts; attempt++ {
+		if attempt > 1 {
+			time.Sleep(ExecutorRetryDelay)
+		}
+		result, exitCode, err := b.executor.TryExecute(l, exec, timeout, arg)
+		if err == nil {
+			b.recordSuccess()
+			l.Info(":gear: Executed with executor %s, exit code: %d", b.name, exitCode)
+			return result, exitCode, true
+		}
+		executorFailures.WithLabelValues(b.name).Inc()
+		l.Error(":skull: Executor %s failed on attempt %d with error: %s", b.name, attempt, err.Error())
+	}
+	b.recordFailure(time.Now())
+	return nil, 0, false
+}
+
+// checkHealth runs the health probe executable on all executors and updates their health.
+func (e *failoverExecutor) checkHealth(l *Logger) {
+	for _, b := range e.backends {
+		result, exitCode, err := b.executor.TryExecute(l, HealthCheckExecutable, HealthCheckTimeout, "")
+		if err == nil && exitCode == 0 {
+			b.recordSuccess()
+			executorHealthy.WithLabelValues(b.name).Set(1)
+			continue
+		}
+		if err != nil {
+			l.Error(":hospital: Executor %s failed health probe with error: %s", b.name, err.Error())
+		} else {
+			l.Error(":hospital: Executor %s failed health probe with exit code %d: %q", b.name, exitCode, result)
+		}
+		b.setUnhealthy()
+		executorHealthy.WithLabelValues(b.name).Set(0)
+	}
+}
+
+// RunHealthChecks probes the health of all executors periodically. Never returns.
+func (e *failoverExecutor) RunHealthChecks(l *Logger) {
+	for {
+		e.checkHealth(l)
+		time.Sleep(HealthCheckInterval)
+	}
+}
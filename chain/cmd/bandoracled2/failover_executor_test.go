@@ -0,0 +1,140 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExecutor returns the given results in order, then keeps returning the last one.
+type fakeExecutor struct {
+	results []fakeResult
+	calls   int
+}
+
+type fakeResult struct {
+	result   []byte
+	exitCode uint32
+	err      error
+}
+
+func (e *fakeExecutor) Execute(l *Logger, exec []byte, timeout time.Duration, arg string) ([]byte, uint32) {
+	return executeOrError(e, l, exec, timeout, arg)
+}
+
+func (e *fakeExecutor) TryExecute(l *Logger, exec []byte, timeout time.Duration, arg string) ([]byte, uint32, error) {
+	r := e.results[len(e.results)-1]
+	if e.calls < len(e.results) {
+		r = e.results[e.calls]
+	}
+	e.calls++
+	return r.result, r.exitCode, r.err
+}
+
+var errExecutorDown = errors.New("executor is down")
+
+func newTestFailoverExecutor(executors ...*fakeExecutor) *failoverExecutor {
+	e := &failoverExecutor{}
+	for i, exec := range executors {
+		e.backends = append(e.backends, &executorBackend{
+			name: string(rune('A' + i)), executor: exec, healthy: true,
+		})
+	}
+	return e
+}
+
+func TestNewFailoverExecutor(t *testing.T) {
+	e, err := NewFailoverExecutor("local:cpu=1  lambda:https://executor.band  ")
+	require.NoError(t, err)
+	require.Len(t, e.backends, 2)
+	require.Equal(t, "local:cpu=1", e.backends[0].name)
+	require.Equal(t, &localExecutor{MaxCPUTime: 1}, e.backends[0].executor)
+	require.Equal(t, &restExecutor{Name: "lambda", URL: "https://executor.band"}, e.backends[1].executor)
+	_, err = NewFailoverExecutor(" ")
+	require.Error(t, err)
+	_, err = NewFailoverExecutor("local: unknown:url")
+	require.Error(t, err)
+}
+
+func TestFailoverExecutorRetry(t *testing.T) {
+	// A script failure is a result, not a reason to fail over.
+	a := &fakeExecutor{results: []fakeResult{{err: errExecutorDown}, {result: []byte("Stderr"), exitCode: 1}}}
+	b := &fakeExecutor{results: []fakeResult{{result: []byte("BEEB")}}}
+	e := newTestFailoverExecutor(a, b)
+	res, exitCode := e.Execute(getLog(), []byte("executable"), time.Second, "")
+	require.Equal(t, []byte("Stderr"), res)
+	require.Equal(t, uint32(1), exitCode)
+	require.Equal(t, 2, a.calls)
+	require.Equal(t, 0, b.calls)
+}
+
+func TestFailoverExecutorFailover(t *testing.T) {
+	a := &fakeExecutor{results: []fakeResult{{err: errExecutorDown}}}
+	b := &fakeExecutor{results: []fakeResult{{result: []byte("BEEB")}}}
+	e := newTestFailoverExecutor(a, b)
+	res, exitCode := e.Execute(getLog(), []byte("executable"), time.Second, "")
+	require.Equal(t, []byte("BEEB"), res)
+	require.Equal(t, uint32(0), exitCode)
+	require.Equal(t, ExecutorMaxAttempts, a.calls)
+	require.Equal(t, 1, b.calls)
+
+	// All executors failing is an execution error.
+	b.results = []fakeResult{{err: errExecutorDown}}
+	res, exitCode = e.Execute(getLog(), []byte("executable"), time.Second, "")
+	require.Equal(t, []byte("EXECUTION_ERROR"), res)
+	require.Equal(t, uint32(255), exitCode)
+}
+
+func TestFailoverExecutorCircuitBreaker(t *testing.T) {
+	a := &fakeExecutor{results: []fakeResult{{err: errExecutorDown}}}
+	b := &fakeExecutor{results: []fakeResult{{result: []byte("BEEB")}}}
+	e := newTestFailoverExecutor(a, b)
+	for i := 0; i < BreakerFailureThreshold; i++ {
+		e.Execute(getLog(), []byte("executable"), time.Second, "")
+	}
+	require.Equal(t, BreakerFailureThreshold*ExecutorMaxAttempts, a.calls)
+	// The circuit of A is open, so calls go straight to B.
+	e.Execute(getLog(), []byte("executable"), time.Second, "")
+	require.Equal(t, BreakerFailureThreshold*ExecutorMaxAttempts, a.calls)
+
+	// After the cooldown, one call goes to A and closes the circuit on success.
+	e.backends[0].openUntil = time.Now().Add(-time.Second)
+	require.True(t, e.backends[0].acquire(time.Now()))
+	require.False(t, e.backends[0].acquire(time.Now()))
+	e.backends[0].trial = false
+	a.results = []fakeResult{{result: []byte("A")}}
+	a.calls = 0
+	res, _ := e.Execute(getLog(), []byte("executable"), time.Second, "")
+	require.Equal(t, []byte("A"), res)
+	require.Equal(t, 0, e.backends[0].failures)
+}
+
+func TestFailoverExecutorHealthCheck(t *testing.T) {
+	a := &fakeExecutor{results: []fakeResult{{err: errExecutorDown}}}
+	b := &fakeExecutor{results: []fakeResult{{result: []byte("ok\n")}}}
+	e := newTestFailoverExecutor(a, b)
+	e.checkHealth(getLog())
+	require.False(t, e.backends[0].healthy)
+	require.True(t, e.backends[1].healthy)
+
+	// Unhealthy executors are skipped.
+	a.calls, b.calls = 0, 0
+	b.results = []fakeResult{{result: []byte("BEEB")}}
+	res, _ := e.Execute(getLog(), []byte("executable"), time.Second, "")
+	require.Equal(t, []byte("BEEB"), res)
+	require.Equal(t, 0, a.calls)
+
+	// If no executor is available, all are tried anyway.
+	e.backends[1].setUnhealthy()
+	a.results = []fakeResult{{result: []byte("A")}}
+	res, _ = e.Execute(getLog(), []byte("executable"), time.Second, "")
+	require.Equal(t, []byte("A"), res)
+	require.True(t, e.backends[0].healthy)
+
+	// A passing health probe marks the executor healthy again.
+	b.results = []fakeResult{{result: []byte("ok\n")}}
+	e.checkHealth(getLog())
+	require.True(t, e.backends[1].healthy)
+}
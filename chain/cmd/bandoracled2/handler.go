@@ -1,27 +1,27 @@
 package main
 
 import (
+	"crypto/rand"
 	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/tendermint/tendermint/crypto/tmhash"
-	tmtypes "github.com/tendermint/tendermint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
 )
 
-func handleTransaction(c *Context, l *Logger, tx tmtypes.TxResult) {
-	l.Debug(":eyes: Inspecting incoming transaction: %X", tmhash.Sum(tx.Tx))
-	if tx.Result.Code != 0 {
-		l.Debug(":alien: Skipping transaction with non-zero code: %d", tx.Result.Code)
-		return
+// getTransactionLogs returns the logs of the request messages in the given transaction.
+func getTransactionLogs(l *Logger, tx *abci.ResponseDeliverTx) (res []sdk.ABCIMessageLog) {
+	if tx.Code != 0 {
+		l.Debug(":alien: Skipping transaction with non-zero code: %d", tx.Code)
+		return nil
 	}
 
-	logs, err := sdk.ParseABCILogs(tx.Result.Log)
+	logs, err := sdk.ParseABCILogs(tx.Log)
 	if err != nil {
 		l.Error(":cold_sweat: Failed to parse transaction logs with error: %s", err.Error())
-		return
+		return nil
 	}
 
 	for _, log := range logs {
@@ -32,7 +32,7 @@ func handleTransaction(c *Context, l *Logger, tx tmtypes.TxResult) {
 		}
 
 		if messageType == (otypes.MsgRequestData{}).Type() {
-			go handleRequestLog(c, l, log)
+			res = append(res, log)
 		} else {
 			l.Debug(":ghost: Skipping non-{request/packet} type: %s", messageType)
 		} /*else if messageType == (ibc.MsgPacket{}).Type() {
@@ -42,25 +42,89 @@ func handleTransaction(c *Context, l *Logger, tx tmtypes.TxResult) {
 				l.Debug(":ghost: Skipping non-request packet")
 				return
 			}
-			go handleRequestLog(c, l, log)
+			res = append(res, log)
 		} */
 	}
+	return res
 }
 
-func handleRequestLog(c *Context, l *Logger, log sdk.ABCIMessageLog) {
+// handleBlock saves a job for every request in the given block that this validator must report
+// to, then marks the block as processed. Returns the saved jobs, or an error if the block must be
+// handled again. Requests that expire by the given latest height are skipped. If the results of
+// the block cannot be fetched MaxBlockResultsFailures times in a row, the block is skipped so
+// that the daemon can move on to newer blocks.
+func handleBlock(c *Context, l *Logger, height int64, latestHeight int64) ([]job, error) {
+	l.Debug(":eyes: Inspecting block: %d", height)
+	res, err := c.client.BlockResults(&height)
+	if err != nil {
+		c.blockResultsFailures++
+		if c.blockResultsFailures < MaxBlockResultsFailures {
+			return nil, err
+		}
+		c.blockResultsFailures = 0
+		l.Error(":skull: Skipping block %d after failing to get its results %d times with error: %s",
+			height, MaxBlockResultsFailures, err.Error())
+		return nil, c.jobs.SetLastHeight(height)
+	}
+	c.blockResultsFailures = 0
+
+	// Standing requests are sent at BeginBlock, so their events are not part of any transaction.
+	logs := GetRequestLogs(res.BeginBlockEvents)
+	for idx, tx := range res.TxsResults {
+		logs = append(logs, getTransactionLogs(l.With("tx", idx), tx)...)
+	}
+
+	var jobs []job
+	var params *otypes.Params
+	for _, log := range logs {
+		j, ok := newJob(c, l, log, height)
+		if !ok {
+			continue
+		}
+		// The job is already saved if the daemon stopped before marking this block as processed.
+		if has, err := c.jobs.HasJob(j.RequestID); err != nil {
+			return nil, err
+		} else if has {
+			continue
+		}
+		if params == nil {
+			p, err := GetParams(c)
+			if err != nil {
+				return nil, err
+			}
+			params = &p
+		}
+		j.ExpirationHeight = height + int64(params.ExpirationBlockCount)
+		if j.ExpirationHeight <= latestHeight {
+			l.Info(":hourglass: Skipping request %d expired at block %d", j.RequestID, j.ExpirationHeight)
+			continue
+		}
+		if err := c.jobs.SaveJob(j); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+	if err := c.jobs.SetLastHeight(height); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// newJob creates the job of the request in the given log. Returns false if the log is invalid or
+// the request is not assigned to this validator.
+func newJob(c *Context, l *Logger, log sdk.ABCIMessageLog, height int64) (job, bool) {
 	idStr, err := GetEventValue(log, otypes.EventTypeRequest, otypes.AttributeKeyID)
 	if err != nil {
 		l.Error(":cold_sweat: Failed to parse request id with error: %s", err.Error())
-		return
+		return job{}, false
 	}
 
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
 		l.Error(":cold_sweat: Failed to convert %s to integer with error: %s", idStr, err.Error())
-		return
+		return job{}, false
 	}
-
-	l = l.With("rid", id)
+	requestsSeen.Inc()
 
 	// Skip if not related to this validator
 	validators := GetEventValues(log, otypes.EventTypeRequest, otypes.AttributeKeyValidator)
@@ -73,13 +137,100 @@ func handleRequestLog(c *Context, l *Logger, log sdk.ABCIMessageLog) {
 	}
 
 	if !hasMe {
-		l.Debug(":next_track_button: Skip request not related to this validator")
-		return
+		l.With("rid", id).Debug(":next_track_button: Skip request not related to this validator")
+		return job{}, false
 	}
+	requestsAssigned.Inc()
+	return job{RequestID: otypes.RequestID(id), Height: height, Log: log}, true
+}
 
-	l.Info(":delivery_truck: Processing incoming request event")
+// processJob executes the data sources of the given job and reports the results, either directly
+// or through commit-reveal, resuming from the last step saved. Every failed step is retried until
+// the request expires. The job is removed once reported, or once expired.
+func processJob(c *Context, l *Logger, j job) {
+	if j.Reports == nil {
+		l.Info(":delivery_truck: Processing incoming request event")
+		j.Reports = executeJob(c, l, j)
+		// Reports are saved so that a resumed job reveals exactly what it commits to.
+		if err := c.jobs.SaveJob(j); err != nil {
+			l.Error(":skull: Failed to save job with error: %s", err.Error())
+		}
+	} else {
+		l.Info(":arrows_counterclockwise: Resuming request from saved job")
+	}
 
-	reqs, err := GetRawRequests(log)
+	if j.Salt == nil {
+		var params otypes.Params
+		if !retryUntilExpired(c, l, j, func() (err error) {
+			params, err = GetParams(c)
+			return err
+		}) {
+			deleteJob(c, l, j.RequestID)
+			return
+		}
+		if params.CommitBlockCount == 0 {
+			retryUntilExpired(c, l, j, func() error {
+				return SubmitReport(c, l, j.RequestID, j.Reports)
+			})
+			deleteJob(c, l, j.RequestID)
+			return
+		}
+		// Commit-reveal is enabled. The salt is saved before committing, so the commit is the same
+		// if the daemon restarts before it is included in a block.
+		j.Salt = make([]byte, 32)
+		if _, err := rand.Read(j.Salt); err != nil {
+			l.Error(":skull: Failed to generate salt with error: %s", err.Error())
+			return
+		}
+		j.RevealHeight = j.Height + int64(params.CommitBlockCount)
+		if err := c.jobs.SaveJob(j); err != nil {
+			l.Error(":skull: Failed to save job with error: %s", err.Error())
+		}
+	}
+
+	if !j.Committed {
+		hash := otypes.ReportCommitHash(j.RequestID, c.validator, j.Reports, j.Salt)
+		if !retryUntilExpired(c, l, j, func() error {
+			return SubmitCommitReport(c, l, j.RequestID, hash)
+		}) {
+			deleteJob(c, l, j.RequestID)
+			return
+		}
+		j.Committed = true
+		if err := c.jobs.SaveJob(j); err != nil {
+			l.Error(":skull: Failed to save job with error: %s", err.Error())
+		}
+	}
+
+	// Reveal the reports after the commit window.
+	c.reveals.Add(pendingReveal{
+		requestID:        j.RequestID,
+		reports:          j.Reports,
+		salt:             j.Salt,
+		revealHeight:     j.RevealHeight,
+		expirationHeight: j.ExpirationHeight,
+		logger:           l,
+	})
+}
+
+// revealJob reveals the committed reports of the given pending reveal and removes its job.
+func revealJob(c *Context, reveal pendingReveal) {
+	j := job{RequestID: reveal.requestID, ExpirationHeight: reveal.expirationHeight}
+	retryUntilExpired(c, reveal.logger, j, func() error {
+		return SubmitRevealReport(c, reveal.logger, reveal.requestID, reveal.reports, reveal.salt)
+	})
+	deleteJob(c, reveal.logger, reveal.requestID)
+}
+
+func deleteJob(c *Context, l *Logger, id otypes.RequestID) {
+	if err := c.jobs.DeleteJob(id); err != nil {
+		l.Error(":skull: Failed to delete job with error: %s", err.Error())
+	}
+}
+
+// executeJob executes the data sources of the given job and returns the raw reports.
+func executeJob(c *Context, l *Logger, j job) []otypes.RawReport {
+	reqs, err := GetRawRequests(j.Log)
 	if err != nil {
 		l.Error(":skull: Failed to parse raw requests with error: %s", err.Error())
 	}
@@ -95,7 +246,11 @@ func handleRequestLog(c *Context, l *Logger, log sdk.ABCIMessageLog) {
 				)
 				return
 			}
+			dataSourceID := strconv.FormatInt(int64(req.dataSourceID), 10)
+			start := time.Now()
 			result, exitCode := c.executor.Execute(l, exec, 3*time.Second, req.calldata)
+			executionDuration.WithLabelValues(dataSourceID).Observe(time.Since(start).Seconds())
+			executions.WithLabelValues(dataSourceID, strconv.FormatUint(uint64(exitCode), 10)).Inc()
 			l.Debug(
 				":sparkles: Query data done with calldata: %q, result: %q, exitCode: %d",
 				req.calldata, result, exitCode,
@@ -108,6 +263,5 @@ func handleRequestLog(c *Context, l *Logger, log sdk.ABCIMessageLog) {
 	for range reqs {
 		reports = append(reports, <-reportsChan)
 	}
-
-	SubmitReport(c, l, otypes.RequestID(id), reports)
+	return reports
 }
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// missingResultsClient is a node client that never has the results of any block.
+type missingResultsClient struct {
+	rpcclient.Client
+}
+
+func (missingResultsClient) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	return nil, errors.New("could not find results for height")
+}
+
+func TestHandleBlockSkipsMissingResults(t *testing.T) {
+	c := &Context{client: missingResultsClient{}, jobs: newJobStore(dbm.NewMemDB())}
+	require.NoError(t, c.jobs.SetLastHeight(9))
+	// The block is retried until the results cannot be fetched MaxBlockResultsFailures times.
+	for i := 1; i < MaxBlockResultsFailures; i++ {
+		_, err := handleBlock(c, getLog(), 10, 20)
+		require.Error(t, err)
+		height, err := c.jobs.LastHeight()
+		require.NoError(t, err)
+		require.Equal(t, int64(9), height)
+	}
+	// Then the block is skipped, so that catching up continues from the next block.
+	jobs, err := handleBlock(c, getLog(), 10, 20)
+	require.NoError(t, err)
+	require.Empty(t, jobs)
+	height, err := c.jobs.LastHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dbm "github.com/tendermint/tm-db"
+
+	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+var (
+	// LastHeightKey is the key to the height of the last block whose requests are saved as jobs.
+	LastHeightKey = []byte{0x00}
+	// JobKeyPrefix is the prefix of the keys to the jobs of the requests not yet reported.
+	JobKeyPrefix = []byte{0x01}
+)
+
+// job is a request that this validator must report to. It is saved before any work is done and
+// updated after every step, so the daemon can resume it after a restart without doing a step twice.
+type job struct {
+	RequestID        otypes.RequestID   `json:"request_id"`
+	Height           int64              `json:"height"`
+	ExpirationHeight int64              `json:"expiration_height"`
+	Log              sdk.ABCIMessageLog `json:"log"`
+	// Reports is set once the data sources are executed.
+	Reports []otypes.RawReport `json:"reports"`
+	// Salt and RevealHeight are set once the job chooses to report through commit-reveal.
+	Salt         []byte `json:"salt"`
+	RevealHeight int64  `json:"reveal_height"`
+	Committed    bool   `json:"committed"`
+}
+
+// jobStore persists the jobs and the last processed block height in a local database.
+type jobStore struct {
+	db dbm.DB
+}
+
+// newJobStore creates a job store backed by the given database.
+func newJobStore(db dbm.DB) *jobStore {
+	return &jobStore{db: db}
+}
+
+func jobKey(id otypes.RequestID) []byte {
+	return append(append([]byte{}, JobKeyPrefix...), sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// LastHeight returns the height of the last processed block, or zero if no block is processed.
+func (s *jobStore) LastHeight() (int64, error) {
+	bz, err := s.db.Get(LastHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// SetLastHeight saves the height of the last processed block.
+func (s *jobStore) SetLastHeight(height int64) error {
+	return s.db.SetSync(LastHeightKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// HasJob returns whether the job of the given request exists.
+func (s *jobStore) HasJob(id otypes.RequestID) (bool, error) {
+	return s.db.Has(jobKey(id))
+}
+
+// SaveJob saves the given job, replacing the existing job of the same request.
+func (s *jobStore) SaveJob(j job) error {
+	bz, err := json.Marshal(j)
+	if err != nil {
+		return err
+	}
+	return s.db.SetSync(jobKey(j.RequestID), bz)
+}
+
+// DeleteJob removes the job of the given request.
+func (s *jobStore) DeleteJob(id otypes.RequestID) error {
+	return s.db.DeleteSync(jobKey(id))
+}
+
+// Jobs returns all saved jobs ordered by request ID.
+func (s *jobStore) Jobs() ([]job, error) {
+	iterator, err := dbm.IteratePrefix(s.db, JobKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+	var jobs []job
+	for ; iterator.Valid(); iterator.Next() {
+		var j job
+		if err := json.Unmarshal(iterator.Value(), &j); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestJobStoreLastHeight(t *testing.T) {
+	s := newJobStore(dbm.NewMemDB())
+	height, err := s.LastHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), height)
+	require.NoError(t, s.SetLastHeight(42))
+	height, err = s.LastHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(42), height)
+}
+
+func TestJobStoreJobs(t *testing.T) {
+	s := newJobStore(dbm.NewMemDB())
+	require.NoError(t, s.SetLastHeight(300))
+	require.NoError(t, s.SaveJob(job{RequestID: 256, Height: 10, ExpirationHeight: 40}))
+	require.NoError(t, s.SaveJob(job{RequestID: 2, Height: 5, ExpirationHeight: 35}))
+	has, err := s.HasJob(2)
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = s.HasJob(3)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Updating a job replaces its saved state.
+	reports := []otypes.RawReport{otypes.NewRawReport(1, 0, []byte("BEEB"))}
+	require.NoError(t, s.SaveJob(job{
+		RequestID: 2, Height: 5, ExpirationHeight: 35, Reports: reports, Salt: []byte("salt"), RevealHeight: 8,
+	}))
+	jobs, err := s.Jobs()
+	require.NoError(t, err)
+	require.Equal(t, []job{
+		{RequestID: 2, Height: 5, ExpirationHeight: 35, Reports: reports, Salt: []byte("salt"), RevealHeight: 8},
+		{RequestID: 256, Height: 10, ExpirationHeight: 40},
+	}, jobs)
+
+	require.NoError(t, s.DeleteJob(2))
+	jobs, err = s.Jobs()
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, otypes.RequestID(256), jobs[0].RequestID)
+}
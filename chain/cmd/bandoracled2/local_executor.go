@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// LocalExecutorPath is the only environment variable given to data source executables, so that
+// they can find common interpreters such as python3 or curl.
+const LocalExecutorPath = "/usr/local/bin:/usr/bin:/bin"
+
+// localExecutor runs data source executables as subprocesses on this machine. Each execution gets
+// its own temporary working directory and a clean environment, and the whole process group is
+// killed once the timeout passes.
+type localExecutor struct {
+	MaxMemory  uint64 // Max virtual memory of the subprocess in megabytes, zero for no limit
+	MaxCPUTime uint64 // Max CPU time of the subprocess in seconds, zero for no limit
+}
+
+// newLocalExecutor creates a local executor with the given comma-separated resource limits,
+// such as "memory=256,cpu=2".
+func newLocalExecutor(options string) (*localExecutor, error) {
+	e := &localExecutor{}
+	if options == "" {
+		return e, nil
+	}
+	for _, option := range strings.Split(options, ",") {
+		kv := strings.SplitN(option, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("Invalid local executor option: %s", option)
+		}
+		value, err := strconv.ParseUint(kv[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid local executor option value: %s", option)
+		}
+		switch kv[0] {
+		case "memory":
+			e.MaxMemory = value
+		case "cpu":
+			e.MaxCPUTime = value
+		default:
+			return nil, fmt.Errorf("Unknown local executor option: %s", kv[0])
+		}
+	}
+	return e, nil
+}
+
+// script returns the shell script that applies the resource limits and replaces itself with the
+// executable given as $0.
+func (e *localExecutor) script() string {
+	var limits []string
+	if e.MaxMemory != 0 {
+		limits = append(limits, fmt.Sprintf("ulimit -v %d", e.MaxMemory*1024))
+	}
+	if e.MaxCPUTime != 0 {
+		limits = append(limits, fmt.Sprintf("ulimit -t %d", e.MaxCPUTime))
+	}
+	return strings.Join(append(limits, `exec "$0" "$@"`), " && ")
+}
+
+func (e *localExecutor) Execute(
+	l *Logger, executable []byte, timeout time.Duration, arg string,
+) ([]byte, uint32) {
+	return executeOrError(e, l, executable, timeout, arg)
+}
+
+// TryExecute runs the given executable. Failures of the executable itself, including timeouts and
+// oversized output, are execution error results, while failures to run it are errors.
+func (e *localExecutor) TryExecute(
+	l *Logger, executable []byte, timeout time.Duration, arg string,
+) ([]byte, uint32, error) {
+	args, err := splitArgs(arg)
+	if err != nil {
+		l.Error(":skull: LocalExecutor failed to parse calldata with error: %s", err.Error())
+		return []byte("EXECUTION_ERROR"), 255, nil
+	}
+
+	dir, err := ioutil.TempDir("", "bandoracled")
+	if err != nil {
+		return nil, 0, fmt.Errorf("LocalExecutor failed to create working directory with error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "exec")
+	if err := ioutil.WriteFile(path, executable, 0700); err != nil {
+		return nil, 0, fmt.Errorf("LocalExecutor failed to write executable with error: %s", err.Error())
+	}
+
+	cmd := exec.Command("/bin/sh", append([]string{"-c", e.script(), path}, args...)...)
+	cmd.Dir = dir
+	cmd.Env = []string{"PATH=" + LocalExecutorPath, "HOME=" + dir}
+	// Run in a new process group, so processes spawned by the executable are killed with it.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	stdout := &limitedBuffer{limit: otypes.MaxDataSize}
+	stderr := &limitedBuffer{limit: otypes.MaxDataSize}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Start(); err != nil {
+		return nil, 0, fmt.Errorf("LocalExecutor failed to start with error: %s", err.Error())
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case err = <-done:
+	case <-time.After(timeout):
+		syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		<-done
+		l.Error(":skull: LocalExecutor timed out after %s", timeout)
+		return []byte("EXECUTION_ERROR"), 255, nil
+	}
+
+	var exitCode uint32
+	if err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() < 0 {
+			l.Error(":skull: LocalExecutor failed with error: %s", err.Error())
+			return []byte("EXECUTION_ERROR"), 255, nil
+		}
+		exitCode = uint32(exitErr.ExitCode())
+	}
+
+	output := stdout
+	if exitCode != 0 {
+		output = stderr
+	}
+	if output.overflow {
+		l.Error(":skull: LocalExecutor output exceeds %d bytes", otypes.MaxDataSize)
+		return []byte("EXECUTION_ERROR"), 255, nil
+	}
+	return output.buf.Bytes(), exitCode, nil
+}
+
+// limitedBuffer keeps up to limit bytes written to it and discards the rest, so a data source
+// cannot exhaust the memory of the daemon with its output.
+type limitedBuffer struct {
+	buf      bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.buf.Len(); len(p) > remaining {
+		b.overflow = true
+		b.buf.Write(p[:remaining])
+	} else {
+		b.buf.Write(p)
+	}
+	// Report the whole input as written, so the subprocess does not fail on a broken pipe.
+	return len(p), nil
+}
+
+// splitArgs splits the given calldata into arguments following shell rules for whitespace, quotes
+// and backslash escapes, without expanding variables or running commands.
+func splitArgs(s string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+	for _, c := range s {
+		switch {
+		case escaped:
+			arg.WriteRune(c)
+			escaped = false
+		case c == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				arg.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case c == ' ' || c == '\t' || c == '\n':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(c)
+			inArg = true
+		}
+	}
+	if escaped || quote != 0 {
+		return nil, fmt.Errorf("Unterminated quote or escape in calldata: %q", s)
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestNewLocalExecutor(t *testing.T) {
+	e, err := NewExecutor("local:")
+	require.NoError(t, err)
+	require.Equal(t, &localExecutor{}, e)
+	e, err = NewExecutor("local:memory=256,cpu=2")
+	require.NoError(t, err)
+	require.Equal(t, &localExecutor{MaxMemory: 256, MaxCPUTime: 2}, e)
+	require.Equal(t, `ulimit -v 262144 && ulimit -t 2 && exec "$0" "$@"`, e.(*localExecutor).script())
+	_, err = NewExecutor("local:memory")
+	require.Error(t, err)
+	_, err = NewExecutor("local:memory=abc")
+	require.Error(t, err)
+	_, err = NewExecutor("local:disk=1")
+	require.Error(t, err)
+}
+
+func TestLocalExecuteSuccess(t *testing.T) {
+	e := &localExecutor{MaxMemory: 256, MaxCPUTime: 2}
+	res, exitCode := e.Execute(getLog(), []byte("#!/bin/sh\necho $# \"$1\" \"$2\""), 1*time.Second, `BTC "hello world"`)
+	require.Equal(t, uint32(0), exitCode)
+	require.Equal(t, []byte("2 BTC hello world\n"), res)
+}
+
+func TestLocalExecuteCleanEnvironment(t *testing.T) {
+	os.Setenv("BANDORACLED_SECRET", "secret")
+	defer os.Unsetenv("BANDORACLED_SECRET")
+	e := &localExecutor{}
+	res, exitCode := e.Execute(getLog(), []byte("#!/bin/sh\necho \"$BANDORACLED_SECRET\" && pwd"), 1*time.Second, "")
+	require.Equal(t, uint32(0), exitCode)
+	lines := strings.Split(string(res), "\n")
+	require.Equal(t, "", lines[0])
+	require.NotEqual(t, "", lines[1])
+	// The working directory is removed after execution.
+	_, err := os.Stat(lines[1])
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestLocalExecuteFail(t *testing.T) {
+	e := &localExecutor{}
+	res, exitCode := e.Execute(getLog(), []byte("#!/bin/sh\necho BEEB\necho Stderr >&2\nexit 3"), 1*time.Second, "")
+	require.Equal(t, uint32(3), exitCode)
+	require.Equal(t, []byte("Stderr\n"), res)
+}
+
+func TestLocalExecuteTimeout(t *testing.T) {
+	e := &localExecutor{}
+	start := time.Now()
+	res, exitCode := e.Execute(getLog(), []byte("#!/bin/sh\nsleep 10 &\nsleep 10"), 100*time.Millisecond, "")
+	require.True(t, time.Since(start) < 5*time.Second)
+	require.Equal(t, uint32(255), exitCode)
+	require.Equal(t, []byte("EXECUTION_ERROR"), res)
+}
+
+func TestLocalExecuteOutputTooLarge(t *testing.T) {
+	e := &localExecutor{}
+	script := "#!/bin/sh\nhead -c 10000 /dev/zero"
+	res, exitCode := e.Execute(getLog(), []byte(script), 1*time.Second, "")
+	require.Equal(t, uint32(255), exitCode)
+	require.Equal(t, []byte("EXECUTION_ERROR"), res)
+	// Output of exactly max data size is fine.
+	script = "#!/bin/sh\nhead -c 1024 /dev/zero"
+	res, exitCode = e.Execute(getLog(), []byte(script), 1*time.Second, "")
+	require.Equal(t, uint32(0), exitCode)
+	require.Len(t, res, otypes.MaxDataSize)
+}
+
+func TestLocalExecuteInvalidExecutable(t *testing.T) {
+	e := &localExecutor{}
+	res, exitCode := e.Execute(getLog(), []byte("\x00\x01\x02"), 1*time.Second, "")
+	require.NotEqual(t, uint32(0), exitCode)
+	require.NotEmpty(t, res)
+}
+
+func TestSplitArgs(t *testing.T) {
+	args, err := splitArgs(`  BTC  'a b' "c \"d\"" e\ f '' `)
+	require.NoError(t, err)
+	require.Equal(t, []string{"BTC", "a b", `c "d"`, "e f", ""}, args)
+	args, err = splitArgs("")
+	require.NoError(t, err)
+	require.Empty(t, args)
+	_, err = splitArgs(`"unterminated`)
+	require.Error(t, err)
+	_, err = splitArgs(`trailing\`)
+	require.Error(t, err)
+}
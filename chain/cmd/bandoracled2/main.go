@@ -18,16 +18,18 @@ const (
 	flagValidator = "validator"
 	flagLogLevel  = "log-level"
 	flagExecutor  = "executor"
+	flagMetrics   = "metrics-listen-addr"
 )
 
 // Config data structure for bandoracled daemon.
 type Config struct {
-	ChainID   string `mapstructure:"chain-id"`   // ChainID of the target chain
-	NodeURI   string `mapstructure:"node"`       // Remote RPC URI of BandChain node to connect to
-	Validator string `mapstructure:"validator"`  // The validator address that I'm responsible for
-	GasPrices string `mapstructure:"gas-prices"` // Gas prices of the transaction
-	LogLevel  string `mapstructure:"log-level"`  // Log level of the logger
-	Executor  string `mapstructure:"executor"`   // Executor name and URL (example: "Executor name:URL")
+	ChainID   string `mapstructure:"chain-id"`            // ChainID of the target chain
+	NodeURI   string `mapstructure:"node"`                // Remote RPC URI of BandChain node to connect to
+	Validator string `mapstructure:"validator"`           // The validator address that I'm responsible for
+	GasPrices string `mapstructure:"gas-prices"`          // Gas prices of the transaction
+	LogLevel  string `mapstructure:"log-level"`           // Log level of the logger
+	Executor  string `mapstructure:"executor"`            // Executors in priority order (example: "Executor name:URL local:memory=256,cpu=2")
+	Metrics   string `mapstructure:"metrics-listen-addr"` // Address to serve Prometheus metrics at, empty to disable
 }
 
 // Global instances.
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MetricsNamespace is the namespace of all metrics of the daemon.
+const MetricsNamespace = "bandoracled"
+
+var (
+	requestsSeen = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "requests_seen_total",
+		Help:      "Number of requests seen in blocks.",
+	})
+	requestsAssigned = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "requests_assigned_total",
+		Help:      "Number of requests assigned to this validator.",
+	})
+	executionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: MetricsNamespace,
+		Name:      "execution_duration_seconds",
+		Help:      "Time taken by the executor to run a data source.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 3, 5, 10},
+	}, []string{"data_source_id"})
+	executions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "executions_total",
+		Help:      "Number of data source executions by exit code.",
+	}, []string{"data_source_id", "exit_code"})
+	executorFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "executor_failures_total",
+		Help:      "Number of failed attempts to run a data source on each executor.",
+	}, []string{"executor"})
+	executorHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: MetricsNamespace,
+		Name:      "executor_healthy",
+		Help:      "Whether each executor passed its last health probe.",
+	}, []string{"executor"})
+	executableCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "executable_cache_lookups_total",
+		Help:      "Number of data source executable lookups in the file cache, by hit or miss.",
+	}, []string{"result"})
+	broadcastTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "broadcast_txs_total",
+		Help:      "Number of broadcast transactions, by success or failure.",
+	}, []string{"result"})
+	txMessages = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: MetricsNamespace,
+		Name:      "tx_messages",
+		Help:      "Number of messages packed into a transaction.",
+		Buckets:   prometheus.LinearBuckets(1, 1, MaxMessagesPerTx),
+	})
+	reporterTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "reporter_txs_total",
+		Help:      "Number of transactions signed by each reporter key.",
+	}, []string{"key"})
+	reporterKeysInUse = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: MetricsNamespace,
+		Name:      "reporter_keys_in_use",
+		Help:      "Number of reporter keys taken from the key pool.",
+	})
+)
+
+// serveMetrics serves the Prometheus metrics at /metrics on the given address. Never returns
+// unless the server fails.
+func serveMetrics(l *Logger, addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	l.Info(":bar_chart: Serving metrics at %s/metrics", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		l.Error(":cold_sweat: Failed to serve metrics with error: %s", err.Error())
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/pkg/filecache"
+)
+
+func TestGetExecutableCacheHitMetric(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bandoracled")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	c := &Context{fileCache: filecache.New(dir)}
+	hash := c.fileCache.AddFile([]byte("#!/bin/sh\necho 'executable from cache'"))
+
+	before := testutil.ToFloat64(executableCacheLookups.WithLabelValues("hit"))
+	exec, err := GetExecutable(c, getLog(), hash)
+	require.NoError(t, err)
+	require.Equal(t, []byte("#!/bin/sh\necho 'executable from cache'"), exec)
+	require.Equal(t, before+1, testutil.ToFloat64(executableCacheLookups.WithLabelValues("hit")))
+}
+
+func TestMetricsHandler(t *testing.T) {
+	broadcastTxs.WithLabelValues("success").Inc()
+	reporterTxs.WithLabelValues("reporter").Inc()
+	w := httptest.NewRecorder()
+	promhttp.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, 200, w.Code)
+	body := w.Body.String()
+	for _, metric := range []string{
+		"bandoracled_requests_seen_total",
+		"bandoracled_requests_assigned_total",
+		`bandoracled_broadcast_txs_total{result="success"}`,
+		`bandoracled_reporter_txs_total{key="reporter"}`,
+		"bandoracled_reporter_keys_in_use",
+	} {
+		require.True(t, strings.Contains(body, metric), metric)
+	}
+}
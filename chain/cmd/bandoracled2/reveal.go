@@ -0,0 +1,46 @@
+package main
+
+import (
+	"sync"
+
+	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// pendingReveal is a committed report waiting for the commit window to close to be revealed.
+type pendingReveal struct {
+	requestID        otypes.RequestID
+	reports          []otypes.RawReport
+	salt             []byte
+	revealHeight     int64
+	expirationHeight int64
+	logger           *Logger
+}
+
+// revealQueue keeps committed reports in memory until they are due to be revealed.
+type revealQueue struct {
+	mtx     sync.Mutex
+	reveals []pendingReveal
+}
+
+// Add puts the given pending reveal into the queue.
+func (q *revealQueue) Add(reveal pendingReveal) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	q.reveals = append(q.reveals, reveal)
+}
+
+// PopDue removes and returns all pending reveals that can be revealed at the given height.
+func (q *revealQueue) PopDue(height int64) []pendingReveal {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	var due, remaining []pendingReveal
+	for _, reveal := range q.reveals {
+		if reveal.revealHeight <= height {
+			due = append(due, reveal)
+		} else {
+			remaining = append(remaining, reveal)
+		}
+	}
+	q.reveals = remaining
+	return due
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevealQueue(t *testing.T) {
+	q := &revealQueue{}
+	q.Add(pendingReveal{requestID: 1, revealHeight: 10})
+	q.Add(pendingReveal{requestID: 2, revealHeight: 12})
+	q.Add(pendingReveal{requestID: 3, revealHeight: 11})
+	require.Empty(t, q.PopDue(9))
+	due := q.PopDue(11)
+	require.Len(t, due, 2)
+	require.EqualValues(t, 1, due[0].requestID)
+	require.EqualValues(t, 3, due[1].requestID)
+	// Popped reveals are not returned again.
+	require.Empty(t, q.PopDue(11))
+	due = q.PopDue(100)
+	require.Len(t, due, 1)
+	require.EqualValues(t, 2, due[0].requestID)
+}
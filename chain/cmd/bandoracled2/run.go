@@ -13,18 +13,77 @@ import (
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
 	httpclient "github.com/tendermint/tendermint/rpc/client/http"
-	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/bandprotocol/bandchain/chain/pkg/filecache"
 )
 
 const (
-	// TODO: We can subscribe only for txs that contain request messages
-	TxQuery = "tm.event = 'Tx'"
+	// BlockQuery is the query to subscribe for new blocks, after which the daemon catches up to.
+	BlockQuery = "tm.event = 'NewBlock'"
 	// EventChannelCapacity is a buffer size of channel between node and this program
 	EventChannelCapacity = 2000
+	// CatchUpInterval is the interval to catch up to the latest block regardless of new block
+	// events, in case the WebSocket subscription drops.
+	CatchUpInterval = 10 * time.Second
+	// MaxBlockResultsFailures is the number of times in a row that the daemon fails to get the
+	// results of a block before skipping it, such as when the node no longer has the results.
+	MaxBlockResultsFailures = 5
 )
 
+// getCatchUpStartHeight returns the first block to handle when catching up from the given last
+// processed block to the given latest block. A fresh daemon starts from the latest block, and
+// blocks whose requests already expired are skipped.
+func getCatchUpStartHeight(lastHeight, latestHeight, expirationBlockCount int64) int64 {
+	if lastHeight == 0 {
+		return latestHeight
+	}
+	if lastHeight+1 <= latestHeight-expirationBlockCount {
+		return latestHeight - expirationBlockCount + 1
+	}
+	return lastHeight + 1
+}
+
+// catchUp handles all blocks after the last processed block up to the latest block in order and
+// processes the jobs of their requests. The last processed block is saved after every block, so
+// requests sent while the daemon is stopped or disconnected are not missed.
+func catchUp(c *Context, l *Logger) error {
+	status, err := c.client.Status()
+	if err != nil {
+		return err
+	}
+	latestHeight := status.SyncInfo.LatestBlockHeight
+	lastHeight, err := c.jobs.LastHeight()
+	if err != nil {
+		return err
+	}
+	if lastHeight >= latestHeight {
+		return nil
+	}
+	params, err := GetParams(c)
+	if err != nil {
+		return err
+	}
+	startHeight := getCatchUpStartHeight(lastHeight, latestHeight, int64(params.ExpirationBlockCount))
+	if startHeight < latestHeight {
+		l.Info(":fast_forward: Catching up from block %d to block %d", startHeight, latestHeight)
+	}
+	for height := startHeight; height <= latestHeight; height++ {
+		jobs, err := handleBlock(c, l, height, latestHeight)
+		if err != nil {
+			return err
+		}
+		for _, j := range jobs {
+			go processJob(c, l.With("rid", j.RequestID), j)
+		}
+		// Reveal committed reports whose commit windows have closed as of this block.
+		for _, reveal := range c.reveals.PopDue(height) {
+			go revealJob(c, reveal)
+		}
+	}
+	return nil
+}
+
 func runImpl(c *Context, l *Logger) error {
 	l.Info(":rocket: Starting WebSocket subscriber")
 	err := c.client.Start()
@@ -35,16 +94,31 @@ func runImpl(c *Context, l *Logger) error {
 	ctx, cxl := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cxl()
 
-	l.Info(":ear: Subscribing to events with query: %s...", TxQuery)
-	eventChan, err := c.client.Subscribe(ctx, "", TxQuery, EventChannelCapacity)
+	l.Info(":ear: Subscribing to events with query: %s...", BlockQuery)
+	blockChan, err := c.client.Subscribe(ctx, "", BlockQuery, EventChannelCapacity)
+	if err != nil {
+		return err
+	}
+
+	go c.submitter.Run()
+
+	jobs, err := c.jobs.Jobs()
 	if err != nil {
 		return err
 	}
+	for _, j := range jobs {
+		go processJob(c, l.With("rid", j.RequestID), j)
+	}
 
+	ticker := time.NewTicker(CatchUpInterval)
+	defer ticker.Stop()
 	for {
+		if err := catchUp(c, l); err != nil {
+			l.Error(":cold_sweat: Failed to catch up to the latest block with error: %s", err.Error())
+		}
 		select {
-		case ev := <-eventChan:
-			go handleTransaction(c, l, ev.Data.(tmtypes.EventDataTx).TxResult)
+		case <-blockChan:
+		case <-ticker.C:
 		}
 	}
 }
@@ -87,30 +161,45 @@ func runCmd(c *Context) *cobra.Command {
 				return err
 			}
 			l := NewLogger(allowLevel)
-			c.executor, err = NewExecutor(cfg.Executor)
+			failover, err := NewFailoverExecutor(cfg.Executor)
 			if err != nil {
 				return err
 			}
+			go failover.RunHealthChecks(l)
+			c.executor = failover
 			l.Info(":star: Creating HTTP client with node URI: %s", cfg.NodeURI)
 			c.client, err = httpclient.New(cfg.NodeURI, "/websocket")
 			if err != nil {
 				return err
 			}
 			c.fileCache = filecache.New(filepath.Join(viper.GetString(flags.FlagHome), "files"))
+			c.reveals = &revealQueue{}
+			db, err := dbm.NewGoLevelDB("jobs", viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			c.jobs = newJobStore(db)
+			c.submitter = newTxSubmitter(c)
+			if cfg.Metrics != "" {
+				go serveMetrics(l, cfg.Metrics)
+			}
 			return runImpl(c, l)
 		},
 	}
 	cmd.Flags().String(flags.FlagChainID, "", "chain ID of BandChain network")
 	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "RPC url to BandChain node")
 	cmd.Flags().String(flagValidator, "", "validator address")
-	cmd.Flags().String(flagExecutor, "lambda:https://dmptasv4j8.execute-api.ap-southeast-1.amazonaws.com/bash-execute", "executor name and url for executing the data source script")
+	cmd.Flags().String(flagExecutor, "lambda:https://dmptasv4j8.execute-api.ap-southeast-1.amazonaws.com/bash-execute", "whitespace-separated executors in priority order, each as name:url for executing the data source script, or local:[memory=<MB>,cpu=<seconds>] to run it as a subprocess")
 	cmd.Flags().String(flags.FlagGasPrices, "", "gas prices for report transaction")
 	cmd.Flags().String(flagLogLevel, "info", "set the logger level")
+	cmd.Flags().String(flagMetrics, "127.0.0.1:26661", "address to serve Prometheus metrics at /metrics, empty to disable")
 	viper.BindPFlag(flags.FlagChainID, cmd.Flags().Lookup(flags.FlagChainID))
 	viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
 	viper.BindPFlag(flagValidator, cmd.Flags().Lookup(flagValidator))
 	viper.BindPFlag(flags.FlagGasPrices, cmd.Flags().Lookup(flags.FlagGasPrices))
 	viper.BindPFlag(flagLogLevel, cmd.Flags().Lookup(flagLogLevel))
 	viper.BindPFlag(flagExecutor, cmd.Flags().Lookup(flagExecutor))
+	viper.BindPFlag(flagMetrics, cmd.Flags().Lookup(flagMetrics))
 	return cmd
 }
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCatchUpStartHeight(t *testing.T) {
+	// A fresh daemon starts from the latest block.
+	require.Equal(t, int64(100), getCatchUpStartHeight(0, 100, 20))
+	// Continue right after the last processed block.
+	require.Equal(t, int64(91), getCatchUpStartHeight(90, 100, 20))
+	require.Equal(t, int64(81), getCatchUpStartHeight(80, 100, 20))
+	// Requests in blocks up to 80 expire by block 100.
+	require.Equal(t, int64(81), getCatchUpStartHeight(50, 100, 20))
+}
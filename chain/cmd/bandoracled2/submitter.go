@@ -0,0 +1,285 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	sdkCtx "github.com/cosmos/cosmos-sdk/client/context"
+	ckeys "github.com/cosmos/cosmos-sdk/client/keys"
+	keyring "github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+)
+
+const (
+	// MaxMessagesPerTx is the max number of messages packed into one transaction.
+	MaxMessagesPerTx = 10
+	// BatchInterval is how long the first message of a transaction waits for others to join it.
+	BatchInterval = 500 * time.Millisecond
+	// GasAdjustment is multiplied to the simulated gas to cover the estimation error.
+	GasAdjustment = 1.3
+	// TxConfirmInterval is the interval between queries for a broadcast transaction.
+	TxConfirmInterval = 1 * time.Second
+	// TxConfirmTimeout is how long to wait for a broadcast transaction to be included in a block.
+	TxConfirmTimeout = 1 * time.Minute
+)
+
+// txError is the error of a transaction rejected by BandChain in simulation, CheckTx or DeliverTx.
+type txError struct {
+	codespace string
+	code      uint32
+	log       string
+}
+
+func (e txError) Error() string {
+	return fmt.Sprintf("Tx returned nonzero code %d with log %s", e.code, e.log)
+}
+
+// pendingMsg is a message waiting to be packed into a transaction. The result of the transaction
+// is sent to its result channel once the transaction is included in a block or fails.
+type pendingMsg struct {
+	newMsg func(reporter sdk.AccAddress) sdk.Msg
+	logger *Logger
+	result chan error
+}
+
+// account is the locally tracked account number and next sequence of a reporter key.
+type account struct {
+	number   uint64
+	sequence uint64
+}
+
+// txSubmitter packs queued messages into multi-message transactions and broadcasts them with the
+// keys in the key pool. A key goes back to the pool as soon as its transaction passes CheckTx, and
+// its sequence is tracked locally, so one key can have many transactions in the same block.
+type txSubmitter struct {
+	c        *Context
+	msgs     chan pendingMsg
+	mtx      sync.Mutex
+	accounts map[string]account // Accounts by key name, missing if not known or out of sync
+}
+
+// newTxSubmitter creates a transaction submitter using the client and the keys of the given context.
+func newTxSubmitter(c *Context) *txSubmitter {
+	return &txSubmitter{
+		c:        c,
+		msgs:     make(chan pendingMsg, EventChannelCapacity),
+		accounts: make(map[string]account),
+	}
+}
+
+// Submit queues the message created with the reporter address of a key and waits until the
+// transaction carrying it is included in a block. Returns an error if the transaction fails.
+func (s *txSubmitter) Submit(l *Logger, newMsg func(reporter sdk.AccAddress) sdk.Msg) error {
+	result := make(chan error, 1)
+	s.msgs <- pendingMsg{newMsg: newMsg, logger: l, result: result}
+	return <-result
+}
+
+// Run packs queued messages into transactions and broadcasts each with a free key. Never returns.
+func (s *txSubmitter) Run() {
+	for {
+		batch := s.collectBatch()
+		key := <-s.c.keys
+		go s.submitBatch(key, batch)
+	}
+}
+
+// collectBatch waits for a queued message, then collects more messages until the transaction is
+// full or the batch interval passes.
+func (s *txSubmitter) collectBatch() []pendingMsg {
+	batch := []pendingMsg{<-s.msgs}
+	timeout := time.After(BatchInterval)
+	for len(batch) < MaxMessagesPerTx {
+		select {
+		case msg := <-s.msgs:
+			batch = append(batch, msg)
+		case <-timeout:
+			return batch
+		}
+	}
+	return batch
+}
+
+// submitBatch broadcasts the messages of the given batch in one transaction signed with the given
+// key, then returns the key to the pool. If the simulation of a batch fails, each message is
+// submitted in its own transaction, so one failing message does not fail the others.
+func (s *txSubmitter) submitBatch(key keyring.Info, batch []pendingMsg) {
+	reporterKeysInUse.Inc()
+	var pendings []pendingMsg
+	var msgs []sdk.Msg
+	for _, pending := range batch {
+		msg := pending.newMsg(key.GetAddress())
+		if err := msg.ValidateBasic(); err != nil {
+			pending.result <- fmt.Errorf("Failed to validate basic with error: %s", err.Error())
+			continue
+		}
+		pendings = append(pendings, pending)
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) == 0 {
+		s.releaseKey(key)
+		return
+	}
+
+	hash, simulated, err := s.broadcast(key, msgs)
+	s.releaseKey(key)
+	if err != nil {
+		broadcastTxs.WithLabelValues("failure").Inc()
+		if _, ok := err.(txError); ok && !simulated && len(pendings) > 1 {
+			for _, pending := range pendings {
+				go s.submitBatch(<-s.c.keys, []pendingMsg{pending})
+			}
+			return
+		}
+		for _, pending := range pendings {
+			pending.result <- err
+		}
+		return
+	}
+	s.confirm(key.GetName(), hash, pendings)
+}
+
+// broadcast simulates the transaction of the given messages for its gas, signs it with the given
+// key and broadcasts it in sync mode. Returns the transaction hash, and whether the simulation
+// passes if the transaction fails.
+func (s *txSubmitter) broadcast(key keyring.Info, msgs []sdk.Msg) ([]byte, bool, error) {
+	acc, err := s.getAccount(key)
+	if err != nil {
+		return nil, false, fmt.Errorf("Failed to retreive account with error: %s", err.Error())
+	}
+	txBldr := auth.NewTxBuilder(
+		auth.DefaultTxEncoder(cdc), acc.number, acc.sequence,
+		0, GasAdjustment, false, cfg.ChainID, "", sdk.NewCoins(), s.c.gasPrices,
+	)
+	gas, err := s.simulate(txBldr, msgs)
+	if err != nil {
+		return nil, false, err
+	}
+	out, err := txBldr.WithGas(gas).WithKeybase(keybase).BuildAndSign(key.GetName(), ckeys.DefaultKeyPass, msgs)
+	if err != nil {
+		return nil, true, fmt.Errorf("Failed to build tx with error: %s", err.Error())
+	}
+	reporterTxs.WithLabelValues(key.GetName()).Inc()
+	txMessages.Observe(float64(len(msgs)))
+
+	res, err := s.c.client.BroadcastTxSync(out)
+	if err != nil {
+		// The transaction may or may not be in the mempool, so the sequence is unknown.
+		s.resetAccount(key.GetName())
+		return nil, true, fmt.Errorf("Failed to broadcast tx with error: %s", err.Error())
+	}
+	if res.Code != 0 {
+		s.resetAccount(key.GetName())
+		return nil, true, txError{codespace: res.Codespace, code: res.Code, log: res.Log}
+	}
+	s.incrementSequence(key.GetName())
+	return res.Hash, true, nil
+}
+
+// releaseKey returns the given key to the key pool.
+func (s *txSubmitter) releaseKey(key keyring.Info) {
+	reporterKeysInUse.Dec()
+	s.c.keys <- key
+}
+
+// simulate returns the gas that the transaction of the given messages needs.
+func (s *txSubmitter) simulate(txBldr auth.TxBuilder, msgs []sdk.Msg) (uint64, error) {
+	txBytes, err := txBldr.BuildTxForSim(msgs)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to build tx for simulation with error: %s", err.Error())
+	}
+	_, gas, err := authclient.CalculateGas(func(path string, data []byte) ([]byte, int64, error) {
+		res, err := s.c.client.ABCIQuery(path, data)
+		if err != nil {
+			return nil, 0, fmt.Errorf("Failed to simulate tx with error: %s", err.Error())
+		}
+		if !res.Response.IsOK() {
+			return nil, 0, txError{codespace: res.Response.Codespace, code: res.Response.Code, log: res.Response.Log}
+		}
+		return res.Response.Value, res.Response.Height, nil
+	}, cdc, txBytes, txBldr.GasAdjustment())
+	return gas, err
+}
+
+// confirm waits for the transaction with the given hash, signed with the given key, to be included
+// in a block and sends the result to all messages in it.
+func (s *txSubmitter) confirm(name string, hash []byte, pendings []pendingMsg) {
+	deadline := time.Now().Add(TxConfirmTimeout)
+	for {
+		time.Sleep(TxConfirmInterval)
+		res, err := s.c.client.Tx(hash, false)
+		if err != nil {
+			if time.Now().After(deadline) {
+				// The transaction may have been dropped from the mempool, so the sequence is unknown.
+				s.resetAccount(name)
+				broadcastTxs.WithLabelValues("failure").Inc()
+				err = fmt.Errorf("Tx %X is not included in a block after %s", hash, TxConfirmTimeout)
+				for _, pending := range pendings {
+					pending.result <- err
+				}
+				return
+			}
+			continue
+		}
+		if res.TxResult.Code != 0 {
+			broadcastTxs.WithLabelValues("failure").Inc()
+			var err error = txError{codespace: res.TxResult.Codespace, code: res.TxResult.Code, log: res.TxResult.Log}
+			if len(pendings) > 1 {
+				// Any of the messages can fail the transaction, so the error is not of this message.
+				err = fmt.Errorf("%s, tx hash: %X", err.Error(), hash)
+			}
+			for _, pending := range pendings {
+				pending.result <- err
+			}
+			return
+		}
+		broadcastTxs.WithLabelValues("success").Inc()
+		for _, pending := range pendings {
+			pending.logger.Info(":smiling_face_with_sunglasses: Successfully broadcast tx with hash: %X", hash)
+			pending.result <- nil
+		}
+		return
+	}
+}
+
+// getAccount returns the locally tracked account of the given key, querying it from BandChain if
+// it is not known.
+func (s *txSubmitter) getAccount(key keyring.Info) (account, error) {
+	s.mtx.Lock()
+	acc, ok := s.accounts[key.GetName()]
+	s.mtx.Unlock()
+	if ok {
+		return acc, nil
+	}
+	res, err := auth.NewAccountRetriever(sdkCtx.CLIContext{Client: s.c.client}).GetAccount(key.GetAddress())
+	if err != nil {
+		return account{}, err
+	}
+	acc = account{number: res.GetAccountNumber(), sequence: res.GetSequence()}
+	s.mtx.Lock()
+	s.accounts[key.GetName()] = acc
+	s.mtx.Unlock()
+	return acc, nil
+}
+
+// incrementSequence increases the locally tracked sequence of the given key after its
+// transaction passes CheckTx.
+func (s *txSubmitter) incrementSequence(name string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if acc, ok := s.accounts[name]; ok {
+		acc.sequence++
+		s.accounts[name] = acc
+	}
+}
+
+// resetAccount forgets the locally tracked account of the given key, so it is queried again for
+// its next transaction.
+func (s *txSubmitter) resetAccount(name string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.accounts, name)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	otypes "github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestCollectBatch(t *testing.T) {
+	s := newTxSubmitter(&Context{})
+	for i := 0; i < MaxMessagesPerTx+2; i++ {
+		s.msgs <- pendingMsg{}
+	}
+	// A full batch is returned without waiting for the batch interval.
+	start := time.Now()
+	require.Len(t, s.collectBatch(), MaxMessagesPerTx)
+	require.True(t, time.Since(start) < BatchInterval)
+	// The remaining messages are returned after the batch interval.
+	require.Len(t, s.collectBatch(), 2)
+	require.True(t, time.Since(start) >= BatchInterval)
+}
+
+func TestAccountSequence(t *testing.T) {
+	s := newTxSubmitter(&Context{})
+	s.accounts["reporter"] = account{number: 5, sequence: 10}
+	s.incrementSequence("reporter")
+	s.incrementSequence("reporter")
+	require.Equal(t, account{number: 5, sequence: 12}, s.accounts["reporter"])
+	// Unknown accounts are queried again instead of being incremented.
+	s.incrementSequence("unknown")
+	require.NotContains(t, s.accounts, "unknown")
+	s.resetAccount("reporter")
+	require.NotContains(t, s.accounts, "reporter")
+}
+
+func TestIsAlreadyDone(t *testing.T) {
+	require.True(t, isAlreadyDone(txError{
+		codespace: otypes.ModuleName, code: otypes.ErrValidatorAlreadyReported.ABCICode(),
+	}))
+	require.True(t, isAlreadyDone(txError{
+		codespace: otypes.ModuleName, code: otypes.ErrAlreadyCommitted.ABCICode(),
+	}))
+	require.False(t, isAlreadyDone(txError{codespace: "sdk", code: otypes.ErrValidatorAlreadyReported.ABCICode()}))
+	require.False(t, isAlreadyDone(txError{codespace: otypes.ModuleName, code: 1}))
+	require.False(t, isAlreadyDone(nil))
+}
+
+func TestIsTerminal(t *testing.T) {
+	require.True(t, isTerminal(txError{codespace: otypes.ModuleName, code: otypes.ErrCommitWindowClosed.ABCICode()}))
+	require.False(t, isTerminal(txError{codespace: "sdk", code: otypes.ErrCommitWindowClosed.ABCICode()}))
+	require.False(t, isTerminal(txError{codespace: otypes.ModuleName, code: otypes.ErrAlreadyCommitted.ABCICode()}))
+	require.False(t, isTerminal(nil))
+}
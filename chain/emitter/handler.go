@@ -69,6 +69,8 @@ func (app *App) handleBeginBlockEndBlockEvent(event abci.Event) {
 		app.handleEventRequestExecute(evMap)
 	case slashing.EventTypeSlash:
 		app.handleEventSlash(evMap)
+	case types.EventTypeSlash:
+		app.handleEventOracleSlash(evMap)
 	default:
 		break
 	}
@@ -3,6 +3,8 @@ package emitter
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
 )
 
 // handleEventSlash implements emitter handler for Slashing event.
@@ -18,6 +20,19 @@ func (app *App) handleEventSlash(event EvMap) {
 	}
 }
 
+// handleEventOracleSlash implements emitter handler for oracle missed report slashing event.
+func (app *App) handleEventOracleSlash(event EvMap) {
+	if raw, ok := event[types.EventTypeSlash+"."+types.AttributeKeyJailed]; ok && len(raw) == 1 {
+		consAddress, _ := sdk.ConsAddressFromBech32(raw[0])
+		validator, _ := app.StakingKeeper.GetValidatorByConsAddr(app.DeliverContext, consAddress)
+		app.Write("UPDATE_VALIDATOR", JsDict{
+			"operator_address": validator.OperatorAddress.String(),
+			"tokens":           validator.Tokens.Uint64(),
+			"jailed":           validator.Jailed,
+		})
+	}
+}
+
 // handleMsgUnjail implements emitter handler for MsgUnjail.
 func (app *App) handleMsgUnjail(msg slashing.MsgUnjail) {
 	validator, _ := app.StakingKeeper.GetValidator(app.DeliverContext, msg.ValidatorAddr)
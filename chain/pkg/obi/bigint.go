@@ -0,0 +1,105 @@
+package obi
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// Uint128 is an OBI unsigned 128-bit integer, backed by big.Int.
+type Uint128 struct{ big.Int }
+
+// Uint256 is an OBI unsigned 256-bit integer, backed by big.Int.
+type Uint256 struct{ big.Int }
+
+// Int128 is an OBI signed 128-bit integer, backed by big.Int.
+type Int128 struct{ big.Int }
+
+// Int256 is an OBI signed 256-bit integer, backed by big.Int.
+type Int256 struct{ big.Int }
+
+// NewUint128 returns a new Uint128 with the same value as the given big.Int.
+func NewUint128(v *big.Int) Uint128 {
+	var res Uint128
+	res.Set(v)
+	return res
+}
+
+// NewUint256 returns a new Uint256 with the same value as the given big.Int.
+func NewUint256(v *big.Int) Uint256 {
+	var res Uint256
+	res.Set(v)
+	return res
+}
+
+// NewInt128 returns a new Int128 with the same value as the given big.Int.
+func NewInt128(v *big.Int) Int128 {
+	var res Int128
+	res.Set(v)
+	return res
+}
+
+// NewInt256 returns a new Int256 with the same value as the given big.Int.
+func NewInt256(v *big.Int) Int256 {
+	var res Int256
+	res.Set(v)
+	return res
+}
+
+// bigIntKind describes the signedness and the size in bits of an OBI big integer type.
+type bigIntKind struct {
+	signed bool
+	bits   int
+}
+
+var bigIntKinds = map[reflect.Type]bigIntKind{
+	reflect.TypeOf(Uint128{}): {signed: false, bits: 128},
+	reflect.TypeOf(Uint256{}): {signed: false, bits: 256},
+	reflect.TypeOf(Int128{}):  {signed: true, bits: 128},
+	reflect.TypeOf(Int256{}):  {signed: true, bits: 256},
+}
+
+// intSchema returns the OBI schema of the integer type with the given signedness and size.
+func intSchema(signed bool, bits int) string {
+	if signed {
+		return fmt.Sprintf("i%d", bits)
+	}
+	return fmt.Sprintf("u%d", bits)
+}
+
+// encodeBigInt encodes the given integer into the big-endian two's complement representation
+// of the given size. Returns error if the integer does not fit in the size.
+func encodeBigInt(v *big.Int, signed bool, bits int) ([]byte, error) {
+	full := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	min, max := big.NewInt(0), new(big.Int).Sub(full, big.NewInt(1))
+	if signed {
+		max = new(big.Int).Rsh(max, 1)
+		min = new(big.Int).Neg(new(big.Int).Add(max, big.NewInt(1)))
+	}
+	if v.Cmp(min) < 0 || v.Cmp(max) > 0 {
+		return nil, fmt.Errorf("obi: integer %s overflows %s", v, intSchema(signed, bits))
+	}
+	num := new(big.Int).Set(v)
+	if num.Sign() < 0 {
+		num.Add(num, full)
+	}
+	res := make([]byte, bits/8)
+	bz := num.Bytes()
+	copy(res[len(res)-len(bz):], bz)
+	return res, nil
+}
+
+// decodeBigInt decodes the big-endian two's complement integer of the given size and returns
+// the integer and the remaining bytes.
+func decodeBigInt(data []byte, signed bool, bits int) (*big.Int, []byte, error) {
+	size := bits / 8
+	if len(data) < size {
+		return nil, nil, errors.New("obi: out of range")
+	}
+	num := new(big.Int).SetBytes(data[:size])
+	if signed && data[0]&0x80 != 0 {
+		num.Sub(num, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+	}
+	return num, data[size:], nil
+}
@@ -13,7 +13,19 @@ func decodeImpl(data []byte, v interface{}) ([]byte, error) {
 		return nil, errors.New("obi: decode into non-ptr type")
 	}
 	ev := rv.Elem()
+	if kind, ok := bigIntKinds[ev.Type()]; ok {
+		val, rem, err := decodeBigInt(data, kind.signed, kind.bits)
+		if err != nil {
+			return nil, err
+		}
+		ev.Field(0).Set(reflect.ValueOf(*val))
+		return rem, nil
+	}
 	switch ev.Kind() {
+	case reflect.Bool:
+		val, rem, err := DecodeBool(data)
+		ev.SetBool(val)
+		return rem, err
 	case reflect.Uint8:
 		val, rem, err := DecodeUnsigned8(data)
 		ev.SetUint(uint64(val))
@@ -70,6 +82,38 @@ func decodeImpl(data []byte, v interface{}) ([]byte, error) {
 		}
 		ev.Set(slice)
 		return rem, nil
+	case reflect.Array:
+		rem := data
+		for idx := 0; idx < ev.Len(); idx++ {
+			var err error
+			rem, err = decodeImpl(rem, ev.Index(idx).Addr().Interface())
+			if err != nil {
+				return nil, err
+			}
+		}
+		return rem, nil
+	case reflect.Ptr:
+		if ev.Type().Elem().Kind() == reflect.Ptr {
+			return nil, errors.New("obi: nested optional is not supported")
+		}
+		tag, rem, err := DecodeUnsigned8(data)
+		if err != nil {
+			return nil, err
+		}
+		if tag == 0x00 {
+			ev.Set(reflect.Zero(ev.Type()))
+			return rem, nil
+		}
+		if tag != 0x01 {
+			return nil, fmt.Errorf("obi: invalid option tag: %d", tag)
+		}
+		val := reflect.New(ev.Type().Elem())
+		rem, err = decodeImpl(rem, val.Interface())
+		if err != nil {
+			return nil, err
+		}
+		ev.Set(val)
+		return rem, nil
 	case reflect.Struct:
 		rem := data
 		for idx := 0; idx < ev.NumField(); idx++ {
@@ -109,6 +153,22 @@ func MustDecode(data []byte, v ...interface{}) {
 	}
 }
 
+// DecodeBool decodes the input bytes into `bool` and returns the remaining bytes.
+func DecodeBool(data []byte) (bool, []byte, error) {
+	val, rem, err := DecodeUnsigned8(data)
+	if err != nil {
+		return false, nil, err
+	}
+	switch val {
+	case 0x00:
+		return false, rem, nil
+	case 0x01:
+		return true, rem, nil
+	default:
+		return false, nil, fmt.Errorf("obi: invalid bool value: %d", val)
+	}
+}
+
 // DecodeUnsigned16 decodes the input bytes into `uint8` and returns the remaining bytes.
 func DecodeUnsigned8(data []byte) (uint8, []byte, error) {
 	if len(data) < 1 {
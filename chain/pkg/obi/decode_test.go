@@ -284,9 +284,9 @@ func TestDecodeIntoNonPointer(t *testing.T) {
 }
 
 func TestUnsupportedType(t *testing.T) {
-	var actual bool
+	var actual float32
 	byteArray := []byte{0x6, 0x0, 0x0, 0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6}
-	require.PanicsWithError(t, "obi: unsupported value type: bool", func() { MustDecode(byteArray, &actual) })
+	require.PanicsWithError(t, "obi: unsupported value type: float32", func() { MustDecode(byteArray, &actual) })
 }
 
 func TestNotAllDataConsumed(t *testing.T) {
@@ -294,3 +294,60 @@ func TestNotAllDataConsumed(t *testing.T) {
 	byteArray := []byte{0x0, 0x0, 0x0, 0x6, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0x10}
 	require.PanicsWithError(t, "obi: not all data was consumed while decoding", func() { MustDecode(byteArray, &actual) })
 }
+
+func TestDecodeBool(t *testing.T) {
+	var actual bool
+	MustDecode([]byte{0x1}, &actual)
+	require.True(t, actual)
+	MustDecode([]byte{0x0}, &actual)
+	require.False(t, actual)
+}
+
+func TestDecodeBoolFail(t *testing.T) {
+	var actual bool
+	require.PanicsWithError(t, "obi: invalid bool value: 2", func() { MustDecode([]byte{0x2}, &actual) })
+}
+
+func TestDecodeFixedArray(t *testing.T) {
+	var actual [3]int16
+	MustDecode([]byte{0x0, 0x1, 0xff, 0xfe, 0x0, 0x3}, &actual)
+	require.Equal(t, [3]int16{1, -2, 3}, actual)
+}
+
+func TestDecodeFixedArrayOutOfRangeFail(t *testing.T) {
+	var actual [3]int16
+	require.PanicsWithError(t, "obi: out of range", func() { MustDecode([]byte{0x0, 0x1, 0xff, 0xfe}, &actual) })
+}
+
+func TestDecodeOptional(t *testing.T) {
+	actual := new(uint32)
+	MustDecode([]byte{0x0}, &actual)
+	require.Nil(t, actual)
+	MustDecode([]byte{0x1, 0x0, 0x0, 0x0, 0x7}, &actual)
+	require.Equal(t, uint32(7), *actual)
+}
+
+func TestDecodeOptionalFail(t *testing.T) {
+	var actual *uint32
+	require.PanicsWithError(t, "obi: invalid option tag: 2", func() { MustDecode([]byte{0x2, 0x0, 0x0, 0x0, 0x7}, &actual) })
+	var nested **uint32
+	require.PanicsWithError(t, "obi: nested optional is not supported", func() { MustDecode([]byte{0x0}, &nested) })
+}
+
+func TestDecodeBigInt(t *testing.T) {
+	var u Uint128
+	MustDecode([]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, &u)
+	require.Equal(t, "18446744073709551616", u.String())
+	var i Int256
+	data := make([]byte, 32)
+	for idx := range data {
+		data[idx] = 0xff
+	}
+	MustDecode(data, &i)
+	require.Equal(t, "-1", i.String())
+}
+
+func TestDecodeBigIntOutOfRangeFail(t *testing.T) {
+	var actual Uint256
+	require.PanicsWithError(t, "obi: out of range", func() { MustDecode(make([]byte, 31), &actual) })
+}
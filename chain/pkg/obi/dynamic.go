@@ -0,0 +1,457 @@
+package obi
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// Spec is a parsed OBI schema of a single type. It can encode and decode OBI data dynamically to
+// and from JSON values, without the need of Go structs. The JSON representation of each type is:
+//   - Integers: JSON numbers. Strings of decimal digits are also accepted when encoding.
+//   - bool: JSON booleans.
+//   - string: JSON strings.
+//   - bytes: base64-encoded JSON strings, similar to how encoding/json treats []byte.
+//   - Vectors and fixed-length arrays: JSON arrays.
+//   - Optionals: JSON null if absent, or the JSON value of the inner type otherwise.
+//   - Structs: JSON objects, with keys in the same order as the schema when decoding.
+type Spec interface {
+	// String returns the compact schema string of the spec, in the same format as GetSchema.
+	String() string
+	// minSize returns the min number of bytes of the encoded value, capped at math.MaxInt32.
+	minSize() int
+	encodeJSON(raw json.RawMessage) ([]byte, error)
+	decodeJSON(data []byte) (json.RawMessage, []byte, error)
+}
+
+// MinSize returns the min number of bytes of OBI data encoded with the given spec, capped at
+// math.MaxInt32. It is at least one for every spec.
+func MinSize(spec Spec) int {
+	return spec.minSize()
+}
+
+// checkLength returns error if the data is too short for the given number of elements of the
+// given spec, so that decoding fails before doing any work on a bogus length.
+func checkLength(elem Spec, length uint32, data []byte) error {
+	if uint64(length)*uint64(elem.minSize()) > uint64(len(data)) {
+		return fmt.Errorf("obi: not enough data for %d elements of %s", length, elem)
+	}
+	return nil
+}
+
+// EncodeJSON encodes the given JSON value into OBI bytes using the given spec.
+func EncodeJSON(spec Spec, value []byte) ([]byte, error) {
+	return spec.encodeJSON(value)
+}
+
+// DecodeJSON decodes the given OBI bytes into a JSON value using the given spec. Returns error
+// if the data is not entirely consumed.
+func DecodeJSON(spec Spec, data []byte) ([]byte, error) {
+	res, rem, err := spec.decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rem) != 0 {
+		return nil, errors.New("obi: not all data was consumed while decoding")
+	}
+	return res, nil
+}
+
+// EncodeInputJSON encodes the given JSON value into OBI calldata using the schema's input spec.
+func (s Schema) EncodeInputJSON(value []byte) ([]byte, error) {
+	return EncodeJSON(s.Input, value)
+}
+
+// DecodeInputJSON decodes the given OBI calldata into a JSON value using the schema's input spec.
+func (s Schema) DecodeInputJSON(data []byte) ([]byte, error) {
+	return DecodeJSON(s.Input, data)
+}
+
+// EncodeOutputJSON encodes the given JSON value into OBI result using the schema's output spec.
+func (s Schema) EncodeOutputJSON(value []byte) ([]byte, error) {
+	return EncodeJSON(s.Output, value)
+}
+
+// DecodeOutputJSON decodes the given OBI result into a JSON value using the schema's output spec.
+func (s Schema) DecodeOutputJSON(data []byte) ([]byte, error) {
+	return DecodeJSON(s.Output, data)
+}
+
+var primitiveSpecs = map[string]Spec{
+	"u8":     intSpec{signed: false, bits: 8},
+	"u16":    intSpec{signed: false, bits: 16},
+	"u32":    intSpec{signed: false, bits: 32},
+	"u64":    intSpec{signed: false, bits: 64},
+	"u128":   intSpec{signed: false, bits: 128},
+	"u256":   intSpec{signed: false, bits: 256},
+	"i8":     intSpec{signed: true, bits: 8},
+	"i16":    intSpec{signed: true, bits: 16},
+	"i32":    intSpec{signed: true, bits: 32},
+	"i64":    intSpec{signed: true, bits: 64},
+	"i128":   intSpec{signed: true, bits: 128},
+	"i256":   intSpec{signed: true, bits: 256},
+	"bool":   boolSpec{},
+	"string": stringSpec{},
+	"bytes":  bytesSpec{},
+}
+
+func unmarshalJSON(raw json.RawMessage, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if dec.More() {
+		return errors.New("obi: unexpected data after JSON value")
+	}
+	return nil
+}
+
+// intSpec is the spec of a big-endian fixed-size integer.
+type intSpec struct {
+	signed bool
+	bits   int
+}
+
+func (s intSpec) String() string {
+	return intSchema(s.signed, s.bits)
+}
+
+func (s intSpec) minSize() int {
+	return s.bits / 8
+}
+
+func (s intSpec) encodeJSON(raw json.RawMessage) ([]byte, error) {
+	var v interface{}
+	if err := unmarshalJSON(raw, &v); err != nil {
+		return nil, err
+	}
+	var str string
+	switch v := v.(type) {
+	case json.Number:
+		str = v.String()
+	case string:
+		str = v
+	default:
+		return nil, fmt.Errorf("obi: expect number for %s, got %s", s, raw)
+	}
+	num, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		return nil, fmt.Errorf("obi: invalid integer %s for %s", str, s)
+	}
+	return encodeBigInt(num, s.signed, s.bits)
+}
+
+func (s intSpec) decodeJSON(data []byte) (json.RawMessage, []byte, error) {
+	num, rem, err := decodeBigInt(data, s.signed, s.bits)
+	if err != nil {
+		return nil, nil, err
+	}
+	return json.RawMessage(num.String()), rem, nil
+}
+
+// boolSpec is the spec of a boolean, encoded as a single byte of 0 or 1.
+type boolSpec struct{}
+
+func (boolSpec) String() string {
+	return "bool"
+}
+
+func (boolSpec) minSize() int {
+	return 1
+}
+
+func (boolSpec) encodeJSON(raw json.RawMessage) ([]byte, error) {
+	var v bool
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("obi: expect boolean, got %s", raw)
+	}
+	return EncodeBool(v), nil
+}
+
+func (boolSpec) decodeJSON(data []byte) (json.RawMessage, []byte, error) {
+	v, rem, err := DecodeBool(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := json.Marshal(v)
+	return res, rem, err
+}
+
+// stringSpec is the spec of a length-prefixed UTF-8 string.
+type stringSpec struct{}
+
+func (stringSpec) String() string {
+	return "string"
+}
+
+func (stringSpec) minSize() int {
+	return 4
+}
+
+func (stringSpec) encodeJSON(raw json.RawMessage) ([]byte, error) {
+	var v string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("obi: expect string, got %s", raw)
+	}
+	return EncodeString(v), nil
+}
+
+func (stringSpec) decodeJSON(data []byte) (json.RawMessage, []byte, error) {
+	v, rem, err := DecodeString(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := json.Marshal(v)
+	return res, rem, err
+}
+
+// bytesSpec is the spec of a length-prefixed byte array.
+type bytesSpec struct{}
+
+func (bytesSpec) String() string {
+	return "bytes"
+}
+
+func (bytesSpec) minSize() int {
+	return 4
+}
+
+func (bytesSpec) encodeJSON(raw json.RawMessage) ([]byte, error) {
+	var v string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("obi: expect base64 string for bytes, got %s", raw)
+	}
+	bz, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return nil, fmt.Errorf("obi: invalid base64 string for bytes: %s", err)
+	}
+	return EncodeBytes(bz), nil
+}
+
+func (bytesSpec) decodeJSON(data []byte) (json.RawMessage, []byte, error) {
+	v, rem, err := DecodeBytes(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := json.Marshal(v)
+	return res, rem, err
+}
+
+// vectorSpec is the spec of a length-prefixed sequence of elements of the same type.
+type vectorSpec struct {
+	elem Spec
+}
+
+func (s vectorSpec) String() string {
+	return fmt.Sprintf("[%s]", s.elem)
+}
+
+func (s vectorSpec) minSize() int {
+	return 4
+}
+
+func (s vectorSpec) encodeJSON(raw json.RawMessage) ([]byte, error) {
+	var elems []json.RawMessage
+	if err := json.Unmarshal(raw, &elems); err != nil {
+		return nil, fmt.Errorf("obi: expect array for %s, got %s", s, raw)
+	}
+	res := EncodeUnsigned32(uint32(len(elems)))
+	for _, elem := range elems {
+		bz, err := s.elem.encodeJSON(elem)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, bz...)
+	}
+	return res, nil
+}
+
+func (s vectorSpec) decodeJSON(data []byte) (json.RawMessage, []byte, error) {
+	length, rem, err := DecodeUnsigned32(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkLength(s.elem, length, rem); err != nil {
+		return nil, nil, err
+	}
+	elems := []string{}
+	for idx := uint32(0); idx < length; idx++ {
+		var elem json.RawMessage
+		elem, rem, err = s.elem.decodeJSON(rem)
+		if err != nil {
+			return nil, nil, err
+		}
+		elems = append(elems, string(elem))
+	}
+	return json.RawMessage("[" + strings.Join(elems, ",") + "]"), rem, nil
+}
+
+// arraySpec is the spec of a fixed-length sequence of elements of the same type. Unlike vectors,
+// the length is part of the schema and is not encoded.
+type arraySpec struct {
+	elem   Spec
+	length int
+}
+
+func (s arraySpec) String() string {
+	return fmt.Sprintf("[%s;%d]", s.elem, s.length)
+}
+
+func (s arraySpec) minSize() int {
+	elem := s.elem.minSize()
+	if elem > math.MaxInt32/s.length {
+		return math.MaxInt32
+	}
+	return elem * s.length
+}
+
+func (s arraySpec) encodeJSON(raw json.RawMessage) ([]byte, error) {
+	var elems []json.RawMessage
+	if err := json.Unmarshal(raw, &elems); err != nil || elems == nil {
+		return nil, fmt.Errorf("obi: expect array for %s, got %s", s, raw)
+	}
+	if len(elems) != s.length {
+		return nil, fmt.Errorf("obi: expect %d elements for %s, got %d", s.length, s, len(elems))
+	}
+	res := []byte{}
+	for _, elem := range elems {
+		bz, err := s.elem.encodeJSON(elem)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, bz...)
+	}
+	return res, nil
+}
+
+func (s arraySpec) decodeJSON(data []byte) (json.RawMessage, []byte, error) {
+	if err := checkLength(s.elem, uint32(s.length), data); err != nil {
+		return nil, nil, err
+	}
+	elems := []string{}
+	rem := data
+	for idx := 0; idx < s.length; idx++ {
+		var elem json.RawMessage
+		var err error
+		elem, rem, err = s.elem.decodeJSON(rem)
+		if err != nil {
+			return nil, nil, err
+		}
+		elems = append(elems, string(elem))
+	}
+	return json.RawMessage("[" + strings.Join(elems, ",") + "]"), rem, nil
+}
+
+// optionSpec is the spec of an optional value, encoded as a byte of 0 if absent, or a byte of 1
+// followed by the value.
+type optionSpec struct {
+	elem Spec
+}
+
+func (s optionSpec) String() string {
+	return fmt.Sprintf("option<%s>", s.elem)
+}
+
+func (s optionSpec) minSize() int {
+	return 1
+}
+
+func (s optionSpec) encodeJSON(raw json.RawMessage) ([]byte, error) {
+	if string(bytes.TrimSpace(raw)) == "null" {
+		return []byte{0x00}, nil
+	}
+	bz, err := s.elem.encodeJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{0x01}, bz...), nil
+}
+
+func (s optionSpec) decodeJSON(data []byte) (json.RawMessage, []byte, error) {
+	tag, rem, err := DecodeUnsigned8(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch tag {
+	case 0x00:
+		return json.RawMessage("null"), rem, nil
+	case 0x01:
+		return s.elem.decodeJSON(rem)
+	default:
+		return nil, nil, fmt.Errorf("obi: invalid option tag: %d", tag)
+	}
+}
+
+type structField struct {
+	name string
+	spec Spec
+}
+
+// structSpec is the spec of a sequence of named fields, encoded one after another in order.
+type structSpec struct {
+	fields []structField
+}
+
+func (s structSpec) String() string {
+	fields := make([]string, len(s.fields))
+	for idx, field := range s.fields {
+		fields[idx] = fmt.Sprintf("%s:%s", field.name, field.spec)
+	}
+	return fmt.Sprintf("{%s}", strings.Join(fields, ","))
+}
+
+func (s structSpec) minSize() int {
+	size := 0
+	for _, field := range s.fields {
+		size += field.spec.minSize()
+		if size > math.MaxInt32 {
+			return math.MaxInt32
+		}
+	}
+	return size
+}
+
+func (s structSpec) encodeJSON(raw json.RawMessage) ([]byte, error) {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &values); err != nil || values == nil {
+		return nil, fmt.Errorf("obi: expect object for %s, got %s", s, raw)
+	}
+	if len(values) != len(s.fields) {
+		return nil, fmt.Errorf("obi: expect %d fields for %s, got %d", len(s.fields), s, len(values))
+	}
+	res := []byte{}
+	for _, field := range s.fields {
+		value, ok := values[field.name]
+		if !ok {
+			return nil, fmt.Errorf("obi: missing field %s for %s", field.name, s)
+		}
+		bz, err := field.spec.encodeJSON(value)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, bz...)
+	}
+	return res, nil
+}
+
+func (s structSpec) decodeJSON(data []byte) (json.RawMessage, []byte, error) {
+	fields := make([]string, len(s.fields))
+	rem := data
+	for idx, field := range s.fields {
+		var value json.RawMessage
+		var err error
+		value, rem, err = field.spec.decodeJSON(rem)
+		if err != nil {
+			return nil, nil, err
+		}
+		name, _ := json.Marshal(field.name)
+		fields[idx] = string(name) + ":" + string(value)
+	}
+	return json.RawMessage("{" + strings.Join(fields, ",") + "}"), rem, nil
+}
@@ -0,0 +1,164 @@
+package obi
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeJSONMatchReflection(t *testing.T) {
+	spec := MustParseSpec(MustGetSchema(ExampleData{}))
+	value := []byte(`{"symbol":"BTC","px":9000,"in":{"a":1,"b":2},"arr":[10,-11]}`)
+	data, err := EncodeJSON(spec, value)
+	require.NoError(t, err)
+	require.Equal(t, MustEncode(ExampleData{
+		Symbol: "BTC",
+		Px:     9000,
+		In:     Inner{A: 1, B: 2},
+		Arr:    []int16{10, -11},
+	}), data)
+	decoded, err := DecodeJSON(spec, data)
+	require.NoError(t, err)
+	require.Equal(t, string(value), string(decoded))
+}
+
+func TestEncodeDecodeJSONIntegerBounds(t *testing.T) {
+	for _, tc := range []struct {
+		schema string
+		value  string
+		data   []byte
+	}{
+		{"u8", "255", []byte{0xff}},
+		{"u16", "0", []byte{0x00, 0x00}},
+		{"u32", "65536", []byte{0x00, 0x01, 0x00, 0x00}},
+		{"u64", "18446744073709551615", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"i8", "-128", []byte{0x80}},
+		{"i8", "127", []byte{0x7f}},
+		{"i16", "-1", []byte{0xff, 0xff}},
+		{"i64", "-9223372036854775808", []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+	} {
+		spec := MustParseSpec(tc.schema)
+		data, err := EncodeJSON(spec, []byte(tc.value))
+		require.NoError(t, err)
+		require.Equal(t, tc.data, data)
+		decoded, err := DecodeJSON(spec, data)
+		require.NoError(t, err)
+		require.Equal(t, tc.value, string(decoded))
+	}
+}
+
+func TestEncodeJSONIntegerAsString(t *testing.T) {
+	data, err := EncodeJSON(MustParseSpec("u64"), []byte(`"18446744073709551615"`))
+	require.NoError(t, err)
+	require.Equal(t, EncodeUnsigned64(18446744073709551615), data)
+}
+
+func TestEncodeDecodeJSONBytes(t *testing.T) {
+	spec := MustParseSpec("{data:bytes,list:[string]}")
+	data, err := EncodeJSON(spec, []byte(`{"list":["a","b"],"data":"AQID"}`))
+	require.NoError(t, err)
+	require.Equal(t, []byte{
+		0x00, 0x00, 0x00, 0x03, 0x01, 0x02, 0x03,
+		0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x61, 0x00, 0x00, 0x00, 0x01, 0x62,
+	}, data)
+	decoded, err := DecodeJSON(spec, data)
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"AQID","list":["a","b"]}`, string(decoded))
+}
+
+func TestEncodeDecodeJSONEmptyVector(t *testing.T) {
+	spec := MustParseSpec("[u8]")
+	data, err := EncodeJSON(spec, []byte(`[]`))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x00, 0x00, 0x00, 0x00}, data)
+	decoded, err := DecodeJSON(spec, data)
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(decoded))
+}
+
+func TestEncodeJSONFail(t *testing.T) {
+	for _, tc := range []struct {
+		schema string
+		value  string
+	}{
+		{"u8", "256"},
+		{"u8", "-1"},
+		{"i8", "128"},
+		{"u8", "1.5"},
+		{"u8", `"abc"`},
+		{"u8", "true"},
+		{"string", "1"},
+		{"bytes", `"not base64!"`},
+		{"[u8]", `{"a":1}`},
+		{"{a:u8}", `[1]`},
+		{"{a:u8}", `null`},
+		{"{a:u8}", `{}`},
+		{"{a:u8}", `{"b":1}`},
+		{"{a:u8}", `{"a":1,"b":2}`},
+		{"bool", "1"},
+		{"bool", `"true"`},
+		{"[u8;2]", "[1]"},
+		{"[u8;2]", "[1,2,3]"},
+		{"[u8;2]", "null"},
+		{"option<u8>", "256"},
+		{"u128", "-1"},
+		{"i256", "57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{"u8", "not json"},
+		{"u8", "1 2"},
+	} {
+		_, err := EncodeJSON(MustParseSpec(tc.schema), []byte(tc.value))
+		require.Error(t, err, tc.schema+" "+tc.value)
+	}
+}
+
+func TestDecodeJSONFail(t *testing.T) {
+	_, err := DecodeJSON(MustParseSpec("u16"), []byte{0x01})
+	require.EqualError(t, err, "obi: out of range")
+	_, err = DecodeJSON(MustParseSpec("u8"), []byte{0x01, 0x02})
+	require.EqualError(t, err, "obi: not all data was consumed while decoding")
+	_, err = DecodeJSON(MustParseSpec("[u8]"), []byte{0x00, 0x00, 0x00, 0x02, 0x01})
+	require.Error(t, err)
+	_, err = DecodeJSON(MustParseSpec("bool"), []byte{0x02})
+	require.EqualError(t, err, "obi: invalid bool value: 2")
+	_, err = DecodeJSON(MustParseSpec("option<u8>"), []byte{0x02, 0x01})
+	require.EqualError(t, err, "obi: invalid option tag: 2")
+	_, err = DecodeJSON(MustParseSpec("[u16;2]"), []byte{0x00, 0x01, 0x00})
+	require.EqualError(t, err, "obi: not enough data for 2 elements of u16")
+	// Bogus vector lengths fail right away instead of decoding until the data runs out.
+	_, err = DecodeJSON(MustParseSpec("[u8]"), []byte{0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03})
+	require.EqualError(t, err, "obi: not enough data for 4294967295 elements of u8")
+}
+
+func TestMinSize(t *testing.T) {
+	for _, tc := range []struct {
+		schema string
+		size   int
+	}{
+		{"u8", 1}, {"i256", 32}, {"bool", 1}, {"string", 4}, {"bytes", 4}, {"[u64]", 4},
+		{"option<u64>", 1}, {"[u16;3]", 6}, {"{a:u8,b:[string;2],c:option<u8>}", 10},
+		{"[[[u64;65536];65536];65536]", math.MaxInt32},
+	} {
+		require.Equal(t, tc.size, MinSize(MustParseSpec(tc.schema)), tc.schema)
+	}
+}
+
+func TestSchemaEncodeDecodeJSON(t *testing.T) {
+	schema, err := ParseSchema("{symbol:string,multiplier:u64}/{px:u64}")
+	require.NoError(t, err)
+	calldata, err := schema.EncodeInputJSON([]byte(`{"symbol":"ETH","multiplier":100}`))
+	require.NoError(t, err)
+	require.Equal(t, MustEncode(struct {
+		Symbol     string `obi:"symbol"`
+		Multiplier uint64 `obi:"multiplier"`
+	}{"ETH", 100}), calldata)
+	input, err := schema.DecodeInputJSON(calldata)
+	require.NoError(t, err)
+	require.Equal(t, `{"symbol":"ETH","multiplier":100}`, string(input))
+	result, err := schema.EncodeOutputJSON([]byte(`{"px":42}`))
+	require.NoError(t, err)
+	require.Equal(t, EncodeUnsigned64(42), result)
+	output, err := schema.DecodeOutputJSON(result)
+	require.NoError(t, err)
+	require.Equal(t, `{"px":42}`, string(output))
+}
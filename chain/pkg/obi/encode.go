@@ -2,14 +2,22 @@ package obi
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 )
 
 // Encode uses obi encoding scheme to encode the given input into bytes.
 func encodeImpl(v interface{}) ([]byte, error) {
 	rv := reflect.ValueOf(v)
+	if kind, ok := bigIntKinds[rv.Type()]; ok {
+		num := rv.Field(0).Interface().(big.Int)
+		return encodeBigInt(&num, kind.signed, kind.bits)
+	}
 	switch rv.Kind() {
+	case reflect.Bool:
+		return EncodeBool(rv.Bool()), nil
 	case reflect.Uint8:
 		return EncodeUnsigned8(uint8(rv.Uint())), nil
 	case reflect.Uint16:
@@ -42,6 +50,28 @@ func encodeImpl(v interface{}) ([]byte, error) {
 			res = append(res, each...)
 		}
 		return res, nil
+	case reflect.Array:
+		res := []byte{}
+		for idx := 0; idx < rv.Len(); idx++ {
+			each, err := Encode(rv.Index(idx).Interface())
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, each...)
+		}
+		return res, nil
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return []byte{0x00}, nil
+		}
+		if rv.Elem().Kind() == reflect.Ptr {
+			return nil, errors.New("obi: nested optional is not supported")
+		}
+		each, err := Encode(rv.Elem().Interface())
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{0x01}, each...), nil
 	case reflect.Struct:
 		res := []byte{}
 		for idx := 0; idx < rv.NumField(); idx++ {
@@ -79,6 +109,14 @@ func MustEncode(v ...interface{}) []byte {
 	return res
 }
 
+// EncodeBool takes a `bool` variable and encodes it into a byte array
+func EncodeBool(v bool) []byte {
+	if v {
+		return []byte{0x01}
+	}
+	return []byte{0x00}
+}
+
 // EncodeUnsigned8 takes an `uint8` variable and encodes it into a byte array
 func EncodeUnsigned8(v uint8) []byte {
 	return []byte{v}
@@ -1,6 +1,7 @@
 package obi
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,7 +27,7 @@ type ExampleData struct {
 }
 
 type InvalidStruct struct {
-	IsBool bool
+	IsFloat float32
 }
 
 func TestEncodeBytes(t *testing.T) {
@@ -54,9 +55,9 @@ func TestEncodeBytesMulti(t *testing.T) {
 
 func TestEncodeStructFail(t *testing.T) {
 	invalid := InvalidStruct{
-		IsBool: true,
+		IsFloat: 1.5,
 	}
-	require.PanicsWithError(t, "obi: unsupported value type: bool", func() { MustEncode(invalid) })
+	require.PanicsWithError(t, "obi: unsupported value type: float32", func() { MustEncode(invalid) })
 }
 
 // Uint8
@@ -176,8 +177,8 @@ func TestEncodeSlice(t *testing.T) {
 }
 
 func TestEncodeSliceFail(t *testing.T) {
-	testSlice := []bool{true, false, true, true}
-	require.PanicsWithError(t, "obi: unsupported value type: bool", func() { MustEncode(testSlice) })
+	testSlice := []float32{1.5, 2.5}
+	require.PanicsWithError(t, "obi: unsupported value type: float32", func() { MustEncode(testSlice) })
 }
 
 func TestEncodeByteArray(t *testing.T) {
@@ -187,13 +188,52 @@ func TestEncodeByteArray(t *testing.T) {
 }
 
 func TestEncodeNotSupported(t *testing.T) {
-	notSupportBool := true
-	byteArray, err := Encode(notSupportBool)
-	require.EqualError(t, err, "obi: unsupported value type: bool")
+	notSupportFloat := float32(1.5)
+	byteArray, err := Encode(notSupportFloat)
+	require.EqualError(t, err, "obi: unsupported value type: float32")
 	require.Nil(t, byteArray)
 }
 
 func TestEncodeNotSupport(t *testing.T) {
-	notSupportBool := true
-	require.PanicsWithError(t, "obi: unsupported value type: bool", func() { MustEncode(notSupportBool) })
+	notSupportFloat := float32(1.5)
+	require.PanicsWithError(t, "obi: unsupported value type: float32", func() { MustEncode(notSupportFloat) })
+}
+
+func TestEncodeBool(t *testing.T) {
+	require.Equal(t, []byte{0x1}, MustEncode(true))
+	require.Equal(t, []byte{0x0}, MustEncode(false))
+}
+
+func TestEncodeFixedArray(t *testing.T) {
+	require.Equal(t, []byte{0x0, 0x1, 0xff, 0xfe, 0x0, 0x3}, MustEncode([3]int16{1, -2, 3}))
+	require.Equal(t, []byte{0x1, 0x2}, MustEncode([2]byte{0x1, 0x2}))
+}
+
+func TestEncodeOptional(t *testing.T) {
+	num := uint32(7)
+	require.Equal(t, []byte{0x1, 0x0, 0x0, 0x0, 0x7}, MustEncode(&num))
+	require.Equal(t, []byte{0x0}, MustEncode((*uint32)(nil)))
+	ptr := &num
+	require.PanicsWithError(t, "obi: nested optional is not supported", func() { MustEncode(&ptr) })
+}
+
+func TestEncodeBigInt(t *testing.T) {
+	num, _ := new(big.Int).SetString("18446744073709551616", 10)
+	require.Equal(t,
+		[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+		MustEncode(NewUint128(num)),
+	)
+	require.Equal(t,
+		[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x85},
+		MustEncode(NewInt128(big.NewInt(-123))),
+	)
+}
+
+func TestEncodeBigIntOverflowFail(t *testing.T) {
+	require.PanicsWithError(t, "obi: integer -1 overflows u256", func() { MustEncode(NewUint256(big.NewInt(-1))) })
+	num := new(big.Int).Lsh(big.NewInt(1), 127)
+	require.PanicsWithError(t,
+		"obi: integer 170141183460469231731687303715884105728 overflows i128",
+		func() { MustEncode(NewInt128(num)) },
+	)
 }
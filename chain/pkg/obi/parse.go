@@ -0,0 +1,175 @@
+package obi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MaxArrayLength is the max length of fixed-length arrays in schemas. Zero-length arrays are not
+// allowed either, so every type takes at least one byte of OBI data and decoding cannot do more
+// work than the size of the data allows.
+const MaxArrayLength = 65536
+
+// Schema is a parsed oracle script schema, which consists of the input and output specs.
+type Schema struct {
+	Input  Spec
+	Output Spec
+}
+
+// ParseSchema parses the given oracle script schema in the form of "<input>/<output>", for
+// instance "{symbol:string,multiplier:u64}/{px:u64}". Whitespaces are ignored.
+func ParseSchema(schema string) (Schema, error) {
+	tokens := strings.Split(stripSpaces(schema), "/")
+	if len(tokens) != 2 {
+		return Schema{}, errors.New("obi: expect exactly one forward slash in schema")
+	}
+	input, err := ParseSpec(tokens[0])
+	if err != nil {
+		return Schema{}, err
+	}
+	output, err := ParseSpec(tokens[1])
+	if err != nil {
+		return Schema{}, err
+	}
+	return Schema{Input: input, Output: output}, nil
+}
+
+// ParseSpec parses the given compact OBI individual schema, as returned from GetSchema, into
+// a Spec. Whitespaces are ignored.
+func ParseSpec(spec string) (Spec, error) {
+	p := &parser{input: stripSpaces(spec)}
+	res, err := p.parseSpec()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.input) {
+		return nil, p.errorf("unexpected trailing characters")
+	}
+	return res, nil
+}
+
+// MustParseSpec parses the given compact OBI individual schema into a Spec. Panics on error.
+func MustParseSpec(spec string) Spec {
+	res, err := ParseSpec(spec)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func stripSpaces(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// parser is a recursive descent parser of OBI schema strings.
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("obi: invalid schema at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) consume(c byte) bool {
+	if p.pos < len(p.input) && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) parseIdent() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c != '_' && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && !('0' <= c && c <= '9') {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *parser) parseSpec() (Spec, error) {
+	switch {
+	case p.consume('['):
+		elem, err := p.parseSpec()
+		if err != nil {
+			return nil, err
+		}
+		if p.consume(']') {
+			return vectorSpec{elem: elem}, nil
+		}
+		if !p.consume(';') {
+			return nil, p.errorf("expect ']' or ';'")
+		}
+		start := p.pos
+		for p.pos < len(p.input) && '0' <= p.input[p.pos] && p.input[p.pos] <= '9' {
+			p.pos++
+		}
+		length, err := strconv.ParseUint(p.input[start:p.pos], 10, 32)
+		if err != nil || length == 0 || length > MaxArrayLength {
+			return nil, p.errorf("invalid array length, expect 1 to %d", MaxArrayLength)
+		}
+		if !p.consume(']') {
+			return nil, p.errorf("expect ']'")
+		}
+		return arraySpec{elem: elem, length: int(length)}, nil
+	case p.consume('{'):
+		var fields []structField
+		names := make(map[string]bool)
+		for {
+			name := p.parseIdent()
+			if name == "" {
+				return nil, p.errorf("expect field name")
+			}
+			if names[name] {
+				return nil, p.errorf("duplicate field name %s", name)
+			}
+			names[name] = true
+			if !p.consume(':') {
+				return nil, p.errorf("expect ':'")
+			}
+			spec, err := p.parseSpec()
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, structField{name: name, spec: spec})
+			if p.consume('}') {
+				return structSpec{fields: fields}, nil
+			}
+			if !p.consume(',') {
+				return nil, p.errorf("expect ',' or '}'")
+			}
+		}
+	default:
+		name := p.parseIdent()
+		if name == "option" && p.consume('<') {
+			elem, err := p.parseSpec()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := elem.(optionSpec); ok {
+				return nil, p.errorf("nested optional is not supported")
+			}
+			if !p.consume('>') {
+				return nil, p.errorf("expect '>'")
+			}
+			return optionSpec{elem: elem}, nil
+		}
+		spec, ok := primitiveSpecs[name]
+		if !ok {
+			return nil, p.errorf("unknown type %q", name)
+		}
+		return spec, nil
+	}
+}
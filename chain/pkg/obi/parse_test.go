@@ -0,0 +1,62 @@
+package obi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec(t *testing.T) {
+	for _, schema := range []string{
+		"u8", "i64", "string", "bytes", "[u16]", "[[string]]",
+		"{symbol:string,px:u64}",
+		"{symbol:string,px:u64,in:{a:u8,b:u8},arr:[i16]}",
+		"u128", "i256", "bool", "[u8;32]", "[[u64;2]]", "option<u64>", "option<{a:bool,b:[i128;1]}>",
+		"{option:option<string>}", "[u8;65536]",
+	} {
+		spec, err := ParseSpec(schema)
+		require.NoError(t, err)
+		require.Equal(t, schema, spec.String())
+	}
+}
+
+func TestParseSpecMatchGetSchema(t *testing.T) {
+	schema := MustGetSchema(ExampleData{})
+	require.Equal(t, schema, MustParseSpec(schema).String())
+	schema = MustGetSchema(AllData{})
+	require.Equal(t, schema, MustParseSpec(schema).String())
+}
+
+func TestParseSpecWhitespace(t *testing.T) {
+	spec, err := ParseSpec(" { symbol : string ,\n px: [ u64 ] } ")
+	require.NoError(t, err)
+	require.Equal(t, "{symbol:string,px:[u64]}", spec.String())
+}
+
+func TestParseSpecFail(t *testing.T) {
+	for _, schema := range []string{
+		"", "u", "u7", "float", "[u8", "u8]", "{}", "{a}", "{a:}", "{a:u8", "{a:u8,}",
+		"{a:u8;b:u8}", "{a:u8,a:u16}", "u8u8", "[u8][u8]", "u512", "[u8;]", "[u8;-1]", "[u8;2",
+		"[u8;99999999999]", "[u8;0]", "[[u8;0]]", "[u8;65537]", "option", "option<u8", "option<>", "option<option<u8>>",
+	} {
+		_, err := ParseSpec(schema)
+		require.Error(t, err, schema)
+	}
+	require.Panics(t, func() { MustParseSpec("float") })
+}
+
+func TestParseSchema(t *testing.T) {
+	schema, err := ParseSchema("{symbol:string,multiplier:u64}/{px:u64}")
+	require.NoError(t, err)
+	require.Equal(t, "{symbol:string,multiplier:u64}", schema.Input.String())
+	require.Equal(t, "{px:u64}", schema.Output.String())
+}
+
+func TestParseSchemaFail(t *testing.T) {
+	for _, schema := range []string{
+		"", "{px:u64}", "{px:u64}/", "/{px:u64}", "{px:u64}/{px:u64}/{px:u64}", "{px:u64}/{px:float}",
+	} {
+		_, err := ParseSchema(schema)
+		require.Error(t, err, schema)
+	}
+}
@@ -8,7 +8,14 @@ import (
 )
 
 func getSchemaImpl(s *strings.Builder, t reflect.Type) error {
+	if kind, ok := bigIntKinds[t]; ok {
+		s.WriteString(intSchema(kind.signed, kind.bits))
+		return nil
+	}
 	switch t.Kind() {
+	case reflect.Bool:
+		s.WriteString("bool")
+		return nil
 	case reflect.Uint8:
 		s.WriteString("u8")
 		return nil
@@ -48,6 +55,25 @@ func getSchemaImpl(s *strings.Builder, t reflect.Type) error {
 		}
 		s.WriteString("]")
 		return nil
+	case reflect.Array:
+		s.WriteString("[")
+		err := getSchemaImpl(s, t.Elem())
+		if err != nil {
+			return err
+		}
+		s.WriteString(fmt.Sprintf(";%d]", t.Len()))
+		return nil
+	case reflect.Ptr:
+		if t.Elem().Kind() == reflect.Ptr {
+			return errors.New("obi: nested optional is not supported")
+		}
+		s.WriteString("option<")
+		err := getSchemaImpl(s, t.Elem())
+		if err != nil {
+			return err
+		}
+		s.WriteString(">")
+		return nil
 	case reflect.Struct:
 		if t.NumField() == 0 {
 			return errors.New("obi: empty struct is not supported")
@@ -13,8 +13,8 @@ type NoOBITagStruct struct {
 }
 
 type NotSupportedStruct struct {
-	IsValid bool   `obi:"isValid"`
-	Test    string `obi:"test"`
+	IsValid float32 `obi:"isValid"`
+	Test    string  `obi:"test"`
 }
 
 type AllData struct {
@@ -65,7 +65,7 @@ func TestMissingOBISchemaFail(t *testing.T) {
 }
 
 func TestUnsupportedTypeFail(t *testing.T) {
-	require.PanicsWithError(t, "obi: unsupported value type: bool", func() { MustGetSchema(NotSupportedStruct{}) })
+	require.PanicsWithError(t, "obi: unsupported value type: float32", func() { MustGetSchema(NotSupportedStruct{}) })
 }
 
 func TestSchemaSupportedNumberTypeSuccess(t *testing.T) {
@@ -77,8 +77,8 @@ func TestSchemaSupportedNumberAliasTypeSuccess(t *testing.T) {
 }
 
 func TestSchemaInvalidSliceFail(t *testing.T) {
-	invalidSlice := []bool{false, false, true, true}
-	require.PanicsWithError(t, "obi: unsupported value type: bool", func() { MustGetSchema(invalidSlice) })
+	invalidSlice := []float32{1.5, 2.5}
+	require.PanicsWithError(t, "obi: unsupported value type: float32", func() { MustGetSchema(invalidSlice) })
 }
 
 func TestSchemaByteArraySuccess(t *testing.T) {
@@ -89,3 +89,19 @@ func TestSchemaByteArraySuccess(t *testing.T) {
 func TestSchemaByteArrayInStructSuccess(t *testing.T) {
 	require.Equal(t, "{byteArray:bytes}", MustGetSchema(ByteArrayStruct{}))
 }
+
+func TestSchemaExtendedTypesSuccess(t *testing.T) {
+	require.Equal(t, "bool", MustGetSchema(true))
+	require.Equal(t, "[u8;32]", MustGetSchema([32]byte{}))
+	require.Equal(t, "option<[string;2]>", MustGetSchema((*[2]string)(nil)))
+	require.Equal(t, "[u128]", MustGetSchema([]Uint128{}))
+	require.Equal(t, "{a:u256,b:i128,c:i256}", MustGetSchema(struct {
+		A Uint256 `obi:"a"`
+		B Int128  `obi:"b"`
+		C Int256  `obi:"c"`
+	}{}))
+}
+
+func TestSchemaNestedOptionalFail(t *testing.T) {
+	require.PanicsWithError(t, "obi: nested optional is not supported", func() { MustGetSchema((**uint8)(nil)) })
+}
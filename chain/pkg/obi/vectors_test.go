@@ -0,0 +1,129 @@
+package obi
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors shared with other OBI implementations. Integer and composite type vectors match the
+// output of pyobi and obi.js, and bool vectors match obi-rs. Fixed-length arrays and optionals
+// follow obi-rs's Borsh-style layout: arrays have no length prefix, and optionals are prefixed
+// with a byte of 0 (absent) or 1 (present).
+var testVectors = []struct {
+	schema string
+	value  string
+	hex    string
+}{
+	{"u8", `42`, "2a"},
+	{"i16", `-123`, "ff85"},
+	{"u64", `1000000000`, "000000003b9aca00"},
+	{"u128", `0`, "00000000000000000000000000000000"},
+	{"u128", `340282366920938463463374607431768211455`, "ffffffffffffffffffffffffffffffff"},
+	{"u128", `12345678901234567890123456789`, "0000000027e41b3246bec9b16e398115"},
+	{"i128", `-1`, "ffffffffffffffffffffffffffffffff"},
+	{"i128", `-170141183460469231731687303715884105728`, "80000000000000000000000000000000"},
+	{"i128", `170141183460469231731687303715884105727`, "7fffffffffffffffffffffffffffffff"},
+	{
+		"u256", `115792089237316195423570985008687907853269984665640564039457584007913129639935`,
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+	},
+	{"u256", `12345678`, "0000000000000000000000000000000000000000000000000000000000bc614e"},
+	{"i256", `-12345678`, "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffff439eb2"},
+	{
+		"i256", `-57896044618658097711785492504343953926634992332820282019728792003956564819968`,
+		"8000000000000000000000000000000000000000000000000000000000000000",
+	},
+	{"bool", `true`, "01"},
+	{"bool", `false`, "00"},
+	{"[u16;3]", `[1,2,3]`, "000100020003"},
+	{"[string;2]", `["a","bc"]`, "0000000161000000026263"},
+	{"[[u8;2];2]", `[[1,2],[3,4]]`, "01020304"},
+	{"option<u32>", `null`, "00"},
+	{"option<u32>", `7`, "0100000007"},
+	{"[option<bool>]", `[true,null,false]`, "000000030101000100"},
+	{"{symbol:string,multiplier:u64}", `{"symbol":"BTC","multiplier":1000000000}`, "00000003425443000000003b9aca00"},
+	{
+		"{price:u64,sources:[{name:string,time:u64}]}",
+		`{"price":9268300000000,"sources":[{"name":"CoinGecko","time":1590305341},{"name":"CryptoCompare","time":1590305362}]}`,
+		"0000086df1baab000000000200000009436f696e4765636b6f000000005eca223d" +
+			"0000000d43727970746f436f6d70617265000000005eca2252",
+	},
+	{
+		"{px:u256,stale:bool,prev:option<i128>,sources:[string;2]}",
+		`{"px":100,"stale":false,"prev":-5,"sources":["a","b"]}`,
+		"0000000000000000000000000000000000000000000000000000000000000064" +
+			"00" + "01fffffffffffffffffffffffffffffffb" + "0000000161" + "0000000162",
+	},
+}
+
+func mustDecodeHex(s string) []byte {
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func TestVectorsJSON(t *testing.T) {
+	for _, tc := range testVectors {
+		spec, err := ParseSpec(tc.schema)
+		require.NoError(t, err, tc.schema)
+		require.Equal(t, tc.schema, spec.String())
+		data, err := EncodeJSON(spec, []byte(tc.value))
+		require.NoError(t, err, tc.schema)
+		require.Equal(t, mustDecodeHex(tc.hex), data, tc.schema)
+		value, err := DecodeJSON(spec, data)
+		require.NoError(t, err, tc.schema)
+		require.Equal(t, tc.value, string(value), tc.schema)
+	}
+}
+
+type PriceFeed struct {
+	Px      Uint256   `obi:"px"`
+	Stale   bool      `obi:"stale"`
+	Prev    *Int128   `obi:"prev"`
+	Sources [2]string `obi:"sources"`
+	Weights *[2]uint8 `obi:"weights"`
+	Deltas  []*Int256 `obi:"deltas"`
+	Total   Uint128   `obi:"total"`
+	Flags   [3]bool   `obi:"flags"`
+}
+
+func TestVectorsReflection(t *testing.T) {
+	prev := NewInt128(big.NewInt(-5))
+	delta := NewInt256(big.NewInt(-12345678))
+	feed := PriceFeed{
+		Px:      NewUint256(big.NewInt(100)),
+		Stale:   false,
+		Prev:    &prev,
+		Sources: [2]string{"a", "b"},
+		Weights: nil,
+		Deltas:  []*Int256{&delta, nil},
+		Total:   NewUint128(new(big.Int).Lsh(big.NewInt(1), 100)),
+		Flags:   [3]bool{true, false, true},
+	}
+	schema := "{px:u256,stale:bool,prev:option<i128>,sources:[string;2],weights:option<[u8;2]>," +
+		"deltas:[option<i256>],total:u128,flags:[bool;3]}"
+	require.Equal(t, schema, MustGetSchema(PriceFeed{}))
+	expected := mustDecodeHex(
+		"0000000000000000000000000000000000000000000000000000000000000064" + "00" +
+			"01fffffffffffffffffffffffffffffffb" + "0000000161" + "0000000162" + "00" +
+			"00000002" + "01ffffffffffffffffffffffffffffffffffffffffffffffffffffffffff439eb2" + "00" +
+			"00000010000000000000000000000000" + "010001",
+	)
+	data := MustEncode(feed)
+	require.Equal(t, expected, data)
+	var decoded PriceFeed
+	MustDecode(data, &decoded)
+	require.Equal(t, feed, decoded)
+	value, err := DecodeJSON(MustParseSpec(schema), data)
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"px":100,"stale":false,"prev":-5,"sources":["a","b"],"weights":null,`+
+			`"deltas":[-12345678,null],"total":1267650600228229401496703205376,"flags":[true,false,true]}`,
+		string(value),
+	)
+}
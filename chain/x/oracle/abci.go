@@ -7,13 +7,16 @@ import (
 	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
 )
 
-// handleBeginBlock re-calculates and saves the rolling seed value based on block hashes.
+// handleBeginBlock re-calculates and saves the rolling seed value based on block hashes and
+// sends requests for due standing requests.
 func handleBeginBlock(ctx sdk.Context, k Keeper, req abci.RequestBeginBlock) {
 	// Update rolling seed used for pseudorandom oracle provider selection.
 	rollingSeed := k.GetRollingSeed(ctx)
 	k.SetRollingSeed(ctx, append(rollingSeed[1:], req.GetHash()[0]))
 	// Reward a portion of block rewards (inflation + tx fee) to active oracle validators.
 	k.AllocateTokens(ctx, req.LastCommitInfo.GetVotes())
+	// Send new requests on behalf of standing requests that are due at this block.
+	k.ProcessStandingRequests(ctx)
 }
 
 // handleEndBlock cleans up the state during end block. See comment in the implementation!
@@ -24,8 +27,8 @@ func handleEndBlock(ctx sdk.Context, k Keeper) {
 	}
 	// Once all the requests are resolved, we can clear the list.
 	k.SetPendingResolveList(ctx, []types.RequestID{})
-	// Lastly, we clean up data requests that are supposed to be expired.
+	// Then, we clean up data requests that are supposed to be expired.
 	k.ProcessExpiredRequests(ctx)
-	// NOTE: We can remove old requests from state to optimize space, using `k.DeleteRequest`
-	// and `k.DeleteReports`. We don't do that now as it is premature optimization at this state.
+	// Lastly, we remove old requests that are past the retention window to save space.
+	k.PruneRequests(ctx)
 }
@@ -130,6 +130,8 @@ func TestAllocateTokensWithDistrAllocateTokens(t *testing.T) {
 	mintParams.InflationMax = sdk.ZeroDec()
 	app.MintKeeper.SetParams(ctx, mintParams)
 	k.SetParam(ctx, types.KeyOracleRewardPercentage, 70)
+	// Allocate the oracle reward in every block, so that it gets allocated along with distr's.
+	k.SetParam(ctx, types.KeyRewardPeriod, 1)
 	// Set block proposer to Validator2, who will receive 5% bonus.
 	app.DistrKeeper.SetPreviousProposerConsAddr(ctx, testapp.Validator2.Address.Bytes())
 	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 50)), app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins())
@@ -24,6 +24,8 @@ type (
 	Keeper                   = keeper.Keeper
 	MsgRequestData           = types.MsgRequestData
 	MsgReportData            = types.MsgReportData
+	MsgCommitReport          = types.MsgCommitReport
+	MsgRevealReport          = types.MsgRevealReport
 	MsgCreateDataSource      = types.MsgCreateDataSource
 	MsgEditDataSource        = types.MsgEditDataSource
 	MsgCreateOracleScript    = types.MsgCreateOracleScript
@@ -31,6 +33,8 @@ type (
 	MsgActivate              = types.MsgActivate
 	MsgAddReporter           = types.MsgAddReporter
 	MsgRemoveReporter        = types.MsgRemoveReporter
+	MsgCreateStandingRequest = types.MsgCreateStandingRequest
+	MsgCancelStandingRequest = types.MsgCancelStandingRequest
 	OracleRequestPacketData  = types.OracleRequestPacketData
 	OracleResponsePacketData = types.OracleResponsePacketData
 )
@@ -24,7 +24,7 @@ func TestSuccessRequestOracleData(t *testing.T) {
 
 	ctx = ctx.WithBlockHeight(4).WithBlockTime(time.Unix(1581589790, 0))
 	handler := oracle.NewHandler(k)
-	requestMsg := types.NewMsgRequestData(types.OracleScriptID(1), []byte("calldata"), 3, 2, "app_test", testapp.Alice.Address)
+	requestMsg := types.NewMsgRequestData(types.OracleScriptID(1), []byte("calldata"), 3, 2, "app_test", testapp.Alice.Address, "")
 	res, err := handler(ctx, requestMsg)
 	require.NotNil(t, res)
 	require.NoError(t, err)
@@ -33,10 +33,11 @@ func TestSuccessRequestOracleData(t *testing.T) {
 		types.OracleScriptID(1), []byte("calldata"),
 		[]sdk.ValAddress{testapp.Validator3.ValAddress, testapp.Validator1.ValAddress, testapp.Validator2.ValAddress},
 		2, 4, testapp.ParseTime(1581589790), "app_test", []types.RawRequest{
-			types.NewRawRequest(1, 1, []byte("beeb")),
-			types.NewRawRequest(2, 2, []byte("beeb")),
-			types.NewRawRequest(3, 3, []byte("beeb")),
+			types.NewRawRequest(1, 1, []byte("beeb"), 1),
+			types.NewRawRequest(2, 2, []byte("beeb"), 1),
+			types.NewRawRequest(3, 3, []byte("beeb"), 1),
 		},
+		1,
 	)
 	app.EndBlocker(ctx, abci.RequestEndBlock{Height: 4})
 	request, err := k.GetRequest(ctx, types.RequestID(1))
@@ -111,7 +112,7 @@ func TestExpiredRequestOracleData(t *testing.T) {
 
 	ctx = ctx.WithBlockHeight(4).WithBlockTime(time.Unix(1581589790, 0))
 	handler := oracle.NewHandler(k)
-	requestMsg := types.NewMsgRequestData(types.OracleScriptID(1), []byte("calldata"), 3, 2, "app_test", testapp.Alice.Address)
+	requestMsg := types.NewMsgRequestData(types.OracleScriptID(1), []byte("calldata"), 3, 2, "app_test", testapp.Alice.Address, "")
 	res, err := handler(ctx, requestMsg)
 	require.NotNil(t, res)
 	require.NoError(t, err)
@@ -120,10 +121,11 @@ func TestExpiredRequestOracleData(t *testing.T) {
 		types.OracleScriptID(1), []byte("calldata"),
 		[]sdk.ValAddress{testapp.Validator3.ValAddress, testapp.Validator1.ValAddress, testapp.Validator2.ValAddress},
 		2, 4, testapp.ParseTime(1581589790), "app_test", []types.RawRequest{
-			types.NewRawRequest(1, 1, []byte("beeb")),
-			types.NewRawRequest(2, 2, []byte("beeb")),
-			types.NewRawRequest(3, 3, []byte("beeb")),
+			types.NewRawRequest(1, 1, []byte("beeb"), 1),
+			types.NewRawRequest(2, 2, []byte("beeb"), 1),
+			types.NewRawRequest(3, 3, []byte("beeb"), 1),
 		},
+		1,
 	)
 	app.EndBlocker(ctx, abci.RequestEndBlock{Height: 4})
 	request, err := k.GetRequest(ctx, types.RequestID(1))
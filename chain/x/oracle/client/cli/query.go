@@ -4,15 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/spf13/cobra"
 
-	clientcmn "github.com/bandprotocol/bandchain/chain/x/oracle/client/common"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/client/common/proof"
 	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
 )
 
@@ -29,11 +32,19 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetQueryCmdParams(storeKey, cdc),
 		GetQueryCmdCounts(storeKey, cdc),
 		GetQueryCmdDataSource(storeKey, cdc),
+		GetQueryCmdDataSourceVersions(storeKey, cdc),
 		GetQueryCmdOracleScript(storeKey, cdc),
+		GetQueryCmdOracleScriptVersions(storeKey, cdc),
 		GetQueryCmdRequest(storeKey, cdc),
 		GetQueryCmdRequestSearch(storeKey, cdc),
+		GetQueryCmdRequestsByOracleScript(storeKey, cdc),
+		GetQueryCmdRequestsByClientID(storeKey, cdc),
+		GetQueryCmdRequestsByResolveStatus(storeKey, cdc),
+		GetQueryCmdSimulate(storeKey, cdc),
 		GetQueryCmdValidatorStatus(storeKey, cdc),
 		GetQueryCmdReporters(storeKey, cdc),
+		GetQueryCmdStandingRequest(storeKey, cdc),
+		GetQueryCmdProof(storeKey, cdc),
 	)...)
 	return oracleCmd
 }
@@ -114,20 +125,62 @@ func GetQueryCmdOracleScript(route string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetQueryCmdDataSourceVersions implements the query data source versions command.
+func GetQueryCmdDataSourceVersions(route string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:  "data-source-versions [id]",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", route, types.QueryDataSourceVersions, args[0]))
+			if err != nil {
+				return err
+			}
+			return printOutput(cliCtx, cdc, bz, &[]types.DataSourceVersion{})
+		},
+	}
+}
+
+// GetQueryCmdOracleScriptVersions implements the query oracle script versions command.
+func GetQueryCmdOracleScriptVersions(route string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:  "oracle-script-versions [id]",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", route, types.QueryOracleScriptVersions, args[0]))
+			if err != nil {
+				return err
+			}
+			return printOutput(cliCtx, cdc, bz, &[]types.OracleScriptVersion{})
+		},
+	}
+}
+
 // GetQueryCmdRequest implements the query request command.
 func GetQueryCmdRequest(route string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:  "request [id]",
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", route, types.QueryRequests, args[0]))
+			decode, err := cmd.Flags().GetBool(flagDecode)
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("custom/%s/%s/%s", route, types.QueryRequests, args[0])
+			if decode {
+				path = fmt.Sprintf("%s/%s", path, types.QueryDecodeOption)
+			}
+			bz, _, err := cliCtx.Query(path)
 			if err != nil {
 				return err
 			}
 			return printOutput(cliCtx, cdc, bz, &types.QueryRequestResult{})
 		},
 	}
+	cmd.Flags().Bool(flagDecode, false, "Decode the calldata and the result to JSON with the oracle script schema")
+	return cmd
 }
 
 // GetQueryCmdRequestSearch implements the search request command.
@@ -137,7 +190,9 @@ func GetQueryCmdRequestSearch(route string, cdc *codec.Codec) *cobra.Command {
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			bz, _, err := clientcmn.QuerySearchLatestRequest(route, cliCtx, args[0], args[1], args[2], args[3])
+			bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s/%s/%s/%s",
+				route, types.QueryRequestSearch, args[0], args[1], args[2], args[3],
+			))
 			if err != nil {
 				return err
 			}
@@ -146,6 +201,128 @@ func GetQueryCmdRequestSearch(route string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// queryRequestIndex queries the given page of request IDs from the given request index.
+func queryRequestIndex(cmd *cobra.Command, route string, cdc *codec.Codec, query string, key string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	page, err := cmd.Flags().GetInt(flags.FlagPage)
+	if err != nil {
+		return err
+	}
+	limit, err := cmd.Flags().GetInt(flags.FlagLimit)
+	if err != nil {
+		return err
+	}
+	bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d/%d/%s", route, query, page, limit, key))
+	if err != nil {
+		return err
+	}
+	return printOutput(cliCtx, cdc, bz, &[]types.RequestID{})
+}
+
+// GetQueryCmdRequestsByOracleScript implements the query requests by oracle script command.
+func GetQueryCmdRequestsByOracleScript(route string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "requests-by-oracle-script [id]",
+		Short: "List IDs of requests to an oracle script, latest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryRequestIndex(cmd, route, cdc, types.QueryRequestsByOracleScript, args[0])
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, rest.DefaultLimit, "Query number of results returned per page")
+	return cmd
+}
+
+// GetQueryCmdRequestsByClientID implements the query requests by client ID command.
+func GetQueryCmdRequestsByClientID(route string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "requests-by-client-id [client-id]",
+		Short: "List IDs of requests with a client ID, latest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryRequestIndex(cmd, route, cdc, types.QueryRequestsByClientID, args[0])
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, rest.DefaultLimit, "Query number of results returned per page")
+	return cmd
+}
+
+// GetQueryCmdRequestsByResolveStatus implements the query requests by resolve status command.
+func GetQueryCmdRequestsByResolveStatus(route string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "requests-by-resolve-status [Open|Success|Failure|Expired]",
+		Short: "List IDs of requests with a resolve status, latest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryRequestIndex(cmd, route, cdc, types.QueryRequestsByResolveStatus, args[0])
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, rest.DefaultLimit, "Query number of results returned per page")
+	return cmd
+}
+
+// GetQueryCmdSimulate implements the simulate oracle script command.
+func GetQueryCmdSimulate(route string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [oracle-script-id] [ask-count] [min-count]",
+		Short: "Simulate an oracle script against the current state without making a request",
+		Long: strings.TrimSpace(`Run the prepare function of an oracle script and return the raw requests it makes.
+If mock reports are given, also run the execute function and return its result. The reports are a JSON
+list of the raw reports of each validator, e.g. [[{"external_id":"1","exit_code":0,"data":"<base64>"}]].`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			oracleScriptID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			askCount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			minCount, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			calldata, err := cmd.Flags().GetBytesHex(flagCalldata)
+			if err != nil {
+				return err
+			}
+			reportsJSON, err := cmd.Flags().GetString(flagReports)
+			if err != nil {
+				return err
+			}
+			var reports [][]types.RawReport
+			if reportsJSON != "" {
+				if err := cdc.UnmarshalJSON([]byte(reportsJSON), &reports); err != nil {
+					return err
+				}
+			}
+			bz, err := cdc.MarshalJSON(types.QuerySimulateParams{
+				OracleScriptID: types.OracleScriptID(oracleScriptID),
+				Calldata:       calldata,
+				AskCount:       askCount,
+				MinCount:       minCount,
+				Reports:        reports,
+			})
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", route, types.QuerySimulate), bz)
+			if err != nil {
+				return err
+			}
+			return printOutput(cliCtx, cdc, res, &types.QuerySimulateResult{})
+		},
+	}
+	cmd.Flags().BytesHexP(flagCalldata, "c", nil, "Calldata used in calling the oracle script")
+	cmd.Flags().String(flagReports, "", "JSON list of mock raw reports of each validator used to simulate execution")
+	return cmd
+}
+
 // GetQueryCmdValidatorStatus implements the query reporter list of validator command.
 func GetQueryCmdValidatorStatus(route string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -177,3 +354,74 @@ func GetQueryCmdReporters(route string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetQueryCmdStandingRequest implements the query standing request command.
+func GetQueryCmdStandingRequest(route string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:  "standing-request [id]",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", route, types.QueryStandingRequests, args[0]))
+			if err != nil {
+				return err
+			}
+			return printOutput(cliCtx, cdc, bz, &types.QueryStandingRequestResult{})
+		},
+	}
+}
+
+// GetQueryCmdProof implements the query proof command.
+func GetQueryCmdProof(route string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof [request-id]...",
+		Short: "Get the proof of the results of the given requests, relaying one block for all of them",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			requestIDs := make([]types.RequestID, len(args))
+			for i, arg := range args {
+				id, err := strconv.ParseInt(arg, 10, 64)
+				if err != nil {
+					return err
+				}
+				requestIDs[i] = types.RequestID(id)
+			}
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+			// The height flag selects the block to relay, while the requests are checked against
+			// the latest state.
+			var height *int64
+			if cliCtx.Height != 0 {
+				height = &cliCtx.Height
+			}
+			cliCtx = cliCtx.WithHeight(0)
+			if len(requestIDs) == 1 {
+				jsonProof, err := proof.GetProof(cliCtx, route, requestIDs[0], height)
+				if err != nil {
+					return err
+				}
+				out, err := proof.EncodeProof(jsonProof, encoding)
+				if err != nil {
+					return err
+				}
+				return cliCtx.PrintOutput(out)
+			}
+			jsonProof, err := proof.GetMultiProof(cliCtx, route, requestIDs, height)
+			if err != nil {
+				return err
+			}
+			out, err := proof.EncodeMultiProof(jsonProof, encoding)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().String(flagEncoding, proof.EVMEncoding,
+		fmt.Sprintf("Encoding of the proof bytes, one of [%s]", strings.Join(proof.ProofEncodings(), ", ")),
+	)
+	return cmd
+}
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/bandprotocol/bandchain/chain/pkg/obi"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+const (
+	// waitPollInterval is the interval between queries while waiting for a request to resolve.
+	waitPollInterval = time.Second
+	// waitTxTimeout is the maximum duration to wait for a broadcast transaction to be included.
+	waitTxTimeout = time.Minute
+	// defaultWaitResultTimeout is the default maximum duration to wait for a request to resolve.
+	defaultWaitResultTimeout = 5 * time.Minute
+)
+
+// waitRequestOutput is the output of the request command when waiting for the request result.
+// The result is decoded to JSON with the schema of the oracle script version that the request was
+// made to, or printed raw along with the reason if it cannot be decoded.
+type waitRequestOutput struct {
+	RequestID     types.RequestID  `json:"request_id"`
+	ResolveStatus string           `json:"resolve_status"`
+	Result        json.RawMessage  `json:"result,omitempty"`
+	RawResult     tmbytes.HexBytes `json:"raw_result,omitempty"`
+	DecodeError   string           `json:"decode_error,omitempty"`
+}
+
+// MarshalYAML implements yaml.Marshaler to print the result as a JSON or hex string instead of a
+// list of bytes in the CLI text output.
+func (o waitRequestOutput) MarshalYAML() (interface{}, error) {
+	var rawResult string
+	if len(o.RawResult) != 0 {
+		rawResult = o.RawResult.String()
+	}
+	return struct {
+		RequestID     types.RequestID `yaml:"request_id"`
+		ResolveStatus string          `yaml:"resolve_status"`
+		Result        string          `yaml:"result,omitempty"`
+		RawResult     string          `yaml:"raw_result,omitempty"`
+		DecodeError   string          `yaml:"decode_error,omitempty"`
+	}{o.RequestID, o.ResolveStatus, string(o.Result), rawResult, o.DecodeError}, nil
+}
+
+// queryModule queries the given path of the oracle module and unmarshals the result into out.
+// Returns error if the query does not succeed.
+func queryModule(cliCtx context.CLIContext, path string, out interface{}) error {
+	bz, _, err := cliCtx.Query(path)
+	if err != nil {
+		return err
+	}
+	var result types.QueryResult
+	if err := json.Unmarshal(bz, &result); err != nil {
+		return err
+	}
+	if result.Status != http.StatusOK {
+		return fmt.Errorf("query %s failed: %s", path, result.Result)
+	}
+	return cliCtx.Codec.UnmarshalJSON(result.Result, out)
+}
+
+// queryOracleScriptSchema returns the parsed OBI schema of the given oracle script, or nil if the
+// oracle script has no schema.
+func queryOracleScriptSchema(cliCtx context.CLIContext, route string, id types.OracleScriptID) (*obi.Schema, error) {
+	var oracleScript types.OracleScript
+	err := queryModule(cliCtx, fmt.Sprintf("custom/%s/%s/%d", route, types.QueryOracleScripts, id), &oracleScript)
+	if err != nil {
+		return nil, err
+	}
+	if oracleScript.Schema == "" {
+		return nil, nil
+	}
+	schema, err := obi.ParseSchema(oracleScript.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// broadcastMsgs signs and broadcasts the given messages similar to utils.CompleteAndBroadcastTxCLI,
+// but returns the broadcast response for the caller to follow up. Returns nil response if the
+// transaction is only simulated or the user declines it.
+func broadcastMsgs(cliCtx context.CLIContext, txBldr auth.TxBuilder, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	txBldr, err := utils.PrepareTxBuilder(txBldr, cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
+		txBldr, err = utils.EnrichWithGas(txBldr, cliCtx, msgs)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(os.Stderr, "%s\n", utils.GasEstimateResponse{GasEstimate: txBldr.Gas()})
+	}
+	if cliCtx.Simulate {
+		return nil, nil
+	}
+	if !cliCtx.SkipConfirm {
+		stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(os.Stderr, "%s\n\n", cliCtx.Codec.MustMarshalJSON(stdSignMsg))
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", bufio.NewReader(os.Stdin))
+		if err != nil || !ok {
+			fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
+			return nil, err
+		}
+	}
+	txBytes, err := txBldr.BuildAndSign(cliCtx.GetFromName(), keys.DefaultKeyPass, msgs)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cliCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := cliCtx.PrintOutput(res); err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("transaction failed with code %d: %s", res.Code, res.RawLog)
+	}
+	return &res, nil
+}
+
+// getRequestID returns the ID of the request created by the transaction with the given logs.
+func getRequestID(logs sdk.ABCIMessageLogs) (types.RequestID, bool) {
+	for _, log := range logs {
+		for _, event := range log.Events {
+			if event.Type != types.EventTypeRequest {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key != types.AttributeKeyID {
+					continue
+				}
+				id, err := strconv.ParseInt(attr.Value, 10, 64)
+				if err != nil {
+					return 0, false
+				}
+				return types.RequestID(id), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// waitRequestID returns the ID of the request created by the given broadcast transaction. Polls
+// for the transaction to be included if it is broadcast without waiting for a block.
+func waitRequestID(cliCtx context.CLIContext, res *sdk.TxResponse) (types.RequestID, error) {
+	logs := res.Logs
+	deadline := time.Now().Add(waitTxTimeout)
+	for len(logs) == 0 {
+		if time.Now().After(deadline) {
+			return 0, fmt.Errorf("transaction %s was not included after %s", res.TxHash, waitTxTimeout)
+		}
+		time.Sleep(waitPollInterval)
+		txRes, err := utils.QueryTx(cliCtx, res.TxHash)
+		if err != nil {
+			continue
+		}
+		if txRes.Code != 0 {
+			return 0, fmt.Errorf("transaction failed with code %d: %s", txRes.Code, txRes.RawLog)
+		}
+		logs = txRes.Logs
+	}
+	id, ok := getRequestID(logs)
+	if !ok {
+		return 0, fmt.Errorf("request ID not found in transaction %s", res.TxHash)
+	}
+	return id, nil
+}
+
+// waitRequestResult polls the given request until it is resolved or the timeout passes, then
+// prints its result decoded with the schema of the oracle script version that the request was
+// made to, or the raw result if it cannot be decoded.
+func waitRequestResult(cliCtx context.CLIContext, route string, id types.RequestID, timeout time.Duration) error {
+	path := fmt.Sprintf("custom/%s/%s/%d/%s", route, types.QueryRequests, id, types.QueryDecodeOption)
+	var req types.QueryRequestResult
+	deadline := time.Now().Add(timeout)
+	for {
+		if err := queryModule(cliCtx, path, &req); err != nil {
+			return err
+		}
+		if req.Result != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("request %d was not resolved after %s", id, timeout)
+		}
+		time.Sleep(waitPollInterval)
+	}
+	out := waitRequestOutput{
+		RequestID:     id,
+		ResolveStatus: req.Result.ResponsePacketData.ResolveStatus.String(),
+	}
+	if req.Result.ResponsePacketData.ResolveStatus == types.ResolveStatus_Success {
+		if req.Decoded != nil && len(req.Decoded.Result) != 0 {
+			out.Result = req.Decoded.Result
+		} else {
+			out.RawResult = req.Result.ResponsePacketData.Result
+			if req.Decoded != nil {
+				out.DecodeError = req.Decoded.Error
+			}
+		}
+	}
+	return cliCtx.PrintOutput(out)
+}
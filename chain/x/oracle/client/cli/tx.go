@@ -28,6 +28,15 @@ const (
 	flagClientID      = "client-id"
 	flagSchema        = "schema"
 	flagSourceCodeURL = "url"
+	flagFee           = "fee"
+	flagFeeLimit      = "fee-limit"
+	flagBudget        = "budget"
+	flagReports       = "reports"
+	flagCalldataJSON  = "calldata-json"
+	flagWait          = "wait"
+	flagWaitTimeout   = "wait-timeout"
+	flagDecode        = "decode"
+	flagEncoding      = "encoding"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -44,28 +53,33 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdEditDataSource(cdc),
 		GetCmdCreateOracleScript(cdc),
 		GetCmdEditOracleScript(cdc),
-		GetCmdRequest(cdc),
+		GetCmdRequest(storeKey, cdc),
 		GetCmdActivate(cdc),
 		GetCmdAddReporter(cdc),
 		GetCmdRemoveReporter(cdc),
+		GetCmdCreateStandingRequest(cdc),
+		GetCmdCancelStandingRequest(cdc),
 	)...)
 
 	return oracleCmd
 }
 
 // GetCmdRequest implements the request command handler.
-func GetCmdRequest(cdc *codec.Codec) *cobra.Command {
+func GetCmdRequest(route string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id])",
+		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata] | --calldata-json [json]) (-m [client-id]) (--fee-limit [coins]) (--wait) (--wait-timeout [duration])",
 		Short: "Make a new data request via an existing oracle script",
 		Args:  cobra.ExactArgs(3),
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Make a new request via an existing oracle script with the configuration flags.
+Calldata can be given as OBI-encoded hex, or as JSON which is encoded against the oracle script's schema.
+With --wait, the command waits until the request is resolved and prints the result decoded to JSON.
 Example:
 $ %s tx oracle request 1 4 3 -c 1234abcdef -x 20 -m client-id --from mykey
-$ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --from mykey
+$ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-limit 100uband --from mykey
+$ %s tx oracle request 1 4 3 --calldata-json '{"symbol":"BTC","multiplier":1000000}' --wait --from mykey
 `,
-				version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -94,11 +108,51 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --from
 				return err
 			}
 
+			calldataJSON, err := cmd.Flags().GetString(flagCalldataJSON)
+			if err != nil {
+				return err
+			}
+
 			clientID, err := cmd.Flags().GetString(flagClientID)
 			if err != nil {
 				return err
 			}
 
+			feeLimit, err := cmd.Flags().GetString(flagFeeLimit)
+			if err != nil {
+				return err
+			}
+
+			wait, err := cmd.Flags().GetBool(flagWait)
+			if err != nil {
+				return err
+			}
+			if wait && cliCtx.GenerateOnly {
+				return fmt.Errorf("--%s cannot be used with --%s", flagWait, flags.FlagGenerateOnly)
+			}
+
+			waitTimeout, err := cmd.Flags().GetDuration(flagWaitTimeout)
+			if err != nil {
+				return err
+			}
+
+			if calldataJSON != "" {
+				if len(calldata) != 0 {
+					return fmt.Errorf("--%s cannot be used with --%s", flagCalldata, flagCalldataJSON)
+				}
+				schema, err := queryOracleScriptSchema(cliCtx, route, oracleScriptID)
+				if err != nil {
+					return err
+				}
+				if schema == nil {
+					return fmt.Errorf("oracle script %d has no schema", oracleScriptID)
+				}
+				calldata, err = schema.EncodeInputJSON([]byte(calldataJSON))
+				if err != nil {
+					return err
+				}
+			}
+
 			msg := types.NewMsgRequestData(
 				oracleScriptID,
 				calldata,
@@ -106,6 +160,7 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --from
 				minCount,
 				clientID,
 				cliCtx.GetFromAddress(),
+				feeLimit,
 			)
 
 			err = msg.ValidateBasic()
@@ -113,12 +168,27 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --from
 				return err
 			}
 
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			if !wait {
+				return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			}
+			res, err := broadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			if err != nil || res == nil {
+				return err
+			}
+			requestID, err := waitRequestID(cliCtx, res)
+			if err != nil {
+				return err
+			}
+			return waitRequestResult(cliCtx, route, requestID, waitTimeout)
 		},
 	}
 
 	cmd.Flags().BytesHexP(flagCalldata, "c", nil, "Calldata used in calling the oracle script")
+	cmd.Flags().String(flagCalldataJSON, "", "Calldata in JSON, encoded with the input schema of the oracle script")
 	cmd.Flags().StringP(flagClientID, "m", "", "Requester can match up the request with response by clientID")
+	cmd.Flags().String(flagFeeLimit, "", "Maximum amount of coins to pay to data source owners")
+	cmd.Flags().Bool(flagWait, false, "Wait until the request is resolved and print the result, decoded to JSON if the oracle script has a schema")
+	cmd.Flags().Duration(flagWaitTimeout, defaultWaitResultTimeout, "Maximum duration to wait for the request to be resolved with --wait")
 
 	return cmd
 }
@@ -126,13 +196,13 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --from
 // GetCmdCreateDataSource implements the create data source command handler.
 func GetCmdCreateDataSource(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-data-source (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner])",
+		Use:   "create-data-source (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--fee [coins])",
 		Short: "Create a new data source",
 		Args:  cobra.NoArgs,
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Create a new data source that will be used by oracle scripts.
 Example:
-$ %s tx oracle create-data-source --name coingecko-price --description "The script that queries crypto price from cryptocompare" --script ../price.sh --owner band15d4apf20449ajvwycq8ruaypt7v6d345n9fpt9 --from mykey
+$ %s tx oracle create-data-source --name coingecko-price --description "The script that queries crypto price from cryptocompare" --script ../price.sh --owner band15d4apf20449ajvwycq8ruaypt7v6d345n9fpt9 --fee 10uband --from mykey
 `,
 				version.ClientName,
 			),
@@ -170,12 +240,18 @@ $ %s tx oracle create-data-source --name coingecko-price --description "The scri
 				return err
 			}
 
+			fee, err := cmd.Flags().GetString(flagFee)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateDataSource(
 				owner,
 				name,
 				description,
 				execBytes,
 				cliCtx.GetFromAddress(),
+				fee,
 			)
 
 			err = msg.ValidateBasic()
@@ -190,6 +266,7 @@ $ %s tx oracle create-data-source --name coingecko-price --description "The scri
 	cmd.Flags().String(flagDescription, "", "Description of this data source")
 	cmd.Flags().String(flagScript, "", "Path to this data source script")
 	cmd.Flags().String(flagOwner, "", "Owner of this data source")
+	cmd.Flags().String(flagFee, "", "Fee paid to the owner for every request to this data source")
 
 	return cmd
 }
@@ -197,7 +274,7 @@ $ %s tx oracle create-data-source --name coingecko-price --description "The scri
 // GetCmdEditDataSource implements the edit data source command handler.
 func GetCmdEditDataSource(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "edit-data-source [id] (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner])",
+		Use:   "edit-data-source [id] (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--fee [coins])",
 		Short: "Edit data source",
 		Args:  cobra.ExactArgs(1),
 		Long: strings.TrimSpace(
@@ -249,6 +326,11 @@ $ %s tx oracle edit-data-source 1 --name coingecko-price --description The scrip
 				return err
 			}
 
+			fee, err := cmd.Flags().GetString(flagFee)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgEditDataSource(
 				dataSourceID,
 				owner,
@@ -256,6 +338,7 @@ $ %s tx oracle edit-data-source 1 --name coingecko-price --description The scrip
 				description,
 				execBytes,
 				cliCtx.GetFromAddress(),
+				fee,
 			)
 
 			err = msg.ValidateBasic()
@@ -270,6 +353,7 @@ $ %s tx oracle edit-data-source 1 --name coingecko-price --description The scrip
 	cmd.Flags().String(flagDescription, types.DoNotModify, "Description of this data source")
 	cmd.Flags().String(flagScript, types.DoNotModify, "Path to this data source script")
 	cmd.Flags().String(flagOwner, "", "Owner of this data source")
+	cmd.Flags().String(flagFee, types.DoNotModify, "Fee paid to the owner for every request to this data source")
 
 	return cmd
 }
@@ -559,3 +643,129 @@ $ %s tx oracle remove-reporter band1p40yh3zkmhcv0ecqp3mcazy83sa57rgjp07dun --fro
 
 	return cmd
 }
+
+// GetCmdCreateStandingRequest implements the create standing request command handler.
+func GetCmdCreateStandingRequest(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-standing-request [oracle-script-id] [ask-count] [min-count] [interval] (-c [calldata]) (-m [client-id]) (--budget [coins]) (--fee-limit [coins])",
+		Short: "Create a data request that is sent automatically every interval blocks",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a standing request that sends a new data request every interval blocks until the budget runs out or it is cancelled.
+Example:
+$ %s tx oracle create-standing-request 1 4 3 10 -c 1234abcdef -m client-id --budget 1000uband --fee-limit 10uband --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			int64OracleScriptID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			oracleScriptID := types.OracleScriptID(int64OracleScriptID)
+
+			askCount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			minCount, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			calldata, err := cmd.Flags().GetBytesHex(flagCalldata)
+			if err != nil {
+				return err
+			}
+
+			clientID, err := cmd.Flags().GetString(flagClientID)
+			if err != nil {
+				return err
+			}
+
+			budget, err := cmd.Flags().GetString(flagBudget)
+			if err != nil {
+				return err
+			}
+
+			feeLimit, err := cmd.Flags().GetString(flagFeeLimit)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateStandingRequest(
+				oracleScriptID,
+				calldata,
+				askCount,
+				minCount,
+				clientID,
+				interval,
+				budget,
+				feeLimit,
+				cliCtx.GetFromAddress(),
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().BytesHexP(flagCalldata, "c", nil, "Calldata used in calling the oracle script")
+	cmd.Flags().StringP(flagClientID, "m", "", "Requester can match up the request with response by clientID")
+	cmd.Flags().String(flagBudget, "", "Amount of coins prepaid to cover data source fees of all requests")
+	cmd.Flags().String(flagFeeLimit, "", "Maximum amount of coins to pay to data source owners per request")
+
+	return cmd
+}
+
+// GetCmdCancelStandingRequest implements the cancel standing request command handler.
+func GetCmdCancelStandingRequest(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-standing-request [id]",
+		Short: "Cancel a standing request and refund its remaining budget",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a standing request owned by the sender and refund its remaining budget.
+Example:
+$ %s tx oracle cancel-standing-request 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelStandingRequest(
+				types.StandingRequestID(id),
+				cliCtx.GetFromAddress(),
+			)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
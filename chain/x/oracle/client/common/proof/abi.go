@@ -231,3 +231,82 @@ var verifyFormat = []byte(`
   }
 ]
 `)
+
+var verifyStoreValueFormat = []byte(`
+[
+  {
+    "internalType": "uint256",
+    "name": "_blockHeight",
+    "type": "uint256"
+  },
+  {
+    "internalType": "bytes",
+    "name": "_key",
+    "type": "bytes"
+  },
+  {
+    "internalType": "bytes",
+    "name": "_value",
+    "type": "bytes"
+  },
+  {
+    "internalType": "uint256",
+    "name": "_version",
+    "type": "uint256"
+  },
+  {
+    "components": [
+      {
+        "internalType": "bool",
+        "name": "isDataOnRight",
+        "type": "bool"
+      },
+      {
+        "internalType": "uint8",
+        "name": "subtreeHeight",
+        "type": "uint8"
+      },
+      {
+        "internalType": "uint256",
+        "name": "subtreeSize",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "subtreeVersion",
+        "type": "uint256"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "siblingHash",
+        "type": "bytes32"
+      }
+    ],
+    "internalType": "struct IAVLMerklePath.Data[]",
+    "name": "_merklePaths",
+    "type": "tuple[]"
+  }
+]
+`)
+
+var relayAndVerifyFormat = []byte(`
+[
+  {
+    "type": "bytes"
+  },
+  {
+    "type": "bytes"
+  }
+]
+`)
+
+var relayAndMultiVerifyFormat = []byte(`
+[
+  {
+    "type": "bytes"
+  },
+  {
+    "type": "bytes[]"
+  }
+]
+`)
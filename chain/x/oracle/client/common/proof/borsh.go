@@ -0,0 +1,164 @@
+package proof
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// borshEncoder encodes proofs with Borsh (https://borsh.io) for bridges on non-EVM chains. The
+// layout follows the fields of JsonProof and JsonMultiProof in order: integers are little-endian,
+// byte strings and lists are prefixed with their u32 length, and hashes are fixed 32 bytes.
+//
+//	Proof            = BlockHeight u64, OracleDataProof, BlockRelayProof
+//	MultiProof       = BlockHeight u64, Vec<OracleDataProof>, BlockRelayProof
+//	StoreProof       = BlockHeight u64, Key Vec<u8>, Value Vec<u8>, Version u64,
+//	                   Vec<IAVLMerklePath>, BlockRelayProof
+//	OracleDataProof  = RequestPacket, ResponsePacket, Version u64, Vec<IAVLMerklePath>
+//	RequestPacket    = ClientID String, OracleScriptID u64, Calldata Vec<u8>, AskCount u64,
+//	                   MinCount u64
+//	ResponsePacket   = ClientID String, RequestID u64, AnsCount u64, RequestTime i64,
+//	                   ResolveTime i64, ResolveStatus u8, Result Vec<u8>
+//	IAVLMerklePath   = IsDataOnRight bool, SubtreeHeight u8, SubtreeSize u64,
+//	                   SubtreeVersion u64, SiblingHash [u8; 32]
+//	BlockRelayProof  = MultiStoreProof (5 x [u8; 32]), BlockHeaderMerkleParts (6 x [u8; 32]),
+//	                   Vec<TMSignature>
+//	TMSignature      = R [u8; 32], S [u8; 32], V u8, SignedDataPrefix Vec<u8>,
+//	                   SignedDataSuffix Vec<u8>
+type borshEncoder struct{}
+
+func (borshEncoder) EncodeProof(proof JsonProof) ([]byte, error) {
+	w := &borshWriter{}
+	w.writeU64(proof.BlockHeight)
+	w.writeOracleDataProof(proof.OracleDataProof)
+	w.writeBlockRelayProof(proof.BlockRelayProof)
+	return w.result()
+}
+
+func (borshEncoder) EncodeMultiProof(proof JsonMultiProof) ([]byte, error) {
+	w := &borshWriter{}
+	w.writeU64(proof.BlockHeight)
+	w.writeU32(uint32(len(proof.OracleDataMultiProof)))
+	for _, oracleData := range proof.OracleDataMultiProof {
+		w.writeOracleDataProof(oracleData)
+	}
+	w.writeBlockRelayProof(proof.BlockRelayProof)
+	return w.result()
+}
+
+func (borshEncoder) EncodeStoreProof(proof JsonStoreProof) ([]byte, error) {
+	w := &borshWriter{}
+	w.writeU64(proof.BlockHeight)
+	w.writeBytes(proof.StoreValueProof.Key)
+	w.writeBytes(proof.StoreValueProof.Value)
+	w.writeU64(proof.StoreValueProof.Version)
+	w.writeMerklePaths(proof.StoreValueProof.MerklePaths)
+	w.writeBlockRelayProof(proof.BlockRelayProof)
+	return w.result()
+}
+
+// borshWriter appends Borsh-encoded values to its buffer. The first error is kept and fails the
+// encoding at the end.
+type borshWriter struct {
+	buf []byte
+	err error
+}
+
+func (w *borshWriter) result() ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.buf, nil
+}
+
+func (w *borshWriter) writeU8(v uint8) {
+	w.buf = append(w.buf, v)
+}
+
+func (w *borshWriter) writeBool(v bool) {
+	if v {
+		w.writeU8(1)
+	} else {
+		w.writeU8(0)
+	}
+}
+
+func (w *borshWriter) writeU32(v uint32) {
+	bz := make([]byte, 4)
+	binary.LittleEndian.PutUint32(bz, v)
+	w.buf = append(w.buf, bz...)
+}
+
+func (w *borshWriter) writeU64(v uint64) {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, v)
+	w.buf = append(w.buf, bz...)
+}
+
+func (w *borshWriter) writeBytes(v []byte) {
+	w.writeU32(uint32(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+func (w *borshWriter) writeHash(v []byte) {
+	if len(v) != 32 {
+		if w.err == nil {
+			w.err = fmt.Errorf("borsh: invalid hash length %d, expect 32", len(v))
+		}
+		return
+	}
+	w.buf = append(w.buf, v...)
+}
+
+func (w *borshWriter) writeOracleDataProof(o OracleDataProof) {
+	req := o.RequestPacket
+	w.writeBytes([]byte(req.ClientID))
+	w.writeU64(uint64(req.OracleScriptID))
+	w.writeBytes(req.Calldata)
+	w.writeU64(req.AskCount)
+	w.writeU64(req.MinCount)
+	res := o.ResponsePacket
+	w.writeBytes([]byte(res.ClientID))
+	w.writeU64(uint64(res.RequestID))
+	w.writeU64(res.AnsCount)
+	w.writeU64(uint64(res.RequestTime))
+	w.writeU64(uint64(res.ResolveTime))
+	w.writeU8(uint8(res.ResolveStatus))
+	w.writeBytes(res.Result)
+	w.writeU64(o.Version)
+	w.writeMerklePaths(o.MerklePaths)
+}
+
+func (w *borshWriter) writeMerklePaths(paths []IAVLMerklePath) {
+	w.writeU32(uint32(len(paths)))
+	for _, path := range paths {
+		w.writeBool(path.IsDataOnRight)
+		w.writeU8(path.SubtreeHeight)
+		w.writeU64(path.SubtreeSize)
+		w.writeU64(path.SubtreeVersion)
+		w.writeHash(path.SiblingHash)
+	}
+}
+
+func (w *borshWriter) writeBlockRelayProof(b BlockRelayProof) {
+	m := b.MultiStoreProof
+	w.writeHash(m.AccToGovStoresMerkleHash)
+	w.writeHash(m.MainAndMintStoresMerkleHash)
+	w.writeHash(m.OracleIAVLStateHash)
+	w.writeHash(m.ParamsStoresMerkleHash)
+	w.writeHash(m.SlashingToUpgradeStoresMerkleHash)
+	p := b.BlockHeaderMerkleParts
+	w.writeHash(p.VersionAndChainIdHash)
+	w.writeHash(p.TimeHash)
+	w.writeHash(p.LastBlockIDAndOther)
+	w.writeHash(p.NextValidatorHashAndConsensusHash)
+	w.writeHash(p.LastResultsHash)
+	w.writeHash(p.EvidenceAndProposerHash)
+	w.writeU32(uint32(len(b.Signatures)))
+	for _, sig := range b.Signatures {
+		w.writeHash(sig.R)
+		w.writeHash(sig.S)
+		w.writeU8(sig.V)
+		w.writeBytes(sig.SignedDataPrefix)
+		w.writeBytes(sig.SignedDataSuffix)
+	}
+}
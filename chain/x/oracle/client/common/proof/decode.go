@@ -0,0 +1,102 @@
+package proof
+
+import (
+	"math/big"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// relayDataEthereum is the arguments of the bridge's relayOracleState function.
+type relayDataEthereum struct {
+	BlockHeight *big.Int                       `abi:"_blockHeight"`
+	MultiStore  MultiStoreProofEthereum        `abi:"_multiStore"`
+	MerkleParts BlockHeaderMerklePartsEthereum `abi:"_merkleParts"`
+	Signatures  []TMSignatureEthereum          `abi:"_signatures"`
+}
+
+// verifyDataEthereum is the arguments of the bridge's verifyOracleData function.
+type verifyDataEthereum struct {
+	BlockHeight    *big.Int                 `abi:"_blockHeight"`
+	RequestPacket  RequestPacketEthereum    `abi:"_requestPacket"`
+	ResponsePacket ResponsePacketEthereum   `abi:"_responsePacket"`
+	Version        *big.Int                 `abi:"_version"`
+	MerklePaths    []IAVLMerklePathEthereum `abi:"_merklePaths"`
+}
+
+// DecodeEVMProofBytes decodes the proof bytes for the bridge's relayAndVerify function back into
+// its JSON proof form.
+func DecodeEVMProofBytes(bz []byte) (JsonProof, error) {
+	var relayData, verifyData []byte
+	if err := relayAndVerifyArguments.Unpack(&[]interface{}{&relayData, &verifyData}, bz); err != nil {
+		return JsonProof{}, err
+	}
+	var relay relayDataEthereum
+	if err := relayArguments.Unpack(&relay, relayData); err != nil {
+		return JsonProof{}, err
+	}
+	var verify verifyDataEthereum
+	if err := verifyArguments.Unpack(&verify, verifyData); err != nil {
+		return JsonProof{}, err
+	}
+	signatures := make([]TMSignature, len(relay.Signatures))
+	for i, sig := range relay.Signatures {
+		signatures[i] = TMSignature{
+			R:                sig.R.Bytes(),
+			S:                sig.S.Bytes(),
+			V:                sig.V,
+			SignedDataPrefix: sig.SignedDataPrefix,
+			SignedDataSuffix: sig.SignedDataSuffix,
+		}
+	}
+	merklePaths := make([]IAVLMerklePath, len(verify.MerklePaths))
+	for i, path := range verify.MerklePaths {
+		merklePaths[i] = IAVLMerklePath{
+			IsDataOnRight:  path.IsDataOnRight,
+			SubtreeHeight:  path.SubtreeHeight,
+			SubtreeSize:    path.SubtreeSize.Uint64(),
+			SubtreeVersion: path.SubtreeVersion.Uint64(),
+			SiblingHash:    path.SiblingHash.Bytes(),
+		}
+	}
+	return JsonProof{
+		BlockHeight: relay.BlockHeight.Uint64(),
+		OracleDataProof: OracleDataProof{
+			RequestPacket: types.OracleRequestPacketData{
+				ClientID:       verify.RequestPacket.ClientId,
+				OracleScriptID: types.OracleScriptID(verify.RequestPacket.OracleScriptId),
+				Calldata:       verify.RequestPacket.Params,
+				AskCount:       verify.RequestPacket.AskCount,
+				MinCount:       verify.RequestPacket.MinCount,
+			},
+			ResponsePacket: types.OracleResponsePacketData{
+				ClientID:      verify.ResponsePacket.ClientId,
+				RequestID:     types.RequestID(verify.ResponsePacket.RequestId),
+				AnsCount:      verify.ResponsePacket.AnsCount,
+				RequestTime:   int64(verify.ResponsePacket.RequestTime),
+				ResolveTime:   int64(verify.ResponsePacket.ResolveTime),
+				ResolveStatus: types.ResolveStatus(verify.ResponsePacket.ResolveStatus),
+				Result:        verify.ResponsePacket.Result,
+			},
+			Version:     verify.Version.Uint64(),
+			MerklePaths: merklePaths,
+		},
+		BlockRelayProof: BlockRelayProof{
+			MultiStoreProof: MultiStoreProof{
+				AccToGovStoresMerkleHash:          relay.MultiStore.AccToGovStoresMerkleHash.Bytes(),
+				MainAndMintStoresMerkleHash:       relay.MultiStore.MainAndMintStoresMerkleHash.Bytes(),
+				OracleIAVLStateHash:               relay.MultiStore.OracleIAVLStateHash.Bytes(),
+				ParamsStoresMerkleHash:            relay.MultiStore.ParamsStoresMerkleHash.Bytes(),
+				SlashingToUpgradeStoresMerkleHash: relay.MultiStore.SlashingToUpgradeStoresMerkleHash.Bytes(),
+			},
+			BlockHeaderMerkleParts: BlockHeaderMerkleParts{
+				VersionAndChainIdHash:             relay.MerkleParts.VersionAndChainIdHash.Bytes(),
+				TimeHash:                          relay.MerkleParts.TimeHash.Bytes(),
+				LastBlockIDAndOther:               relay.MerkleParts.LastBlockIDAndOther.Bytes(),
+				NextValidatorHashAndConsensusHash: relay.MerkleParts.NextValidatorHashAndConsensusHash.Bytes(),
+				LastResultsHash:                   relay.MerkleParts.LastResultsHash.Bytes(),
+				EvidenceAndProposerHash:           relay.MerkleParts.EvidenceAndProposerHash.Bytes(),
+			},
+			Signatures: signatures,
+		},
+	}, nil
+}
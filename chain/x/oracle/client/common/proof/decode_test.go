@@ -0,0 +1,78 @@
+package proof
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func testJsonProof() JsonProof {
+	return JsonProof{
+		BlockHeight: 191,
+		OracleDataProof: OracleDataProof{
+			RequestPacket: types.OracleRequestPacketData{
+				ClientID:       "test",
+				OracleScriptID: 1,
+				Calldata:       hexToBytes("000000034254430000000000000064"),
+				AskCount:       4,
+				MinCount:       4,
+			},
+			ResponsePacket: types.OracleResponsePacketData{
+				ClientID:      "test",
+				RequestID:     1,
+				AnsCount:      4,
+				RequestTime:   1592898765,
+				ResolveTime:   1592898773,
+				ResolveStatus: 1,
+				Result:        hexToBytes("00000000000eb9e6"),
+			},
+			Version: 180,
+			MerklePaths: []IAVLMerklePath{{
+				IsDataOnRight:  true,
+				SubtreeHeight:  1,
+				SubtreeSize:    2,
+				SubtreeVersion: 180,
+				SiblingHash:    hexToBytes("108873CE6589ADD98E40996E7EA55D6592CFEE6EBF140CFBE7F0C7EAF554B8BD"),
+			}},
+		},
+		BlockRelayProof: BlockRelayProof{
+			MultiStoreProof: MultiStoreProof{
+				AccToGovStoresMerkleHash:          hexToBytes("685430546D23A44E6B8034EAAFBC2F4CD7FEF54B54D5B66528CB4E5225BD74FB"),
+				MainAndMintStoresMerkleHash:       hexToBytes("4F8D0BB0CD3EB9DC70B4DBBEA5F0CBD5B523195F7BAE02BB401BB00A93ABA08E"),
+				OracleIAVLStateHash:               hexToBytes("1912057FFF0B3E85ABF1A319F75D37B21B430F3DA7DB9E486A5041DE47C686D3"),
+				ParamsStoresMerkleHash:            hexToBytes("B1F2FD852E790E735CA2D3014F96A2A53C60393E9C6BBF941B9A6DD6A05CF6F9"),
+				SlashingToUpgradeStoresMerkleHash: hexToBytes("91CC906286235B676AD402FC04ED768EB2BFECA664E8D595C286571DA1433C60"),
+			},
+			BlockHeaderMerkleParts: BlockHeaderMerkleParts{
+				VersionAndChainIdHash:             hexToBytes("32FA694879095840619F5E49380612BD296FF7E950EAFB66FF654D99CA70869E"),
+				TimeHash:                          hexToBytes("D9F175396C0E2D0E77F69856ABF5D8E69283CB915EB8886262FEDA1D519B3005"),
+				LastBlockIDAndOther:               hexToBytes("4F4D548668A3986DB253689234B9CAC96303A128B7081B16E53B79AB9E65B887"),
+				NextValidatorHashAndConsensusHash: hexToBytes("004209A161040AB1778E2F2C00EE482F205B28EFBA439FCB04EA283F619478D9"),
+				LastResultsHash:                   hexToBytes("6E340B9CFFB37A989CA544E6BB780A2C78901D3FB33738768511A30617AFA01D"),
+				EvidenceAndProposerHash:           hexToBytes("D991DA4D4E69473CC75A4B819F9E07D4956671A6F4A74DF4CC16596FCBE68137"),
+			},
+			Signatures: []TMSignature{{
+				R:                hexToBytes("9279257914BFEE6FAEC46DF086E9A673A4C1576FB299094D45F77E12FA3728E7"),
+				S:                hexToBytes("04DBF23F5EBB07BA8FD7CA06843DFE363B5E86596930E1889D9BD5BD3E98FC53"),
+				V:                28,
+				SignedDataPrefix: hexToBytes("6E080211BF0000000000000022480A20"),
+				SignedDataSuffix: hexToBytes("12240A2066424E8F0417945A71067A55B7121282A90524E3C0709D8F8ADDB6ADC8FD46D110012A0C08E6E9C6F70510979FBD9801320962616E64636861696E"),
+			}},
+		},
+	}
+}
+
+func TestDecodeEVMProofBytes(t *testing.T) {
+	p := testJsonProof()
+	evmProofBytes, err := evmEncoder{}.EncodeProof(p)
+	require.NoError(t, err)
+
+	decoded, err := DecodeEVMProofBytes(evmProofBytes)
+	require.NoError(t, err)
+	require.Equal(t, p, decoded)
+
+	_, err = DecodeEVMProofBytes(evmProofBytes[:100])
+	require.Error(t, err)
+}
@@ -0,0 +1,102 @@
+package proof
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// EVMEncoding is the Ethereum ABI encoding for the EVM bridge contract.
+	EVMEncoding = "evm"
+	// BorshEncoding is the Borsh encoding for bridges on non-EVM chains.
+	BorshEncoding = "borsh"
+)
+
+// ProofEncoder serializes proofs into the format that a bridge on the target chain accepts.
+type ProofEncoder interface {
+	// EncodeProof serializes the proof of a single request result.
+	EncodeProof(proof JsonProof) ([]byte, error)
+	// EncodeMultiProof serializes the proof of many request results under one block relay.
+	EncodeMultiProof(proof JsonMultiProof) ([]byte, error)
+	// EncodeStoreProof serializes the proof of an oracle store entry.
+	EncodeStoreProof(proof JsonStoreProof) ([]byte, error)
+}
+
+var proofEncoders = map[string]ProofEncoder{}
+
+func init() {
+	RegisterProofEncoder(EVMEncoding, evmEncoder{})
+	RegisterProofEncoder(BorshEncoding, borshEncoder{})
+}
+
+// RegisterProofEncoder registers the proof encoder with the given encoding name. Panics if the
+// name is already registered.
+func RegisterProofEncoder(encoding string, encoder ProofEncoder) {
+	if _, ok := proofEncoders[encoding]; ok {
+		panic(fmt.Sprintf("proof encoder %s is already registered", encoding))
+	}
+	proofEncoders[encoding] = encoder
+}
+
+// GetProofEncoder returns the proof encoder registered with the given encoding name.
+func GetProofEncoder(encoding string) (ProofEncoder, error) {
+	encoder, ok := proofEncoders[encoding]
+	if !ok {
+		return nil, fmt.Errorf("unknown proof encoding %s, must be one of [%s]", encoding, strings.Join(ProofEncodings(), ", "))
+	}
+	return encoder, nil
+}
+
+// ProofEncodings returns the sorted list of registered proof encoding names.
+func ProofEncodings() []string {
+	encodings := make([]string, 0, len(proofEncoders))
+	for encoding := range proofEncoders {
+		encodings = append(encodings, encoding)
+	}
+	sort.Strings(encodings)
+	return encodings
+}
+
+// evmEncoder encodes proofs as the calldata of the bridge contract's relayAndVerify,
+// relayAndMultiVerify and relayAndVerifyStoreValue functions.
+type evmEncoder struct{}
+
+func (evmEncoder) EncodeProof(proof JsonProof) ([]byte, error) {
+	blockRelayBytes, err := proof.BlockRelayProof.encodeToEthData(proof.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	oracleDataBytes, err := proof.OracleDataProof.encodeToEthData(proof.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	return relayAndVerifyArguments.Pack(blockRelayBytes, oracleDataBytes)
+}
+
+func (evmEncoder) EncodeMultiProof(proof JsonMultiProof) ([]byte, error) {
+	blockRelayBytes, err := proof.BlockRelayProof.encodeToEthData(proof.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	oracleDataMultiBytes := make([][]byte, len(proof.OracleDataMultiProof))
+	for i, oracleData := range proof.OracleDataMultiProof {
+		oracleDataMultiBytes[i], err = oracleData.encodeToEthData(proof.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return relayAndMultiVerifyArguments.Pack(blockRelayBytes, oracleDataMultiBytes)
+}
+
+func (evmEncoder) EncodeStoreProof(proof JsonStoreProof) ([]byte, error) {
+	blockRelayBytes, err := proof.BlockRelayProof.encodeToEthData(proof.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	storeValueBytes, err := proof.StoreValueProof.encodeToEthData(proof.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	return relayAndVerifyArguments.Pack(blockRelayBytes, storeValueBytes)
+}
@@ -0,0 +1,114 @@
+package proof
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProofEncoder(t *testing.T) {
+	require.Equal(t, []string{BorshEncoding, EVMEncoding}, ProofEncodings())
+	_, err := GetProofEncoder(EVMEncoding)
+	require.NoError(t, err)
+	_, err = GetProofEncoder("scale")
+	require.EqualError(t, err, "unknown proof encoding scale, must be one of [borsh, evm]")
+	require.Panics(t, func() { RegisterProofEncoder(EVMEncoding, evmEncoder{}) })
+}
+
+func TestEncodeProof(t *testing.T) {
+	p := testJsonProof()
+	proof, err := EncodeProof(p, EVMEncoding)
+	require.NoError(t, err)
+	require.Equal(t, proof.ProofBytes, proof.EVMProofBytes)
+	proof, err = EncodeProof(p, BorshEncoding)
+	require.NoError(t, err)
+	require.Nil(t, proof.EVMProofBytes)
+	require.Equal(t, BorshEncoding, proof.Encoding)
+	_, err = EncodeProof(p, "scale")
+	require.Error(t, err)
+}
+
+func TestEncodeBorsh(t *testing.T) {
+	p := testJsonProof()
+	bz, err := borshEncoder{}.EncodeProof(p)
+	require.NoError(t, err)
+	require.Equal(t, hexToBytes(
+		"bf00000000000000"+ // block height
+			"0400000074657374"+"0100000000000000"+ // client ID, oracle script ID
+			"0f000000"+"000000034254430000000000000064"+ // calldata
+			"0400000000000000"+"0400000000000000"+ // ask count, min count
+			"0400000074657374"+"0100000000000000"+"0400000000000000"+ // client ID, request ID, ans count
+			"cdb4f15e00000000"+"d5b4f15e00000000"+"01"+ // request time, resolve time, resolve status
+			"08000000"+"00000000000eb9e6"+ // result
+			"b400000000000000"+ // version
+			"01000000"+"01"+"01"+"0200000000000000"+"b400000000000000"+ // merkle paths
+			"108873ce6589add98e40996e7ea55d6592cfee6ebf140cfbe7f0c7eaf554b8bd",
+	), bz[:174])
+	// 11 hashes of the block relay proof and 1 signature with 16-byte prefix and 63-byte suffix.
+	require.Len(t, bz, 174+11*32+4+(32+32+1+4+16+4+63))
+	require.Equal(t, uint32(1), binary.LittleEndian.Uint32(bz[174+11*32:]))
+
+	p.BlockRelayProof.MultiStoreProof.OracleIAVLStateHash = []byte{1, 2, 3}
+	_, err = borshEncoder{}.EncodeProof(p)
+	require.EqualError(t, err, "borsh: invalid hash length 3, expect 32")
+}
+
+func TestEncodeBorshMultiProof(t *testing.T) {
+	p := testJsonProof()
+	single, err := borshEncoder{}.EncodeProof(p)
+	require.NoError(t, err)
+	multi, err := borshEncoder{}.EncodeMultiProof(JsonMultiProof{
+		BlockHeight:          p.BlockHeight,
+		OracleDataMultiProof: []OracleDataProof{p.OracleDataProof, p.OracleDataProof},
+		BlockRelayProof:      p.BlockRelayProof,
+	})
+	require.NoError(t, err)
+	oracleData := single[8 : len(single)-(11*32+4+(32+32+1+4+16+4+63))]
+	expected := append([]byte{}, single[:8]...)
+	expected = append(expected, 2, 0, 0, 0)
+	expected = append(expected, oracleData...)
+	expected = append(expected, oracleData...)
+	expected = append(expected, single[8+len(oracleData):]...)
+	require.Equal(t, expected, multi)
+}
+
+func testJsonStoreProof() JsonStoreProof {
+	p := testJsonProof()
+	return JsonStoreProof{
+		BlockHeight: p.BlockHeight,
+		StoreValueProof: StoreValueProof{
+			Key:         hexToBytes("020000000000000001"),
+			Value:       []byte("value"),
+			Version:     p.OracleDataProof.Version,
+			MerklePaths: p.OracleDataProof.MerklePaths,
+		},
+		BlockRelayProof: p.BlockRelayProof,
+	}
+}
+
+func TestEncodeStoreProof(t *testing.T) {
+	p := testJsonStoreProof()
+	bz, err := EncodeStoreProof(p, EVMEncoding)
+	require.NoError(t, err)
+	values, err := relayAndVerifyArguments.UnpackValues(bz)
+	require.NoError(t, err)
+	storeValue, err := verifyStoreValueArguments.UnpackValues(values[1].([]byte))
+	require.NoError(t, err)
+	require.Equal(t, []byte(p.StoreValueProof.Key), storeValue[1])
+	require.Equal(t, []byte(p.StoreValueProof.Value), storeValue[2])
+
+	bz, err = EncodeStoreProof(p, BorshEncoding)
+	require.NoError(t, err)
+	require.Equal(t, hexToBytes(
+		"bf00000000000000"+ // block height
+			"09000000"+"020000000000000001"+ // key
+			"05000000"+"76616c7565"+ // value
+			"b400000000000000"+ // version
+			"01000000"+"01"+"01"+"0200000000000000"+"b400000000000000"+ // merkle paths
+			"108873ce6589add98e40996e7ea55d6592cfee6ebf140cfbe7f0c7eaf554b8bd",
+	), bz[:92])
+	require.Len(t, bz, 92+11*32+4+(32+32+1+4+16+4+63))
+	_, err = EncodeStoreProof(p, "scale")
+	require.Error(t, err)
+}
@@ -0,0 +1,163 @@
+package proof
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+const (
+	RequestIDsTag = "id"
+	// MaxMultiProofRequests is the max number of requests in one multi proof, the same as the
+	// MAX_MULTI_VERIFY_COUNT of the bridge contract.
+	MaxMultiProofRequests = 16
+)
+
+// JsonMultiProof is the proof of many request results under one block relay proof.
+type JsonMultiProof struct {
+	BlockHeight          uint64            `json:"blockHeight"`
+	OracleDataMultiProof []OracleDataProof `json:"oracleDataMultiProof"`
+	BlockRelayProof      BlockRelayProof   `json:"blockRelayProof"`
+}
+
+// MultiProof is the response of the multi proof endpoint. See Proof for the bytes fields.
+type MultiProof struct {
+	JsonProof     JsonMultiProof   `json:"jsonProof"`
+	EVMProofBytes tmbytes.HexBytes `json:"evmProofBytes,omitempty"`
+	Encoding      string           `json:"encoding"`
+	ProofBytes    tmbytes.HexBytes `json:"proofBytes"`
+}
+
+// parseRequestIDsOrReturnBadRequest parses the list of request IDs from the repeated id query
+// parameter of multi proof requests.
+func parseRequestIDsOrReturnBadRequest(w http.ResponseWriter, r *http.Request) ([]types.RequestID, bool) {
+	if err := r.ParseForm(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	idStrs := r.Form[RequestIDsTag]
+	requestIDs := make([]types.RequestID, len(idStrs))
+	for i, idStr := range idStrs {
+		intRequestID, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return nil, false
+		}
+		requestIDs[i] = types.RequestID(intRequestID)
+	}
+	return requestIDs, true
+}
+
+// checkRequestIDs checks that the given list of request IDs is non-empty, not too long and has no
+// duplicates.
+func checkRequestIDs(requestIDs []types.RequestID) error {
+	if len(requestIDs) == 0 {
+		return newProofError(http.StatusBadRequest, "at least one request ID is required")
+	}
+	if len(requestIDs) > MaxMultiProofRequests {
+		return newProofError(http.StatusBadRequest,
+			"too many request IDs, got: %d, max: %d", len(requestIDs), MaxMultiProofRequests,
+		)
+	}
+	seen := make(map[types.RequestID]bool)
+	for _, requestID := range requestIDs {
+		if seen[requestID] {
+			return newProofError(http.StatusBadRequest, "duplicate request ID %d", requestID)
+		}
+		seen[requestID] = true
+	}
+	return nil
+}
+
+// GetMultiProof builds the proofs of the results of the given requests, relaying the block that
+// commits to them only once. See GetProof for the height.
+func GetMultiProof(
+	cliCtx context.CLIContext, route string, requestIDs []types.RequestID, height *int64,
+) (JsonMultiProof, error) {
+	if err := checkRequestIDs(requestIDs); err != nil {
+		return JsonMultiProof{}, err
+	}
+	commit, err := getCommit(cliCtx, height)
+	if err != nil {
+		return JsonMultiProof{}, err
+	}
+	for _, requestID := range requestIDs {
+		if err := checkRequestResolved(cliCtx, route, commit, requestID); err != nil {
+			return JsonMultiProof{}, err
+		}
+	}
+	oracleDataMultiProof := make([]OracleDataProof, len(requestIDs))
+	var blockRelay BlockRelayProof
+	for i, requestID := range requestIDs {
+		oracleData, multiStoreProof, err := getOracleDataProof(cliCtx, commit, requestID)
+		if err != nil {
+			return JsonMultiProof{}, err
+		}
+		// All results are queried from the same state, so they share the same multistore proof.
+		if i == 0 {
+			blockRelay, err = getBlockRelayProof(cliCtx, commit, multiStoreProof)
+			if err != nil {
+				return JsonMultiProof{}, err
+			}
+		}
+		oracleDataMultiProof[i] = oracleData
+	}
+	return JsonMultiProof{
+		BlockHeight:          uint64(commit.Height),
+		OracleDataMultiProof: oracleDataMultiProof,
+		BlockRelayProof:      blockRelay,
+	}, nil
+}
+
+// EncodeMultiProof returns the multi proof response of the given proof serialized with the given
+// encoding.
+func EncodeMultiProof(jsonProof JsonMultiProof, encoding string) (MultiProof, error) {
+	encoder, err := GetProofEncoder(encoding)
+	if err != nil {
+		return MultiProof{}, err
+	}
+	proofBytes, err := encoder.EncodeMultiProof(jsonProof)
+	if err != nil {
+		return MultiProof{}, err
+	}
+	proof := MultiProof{JsonProof: jsonProof, Encoding: encoding, ProofBytes: proofBytes}
+	if encoding == EVMEncoding {
+		proof.EVMProofBytes = proofBytes
+	}
+	return proof, nil
+}
+
+// GetMultiProofHandlerFn returns the proofs of the results of many requests, relaying the block
+// that commits to them only once.
+func GetMultiProofHandlerFn(cliCtx context.CLIContext, route string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestIDs, ok := parseRequestIDsOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		height, ok := parseHeightOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		encoding, ok := parseEncodingOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		jsonProof, err := GetMultiProof(cliCtx, route, requestIDs, height)
+		if err != nil {
+			writeProofError(w, err)
+			return
+		}
+		proof, err := EncodeMultiProof(jsonProof, encoding)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, proof)
+	}
+}
@@ -0,0 +1,51 @@
+package proof
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestParseRequestIDs(t *testing.T) {
+	w := httptest.NewRecorder()
+	requestIDs, ok := parseRequestIDsOrReturnBadRequest(w, httptest.NewRequest("GET", "/oracle/multi_proof?id=3&id=1&id=2", nil))
+	require.True(t, ok)
+	require.Equal(t, []types.RequestID{3, 1, 2}, requestIDs)
+
+	for _, query := range []string{"?id=", "?id=1&id=abc", "?id=-1"} {
+		w = httptest.NewRecorder()
+		_, ok = parseRequestIDsOrReturnBadRequest(w, httptest.NewRequest("GET", "/oracle/multi_proof"+query, nil))
+		require.False(t, ok, query)
+		require.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
+
+func TestCheckRequestIDs(t *testing.T) {
+	require.NoError(t, checkRequestIDs([]types.RequestID{3, 1, 2}))
+	err := checkRequestIDs(nil)
+	require.Equal(t, http.StatusBadRequest, err.(proofError).status)
+	err = checkRequestIDs([]types.RequestID{1, 2, 1})
+	require.Equal(t, http.StatusBadRequest, err.(proofError).status)
+	requestIDs := make([]types.RequestID, MaxMultiProofRequests+1)
+	for i := range requestIDs {
+		requestIDs[i] = types.RequestID(i + 1)
+	}
+	require.NoError(t, checkRequestIDs(requestIDs[:MaxMultiProofRequests]))
+	err = checkRequestIDs(requestIDs)
+	require.Equal(t, http.StatusBadRequest, err.(proofError).status)
+}
+
+func TestEncodeRelayAndMultiVerify(t *testing.T) {
+	relay := []byte("relay")
+	verifies := [][]byte{[]byte("verify1"), []byte("verify2")}
+	bz, err := relayAndMultiVerifyArguments.Pack(relay, verifies)
+	require.NoError(t, err)
+	values, err := relayAndMultiVerifyArguments.UnpackValues(bz)
+	require.NoError(t, err)
+	require.Equal(t, relay, values[0])
+	require.Equal(t, verifies, values[1])
+}
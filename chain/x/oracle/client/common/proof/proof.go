@@ -15,19 +15,24 @@ import (
 	"github.com/tendermint/iavl"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/bandprotocol/bandchain/chain/pkg/obi"
-	clientcmn "github.com/bandprotocol/bandchain/chain/x/oracle/client/common"
 	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
 )
 
 var (
-	relayArguments  abi.Arguments
-	verifyArguments abi.Arguments
+	relayArguments               abi.Arguments
+	verifyArguments              abi.Arguments
+	relayAndVerifyArguments      abi.Arguments
+	relayAndMultiVerifyArguments abi.Arguments
+	verifyStoreValueArguments    abi.Arguments
 )
 
 const (
 	RequestIDTag = "requestID"
+	HeightTag    = "height"
+	EncodingTag  = "encoding"
 )
 
 func init() {
@@ -39,6 +44,18 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = json.Unmarshal(relayAndVerifyFormat, &relayAndVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(relayAndMultiVerifyFormat, &relayAndMultiVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(verifyStoreValueFormat, &verifyStoreValueArguments)
+	if err != nil {
+		panic(err)
+	}
 }
 
 type BlockRelayProof struct {
@@ -87,159 +104,290 @@ type JsonProof struct {
 	BlockRelayProof BlockRelayProof `json:"blockRelayProof"`
 }
 
+// Proof is the response of the proof endpoint. ProofBytes is the proof serialized with the
+// requested encoding. EVMProofBytes is kept for the default EVM encoding for compatibility.
 type Proof struct {
 	JsonProof     JsonProof        `json:"jsonProof"`
-	EVMProofBytes tmbytes.HexBytes `json:"evmProofBytes"`
+	EVMProofBytes tmbytes.HexBytes `json:"evmProofBytes,omitempty"`
+	Encoding      string           `json:"encoding"`
+	ProofBytes    tmbytes.HexBytes `json:"proofBytes"`
 }
 
-func GetProofHandlerFn(cliCtx context.CLIContext, route string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		intRequestID, err := strconv.ParseUint(vars[RequestIDTag], 10, 64)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		requestID := types.RequestID(intRequestID)
-		bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", route, types.QueryRequests, requestID))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-		var qResult types.QueryResult
-		if err := json.Unmarshal(bz, &qResult); err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-		if qResult.Status != http.StatusOK {
-			clientcmn.PostProcessQueryResponse(w, cliCtx, bz)
-			return
-		}
-		var request types.QueryRequestResult
-		if err := cliCtx.Codec.UnmarshalJSON(qResult.Result, &request); err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-		if request.Result == nil {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "Result has not been resolved")
-			return
-		}
+// proofError is an error while building a proof, along with the HTTP status to respond with.
+type proofError struct {
+	status int
+	msg    string
+}
 
-		commit, err := cliCtx.Client.Commit(nil)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+func (e proofError) Error() string {
+	return e.msg
+}
+
+func newProofError(status int, format string, args ...interface{}) error {
+	return proofError{status: status, msg: fmt.Sprintf(format, args...)}
+}
+
+// writeProofError writes the given error to the response with the status of the proof error, or
+// internal server error otherwise.
+func writeProofError(w http.ResponseWriter, err error) {
+	if pErr, ok := err.(proofError); ok {
+		rest.WriteErrorResponse(w, pErr.status, pErr.msg)
+		return
+	}
+	rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+}
+
+// parseHeightOrReturnBadRequest parses the optional height query parameter of proof requests.
+// Returns nil height if the parameter is not given.
+func parseHeightOrReturnBadRequest(w http.ResponseWriter, r *http.Request) (*int64, bool) {
+	heightStr := r.FormValue(HeightTag)
+	if heightStr == "" {
+		return nil, true
+	}
+	height, err := strconv.ParseInt(heightStr, 10, 64)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return &height, true
+}
+
+// parseEncodingOrReturnBadRequest parses the optional encoding query parameter of proof
+// requests, defaulting to the EVM encoding.
+func parseEncodingOrReturnBadRequest(w http.ResponseWriter, r *http.Request) (string, bool) {
+	encoding := r.FormValue(EncodingTag)
+	if encoding == "" {
+		encoding = EVMEncoding
+	}
+	if _, err := GetProofEncoder(encoding); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return "", false
+	}
+	return encoding, true
+}
+
+// getCommit returns the signed header of the given height, or the latest block if the height is
+// nil. The height must be greater than one since the app hash of a block commits to the state of
+// the previous height.
+func getCommit(cliCtx context.CLIContext, height *int64) (*ctypes.ResultCommit, error) {
+	if height == nil {
+		return cliCtx.Client.Commit(nil)
+	}
+	if *height <= 1 {
+		return nil, newProofError(http.StatusBadRequest, "height must be greater than 1, but got %d", *height)
+	}
+	commit, err := cliCtx.Client.Commit(height)
+	if err != nil {
+		return nil, newProofError(http.StatusBadRequest, "block at height %d is not available: %s", *height, err.Error())
+	}
+	return commit, nil
+}
+
+// checkRequestResolved checks that the given request exists and has been resolved in the state
+// committed in the given signed header.
+func checkRequestResolved(
+	cliCtx context.CLIContext, route string, commit *ctypes.ResultCommit, requestID types.RequestID,
+) error {
+	bz, _, err := cliCtx.WithHeight(commit.Height - 1).Query(fmt.Sprintf("custom/%s/%s/%d", route, types.QueryRequests, requestID))
+	if err != nil {
+		return err
+	}
+	var qResult types.QueryResult
+	if err := json.Unmarshal(bz, &qResult); err != nil {
+		return err
+	}
+	if qResult.Status != http.StatusOK {
+		var msg string
+		if err := json.Unmarshal(qResult.Result, &msg); err != nil {
+			msg = string(qResult.Result)
 		}
+		return newProofError(qResult.Status, msg)
+	}
+	var request types.QueryRequestResult
+	if err := cliCtx.Codec.UnmarshalJSON(qResult.Result, &request); err != nil {
+		return err
+	}
+	if request.Result == nil {
+		return newProofError(http.StatusNotFound,
+			"Result of request %d has not been resolved at height %d", requestID, commit.Height,
+		)
+	}
+	return nil
+}
 
-		resp, err := cliCtx.Client.ABCIQueryWithOptions(
-			"/store/oracle/key",
-			types.ResultStoreKey(requestID),
-			rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
+// getStoreValueProof queries the value of the given key in the oracle store with its IAVL and
+// multistore proofs from the state committed in the given signed header. The IAVL proof is nil
+// if the key does not exist in the state.
+func getStoreValueProof(
+	cliCtx context.CLIContext, commit *ctypes.ResultCommit, key []byte,
+) ([]byte, *iavl.ValueOp, rootmulti.MultiStoreProofOp, error) {
+	resp, err := cliCtx.Client.ABCIQueryWithOptions(
+		"/store/oracle/key",
+		key,
+		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
+	)
+	if err != nil {
+		return nil, nil, rootmulti.MultiStoreProofOp{}, err
+	}
+	if resp.Response.IsErr() {
+		// The store returns an error instead of a proof once the state has been pruned.
+		return nil, nil, rootmulti.MultiStoreProofOp{}, newProofError(http.StatusNotFound,
+			"state at height %d is not available, it may have been pruned: %s",
+			commit.Height-1, resp.Response.GetLog(),
 		)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
+	}
 
-		proof := resp.Response.GetProof()
-		if proof == nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, "Proof not found")
-			return
-		}
+	proof := resp.Response.GetProof()
+	if proof == nil {
+		return nil, nil, rootmulti.MultiStoreProofOp{}, fmt.Errorf("Proof not found")
+	}
 
-		ops := proof.GetOps()
-		if ops == nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, "proof ops not found")
-			return
-		}
+	ops := proof.GetOps()
+	if ops == nil {
+		return nil, nil, rootmulti.MultiStoreProofOp{}, fmt.Errorf("proof ops not found")
+	}
 
-		var iavlProof iavl.ValueOp
-		var multiStoreProof rootmulti.MultiStoreProofOp
-		for _, op := range ops {
-			opType := op.GetType()
-			if opType == "iavl:v" {
-				err := cliCtx.Codec.UnmarshalBinaryLengthPrefixed(op.GetData(), &iavlProof)
-				if err != nil {
-					rest.WriteErrorResponse(w, http.StatusInternalServerError,
-						fmt.Sprintf("iavl: %s", err.Error()),
-					)
-					return
-				}
-			} else if opType == "multistore" {
-				mp, err := rootmulti.MultiStoreProofOpDecoder(op)
-				multiStoreProof = mp.(rootmulti.MultiStoreProofOp)
-				if err != nil {
-					rest.WriteErrorResponse(w, http.StatusInternalServerError,
-						fmt.Sprintf("multiStore: %s", err.Error()),
-					)
-					return
-				}
+	var iavlProof iavl.ValueOp
+	var multiStoreProof rootmulti.MultiStoreProofOp
+	for _, op := range ops {
+		opType := op.GetType()
+		if opType == "iavl:v" {
+			err := cliCtx.Codec.UnmarshalBinaryLengthPrefixed(op.GetData(), &iavlProof)
+			if err != nil {
+				return nil, nil, rootmulti.MultiStoreProofOp{}, fmt.Errorf("iavl: %s", err.Error())
 			}
+		} else if opType == "multistore" {
+			mp, err := rootmulti.MultiStoreProofOpDecoder(op)
+			if err != nil {
+				return nil, nil, rootmulti.MultiStoreProofOp{}, fmt.Errorf("multiStore: %s", err.Error())
+			}
+			multiStoreProof = mp.(rootmulti.MultiStoreProofOp)
 		}
-		if iavlProof.Proof == nil {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "Proof has not been ready.")
-			return
-		}
-		eventHeight := iavlProof.Proof.Leaves[0].Version
-		signatures, err := GetSignaturesAndPrefix(&commit.SignedHeader)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-		blockRelay := BlockRelayProof{
-			MultiStoreProof:        GetMultiStoreProof(multiStoreProof),
-			BlockHeaderMerkleParts: GetBlockHeaderMerkleParts(cliCtx.Codec, commit.Header),
-			Signatures:             signatures,
-		}
-		resValue := resp.Response.GetValue()
+	}
+	if iavlProof.Proof == nil {
+		return nil, nil, multiStoreProof, nil
+	}
+	return resp.Response.GetValue(), &iavlProof, multiStoreProof, nil
+}
 
-		type result struct {
-			Req types.OracleRequestPacketData
-			Res types.OracleResponsePacketData
-		}
-		var rs result
-		obi.MustDecode(resValue, &rs)
-
-		oracleData := OracleDataProof{
-			RequestPacket:  rs.Req,
-			ResponsePacket: rs.Res,
-			Version:        uint64(eventHeight),
-			MerklePaths:    GetIAVLMerklePaths(&iavlProof),
-		}
+// getOracleDataProof queries the result of the given request with its IAVL and multistore proofs
+// from the state committed in the given signed header.
+func getOracleDataProof(
+	cliCtx context.CLIContext, commit *ctypes.ResultCommit, requestID types.RequestID,
+) (OracleDataProof, rootmulti.MultiStoreProofOp, error) {
+	value, iavlProof, multiStoreProof, err := getStoreValueProof(cliCtx, commit, types.ResultStoreKey(requestID))
+	if err != nil {
+		return OracleDataProof{}, rootmulti.MultiStoreProofOp{}, err
+	}
+	if iavlProof == nil {
+		return OracleDataProof{}, rootmulti.MultiStoreProofOp{}, newProofError(http.StatusNotFound,
+			"Result of request %d has not been resolved at height %d", requestID, commit.Height,
+		)
+	}
+	eventHeight := iavlProof.Proof.Leaves[0].Version
 
-		// Calculate byte for proofbytes
-		var relayAndVerifyArguments abi.Arguments
-		format := `[{"type":"bytes"},{"type":"bytes"}]`
-		err = json.Unmarshal([]byte(format), &relayAndVerifyArguments)
-		if err != nil {
-			panic(err)
-		}
+	type result struct {
+		Req types.OracleRequestPacketData
+		Res types.OracleResponsePacketData
+	}
+	var rs result
+	obi.MustDecode(value, &rs)
+
+	return OracleDataProof{
+		RequestPacket:  rs.Req,
+		ResponsePacket: rs.Res,
+		Version:        uint64(eventHeight),
+		MerklePaths:    GetIAVLMerklePaths(iavlProof),
+	}, multiStoreProof, nil
+}
 
-		blockRelayBytes, err := blockRelay.encodeToEthData(uint64(commit.Height))
+// getBlockRelayProof returns the block relay proof of the given signed header and the multistore
+// proof of the state it commits to.
+func getBlockRelayProof(
+	cliCtx context.CLIContext, commit *ctypes.ResultCommit, multiStoreProof rootmulti.MultiStoreProofOp,
+) (BlockRelayProof, error) {
+	signatures, err := GetSignaturesAndPrefix(&commit.SignedHeader)
+	if err != nil {
+		return BlockRelayProof{}, err
+	}
+	return BlockRelayProof{
+		MultiStoreProof:        GetMultiStoreProof(multiStoreProof),
+		BlockHeaderMerkleParts: GetBlockHeaderMerkleParts(cliCtx.Codec, commit.Header),
+		Signatures:             signatures,
+	}, nil
+}
+
+// GetProof builds the proof of the result of the given request against the signed header of the
+// given height, or the latest block if the height is nil. The app hash of a block commits to the
+// state of the previous height, so the result is proven as of one height lower.
+func GetProof(
+	cliCtx context.CLIContext, route string, requestID types.RequestID, height *int64,
+) (JsonProof, error) {
+	commit, err := getCommit(cliCtx, height)
+	if err != nil {
+		return JsonProof{}, err
+	}
+	if err := checkRequestResolved(cliCtx, route, commit, requestID); err != nil {
+		return JsonProof{}, err
+	}
+	oracleData, multiStoreProof, err := getOracleDataProof(cliCtx, commit, requestID)
+	if err != nil {
+		return JsonProof{}, err
+	}
+	blockRelay, err := getBlockRelayProof(cliCtx, commit, multiStoreProof)
+	if err != nil {
+		return JsonProof{}, err
+	}
+	return JsonProof{
+		BlockHeight:     uint64(commit.Height),
+		OracleDataProof: oracleData,
+		BlockRelayProof: blockRelay,
+	}, nil
+}
+
+// EncodeProof returns the proof response of the given proof serialized with the given encoding.
+func EncodeProof(jsonProof JsonProof, encoding string) (Proof, error) {
+	encoder, err := GetProofEncoder(encoding)
+	if err != nil {
+		return Proof{}, err
+	}
+	proofBytes, err := encoder.EncodeProof(jsonProof)
+	if err != nil {
+		return Proof{}, err
+	}
+	proof := Proof{JsonProof: jsonProof, Encoding: encoding, ProofBytes: proofBytes}
+	if encoding == EVMEncoding {
+		proof.EVMProofBytes = proofBytes
+	}
+	return proof, nil
+}
+
+func GetProofHandlerFn(cliCtx context.CLIContext, route string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		intRequestID, err := strconv.ParseUint(vars[RequestIDTag], 10, 64)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-
-		oracleDataBytes, err := oracleData.encodeToEthData(uint64(commit.Height))
+		height, ok := parseHeightOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		encoding, ok := parseEncodingOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		jsonProof, err := GetProof(cliCtx, route, types.RequestID(intRequestID), height)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			writeProofError(w, err)
 			return
 		}
-
-		evmProofBytes, err := relayAndVerifyArguments.Pack(blockRelayBytes, oracleDataBytes)
+		proof, err := EncodeProof(jsonProof, encoding)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-
-		rest.PostProcessResponse(w, cliCtx, Proof{
-			JsonProof: JsonProof{
-				BlockHeight:     uint64(commit.Height),
-				OracleDataProof: oracleData,
-				BlockRelayProof: blockRelay,
-			},
-			EVMProofBytes: evmProofBytes,
-		})
+		rest.PostProcessResponse(w, cliCtx, proof)
 	}
 }
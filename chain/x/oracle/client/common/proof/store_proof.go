@@ -0,0 +1,375 @@
+package proof
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+const (
+	DataSourceIDTag   = "dataSourceID"
+	OracleScriptIDTag = "oracleScriptID"
+	VersionTag        = "version"
+)
+
+// StoreValueProof is the proof of an entry of the oracle store, such as a data source or an
+// oracle script. Value is the raw value as stored on BandChain.
+type StoreValueProof struct {
+	Key         tmbytes.HexBytes `json:"key"`
+	Value       tmbytes.HexBytes `json:"value"`
+	Version     uint64           `json:"version"`
+	MerklePaths []IAVLMerklePath `json:"merklePaths"`
+}
+
+func (s *StoreValueProof) encodeToEthData(blockHeight uint64) ([]byte, error) {
+	parsePaths := make([]IAVLMerklePathEthereum, len(s.MerklePaths))
+	for i, path := range s.MerklePaths {
+		parsePaths[i] = path.encodeToEthFormat()
+	}
+	return verifyStoreValueArguments.Pack(
+		big.NewInt(int64(blockHeight)),
+		[]byte(s.Key),
+		[]byte(s.Value),
+		big.NewInt(int64(s.Version)),
+		parsePaths,
+	)
+}
+
+// JsonStoreProof is the proof of an oracle store entry under a block relay proof.
+type JsonStoreProof struct {
+	BlockHeight     uint64          `json:"blockHeight"`
+	StoreValueProof StoreValueProof `json:"storeValueProof"`
+	BlockRelayProof BlockRelayProof `json:"blockRelayProof"`
+}
+
+// DataSourceProof is the response of the data source proof endpoint. See Proof for the bytes
+// fields.
+type DataSourceProof struct {
+	JsonProof  JsonStoreProof   `json:"jsonProof"`
+	DataSource types.DataSource `json:"dataSource"`
+	Encoding   string           `json:"encoding"`
+	ProofBytes tmbytes.HexBytes `json:"proofBytes"`
+}
+
+// OracleScriptProof is the response of the oracle script proof endpoint. See Proof for the bytes
+// fields.
+type OracleScriptProof struct {
+	JsonProof    JsonStoreProof     `json:"jsonProof"`
+	OracleScript types.OracleScript `json:"oracleScript"`
+	Encoding     string             `json:"encoding"`
+	ProofBytes   tmbytes.HexBytes   `json:"proofBytes"`
+}
+
+// DataSourceVersionProof is the response of the data source version proof endpoint. See Proof for
+// the bytes fields.
+type DataSourceVersionProof struct {
+	JsonProof         JsonStoreProof          `json:"jsonProof"`
+	DataSourceVersion types.DataSourceVersion `json:"dataSourceVersion"`
+	Encoding          string                  `json:"encoding"`
+	ProofBytes        tmbytes.HexBytes        `json:"proofBytes"`
+}
+
+// OracleScriptVersionProof is the response of the oracle script version proof endpoint. See Proof
+// for the bytes fields.
+type OracleScriptVersionProof struct {
+	JsonProof           JsonStoreProof            `json:"jsonProof"`
+	OracleScriptVersion types.OracleScriptVersion `json:"oracleScriptVersion"`
+	Encoding            string                    `json:"encoding"`
+	ProofBytes          tmbytes.HexBytes          `json:"proofBytes"`
+}
+
+// GetStoreProof builds the proof of the entry of the given key in the oracle store. See GetProof
+// for the height.
+func GetStoreProof(cliCtx context.CLIContext, key []byte, height *int64) (JsonStoreProof, error) {
+	commit, err := getCommit(cliCtx, height)
+	if err != nil {
+		return JsonStoreProof{}, err
+	}
+	value, iavlProof, multiStoreProof, err := getStoreValueProof(cliCtx, commit, key)
+	if err != nil {
+		return JsonStoreProof{}, err
+	}
+	if iavlProof == nil {
+		return JsonStoreProof{}, newProofError(http.StatusNotFound,
+			"key %X does not exist at height %d", key, commit.Height,
+		)
+	}
+	blockRelay, err := getBlockRelayProof(cliCtx, commit, multiStoreProof)
+	if err != nil {
+		return JsonStoreProof{}, err
+	}
+	return JsonStoreProof{
+		BlockHeight: uint64(commit.Height),
+		StoreValueProof: StoreValueProof{
+			Key:         key,
+			Value:       value,
+			Version:     uint64(iavlProof.Proof.Leaves[0].Version),
+			MerklePaths: GetIAVLMerklePaths(iavlProof),
+		},
+		BlockRelayProof: blockRelay,
+	}, nil
+}
+
+// EncodeStoreProof serializes the given store proof with the given encoding.
+func EncodeStoreProof(jsonProof JsonStoreProof, encoding string) ([]byte, error) {
+	encoder, err := GetProofEncoder(encoding)
+	if err != nil {
+		return nil, err
+	}
+	return encoder.EncodeStoreProof(jsonProof)
+}
+
+// GetDataSourceProof builds the proof of the given data source.
+func GetDataSourceProof(
+	cliCtx context.CLIContext, id types.DataSourceID, height *int64,
+) (JsonStoreProof, types.DataSource, error) {
+	jsonProof, err := GetStoreProof(cliCtx, types.DataSourceStoreKey(id), height)
+	if err != nil {
+		if pErr, ok := err.(proofError); ok && pErr.status == http.StatusNotFound {
+			return JsonStoreProof{}, types.DataSource{}, newProofError(http.StatusNotFound,
+				"data source %d not found: %s", id, pErr.msg,
+			)
+		}
+		return JsonStoreProof{}, types.DataSource{}, err
+	}
+	var dataSource types.DataSource
+	if err := cliCtx.Codec.UnmarshalBinaryBare(jsonProof.StoreValueProof.Value, &dataSource); err != nil {
+		return JsonStoreProof{}, types.DataSource{}, err
+	}
+	return jsonProof, dataSource, nil
+}
+
+// GetOracleScriptProof builds the proof of the given oracle script.
+func GetOracleScriptProof(
+	cliCtx context.CLIContext, id types.OracleScriptID, height *int64,
+) (JsonStoreProof, types.OracleScript, error) {
+	jsonProof, err := GetStoreProof(cliCtx, types.OracleScriptStoreKey(id), height)
+	if err != nil {
+		if pErr, ok := err.(proofError); ok && pErr.status == http.StatusNotFound {
+			return JsonStoreProof{}, types.OracleScript{}, newProofError(http.StatusNotFound,
+				"oracle script %d not found: %s", id, pErr.msg,
+			)
+		}
+		return JsonStoreProof{}, types.OracleScript{}, err
+	}
+	var oracleScript types.OracleScript
+	if err := cliCtx.Codec.UnmarshalBinaryBare(jsonProof.StoreValueProof.Value, &oracleScript); err != nil {
+		return JsonStoreProof{}, types.OracleScript{}, err
+	}
+	return jsonProof, oracleScript, nil
+}
+
+// GetDataSourceVersionProof builds the proof of the given version of a data source. Unlike the
+// data source itself, a version is never changed once recorded, so its proof stays valid.
+func GetDataSourceVersionProof(
+	cliCtx context.CLIContext, id types.DataSourceID, version int64, height *int64,
+) (JsonStoreProof, types.DataSourceVersion, error) {
+	jsonProof, err := GetStoreProof(cliCtx, types.DataSourceVersionStoreKey(id, version), height)
+	if err != nil {
+		if pErr, ok := err.(proofError); ok && pErr.status == http.StatusNotFound {
+			return JsonStoreProof{}, types.DataSourceVersion{}, newProofError(http.StatusNotFound,
+				"version %d of data source %d not found: %s", version, id, pErr.msg,
+			)
+		}
+		return JsonStoreProof{}, types.DataSourceVersion{}, err
+	}
+	var dataSourceVersion types.DataSourceVersion
+	if err := cliCtx.Codec.UnmarshalBinaryBare(jsonProof.StoreValueProof.Value, &dataSourceVersion); err != nil {
+		return JsonStoreProof{}, types.DataSourceVersion{}, err
+	}
+	return jsonProof, dataSourceVersion, nil
+}
+
+// GetOracleScriptVersionProof builds the proof of the given version of an oracle script. See
+// GetDataSourceVersionProof.
+func GetOracleScriptVersionProof(
+	cliCtx context.CLIContext, id types.OracleScriptID, version int64, height *int64,
+) (JsonStoreProof, types.OracleScriptVersion, error) {
+	jsonProof, err := GetStoreProof(cliCtx, types.OracleScriptVersionStoreKey(id, version), height)
+	if err != nil {
+		if pErr, ok := err.(proofError); ok && pErr.status == http.StatusNotFound {
+			return JsonStoreProof{}, types.OracleScriptVersion{}, newProofError(http.StatusNotFound,
+				"version %d of oracle script %d not found: %s", version, id, pErr.msg,
+			)
+		}
+		return JsonStoreProof{}, types.OracleScriptVersion{}, err
+	}
+	var oracleScriptVersion types.OracleScriptVersion
+	if err := cliCtx.Codec.UnmarshalBinaryBare(jsonProof.StoreValueProof.Value, &oracleScriptVersion); err != nil {
+		return JsonStoreProof{}, types.OracleScriptVersion{}, err
+	}
+	return jsonProof, oracleScriptVersion, nil
+}
+
+// parseVersionOrReturnBadRequest parses the version path parameter of version proof requests.
+func parseVersionOrReturnBadRequest(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	version, err := strconv.ParseInt(mux.Vars(r)[VersionTag], 10, 64)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return 0, false
+	}
+	if version <= 0 {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("version must be positive, but got %d", version))
+		return 0, false
+	}
+	return version, true
+}
+
+// GetDataSourceProofHandlerFn returns the proof of the given data source as stored on BandChain.
+func GetDataSourceProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)[DataSourceIDTag], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		height, ok := parseHeightOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		encoding, ok := parseEncodingOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		jsonProof, dataSource, err := GetDataSourceProof(cliCtx, types.DataSourceID(id), height)
+		if err != nil {
+			writeProofError(w, err)
+			return
+		}
+		proofBytes, err := EncodeStoreProof(jsonProof, encoding)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, DataSourceProof{
+			JsonProof:  jsonProof,
+			DataSource: dataSource,
+			Encoding:   encoding,
+			ProofBytes: proofBytes,
+		})
+	}
+}
+
+// GetOracleScriptProofHandlerFn returns the proof of the given oracle script as stored on
+// BandChain.
+func GetOracleScriptProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)[OracleScriptIDTag], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		height, ok := parseHeightOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		encoding, ok := parseEncodingOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		jsonProof, oracleScript, err := GetOracleScriptProof(cliCtx, types.OracleScriptID(id), height)
+		if err != nil {
+			writeProofError(w, err)
+			return
+		}
+		proofBytes, err := EncodeStoreProof(jsonProof, encoding)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, OracleScriptProof{
+			JsonProof:    jsonProof,
+			OracleScript: oracleScript,
+			Encoding:     encoding,
+			ProofBytes:   proofBytes,
+		})
+	}
+}
+
+// GetDataSourceVersionProofHandlerFn returns the proof of the given version of a data source.
+func GetDataSourceVersionProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)[DataSourceIDTag], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		version, ok := parseVersionOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		height, ok := parseHeightOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		encoding, ok := parseEncodingOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		jsonProof, dataSourceVersion, err := GetDataSourceVersionProof(cliCtx, types.DataSourceID(id), version, height)
+		if err != nil {
+			writeProofError(w, err)
+			return
+		}
+		proofBytes, err := EncodeStoreProof(jsonProof, encoding)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, DataSourceVersionProof{
+			JsonProof:         jsonProof,
+			DataSourceVersion: dataSourceVersion,
+			Encoding:          encoding,
+			ProofBytes:        proofBytes,
+		})
+	}
+}
+
+// GetOracleScriptVersionProofHandlerFn returns the proof of the given version of an oracle script.
+func GetOracleScriptVersionProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)[OracleScriptIDTag], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		version, ok := parseVersionOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		height, ok := parseHeightOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		encoding, ok := parseEncodingOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		jsonProof, oracleScriptVersion, err := GetOracleScriptVersionProof(
+			cliCtx, types.OracleScriptID(id), version, height,
+		)
+		if err != nil {
+			writeProofError(w, err)
+			return
+		}
+		proofBytes, err := EncodeStoreProof(jsonProof, encoding)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, OracleScriptVersionProof{
+			JsonProof:           jsonProof,
+			OracleScriptVersion: oracleScriptVersion,
+			Encoding:            encoding,
+			ProofBytes:          proofBytes,
+		})
+	}
+}
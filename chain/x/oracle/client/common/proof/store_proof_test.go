@@ -0,0 +1,29 @@
+package proof
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	r := mux.SetURLVars(httptest.NewRequest("GET", "/oracle/data_source_proof/1/versions/2", nil), map[string]string{
+		VersionTag: "2",
+	})
+	version, ok := parseVersionOrReturnBadRequest(httptest.NewRecorder(), r)
+	require.True(t, ok)
+	require.Equal(t, int64(2), version)
+
+	for _, v := range []string{"", "abc", "0", "-1"} {
+		w := httptest.NewRecorder()
+		r := mux.SetURLVars(httptest.NewRequest("GET", "/oracle/data_source_proof/1/versions/x", nil), map[string]string{
+			VersionTag: v,
+		})
+		_, ok = parseVersionOrReturnBadRequest(w, r)
+		require.False(t, ok, v)
+		require.Equal(t, http.StatusBadRequest, w.Code, v)
+	}
+}
@@ -0,0 +1,259 @@
+// Package verifier checks BandChain oracle proofs in Go, performing the same checks as the
+// Solidity bridge contract without the need to deploy it.
+package verifier
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/bandprotocol/bandchain/chain/pkg/obi"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/client/common/proof"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+var (
+	ErrInvalidSignature       = errors.New("verifier: invalid signature format")
+	ErrInvalidSignerOrder     = errors.New("verifier: signatures are not sorted by signer address")
+	ErrInsufficientSignatures = errors.New("verifier: insufficient validator signatures")
+	ErrInvalidOracleDataProof = errors.New("verifier: oracle data does not match the oracle state")
+	ErrDuplicateValidator     = errors.New("verifier: duplicate validator in the validator set")
+	ErrEmptyValidatorSet      = errors.New("verifier: validator set has no voting power")
+)
+
+// Validator is a trusted BandChain validator, identified by the Ethereum address of its
+// secp256k1 public key, as in the bridge contract's validator set.
+type Validator struct {
+	Address common.Address
+	Power   uint64
+}
+
+// Verifier verifies oracle proofs against a trusted validator set.
+type Verifier struct {
+	powers     map[common.Address]uint64
+	totalPower uint64
+}
+
+// NewVerifier creates a new Verifier that trusts the given validator set.
+func NewVerifier(validators []Validator) (*Verifier, error) {
+	powers := make(map[common.Address]uint64, len(validators))
+	totalPower := uint64(0)
+	for _, val := range validators {
+		if _, ok := powers[val.Address]; ok {
+			return nil, ErrDuplicateValidator
+		}
+		powers[val.Address] = val.Power
+		totalPower += val.Power
+	}
+	if totalPower == 0 {
+		return nil, ErrEmptyValidatorSet
+	}
+	return &Verifier{powers: powers, totalPower: totalPower}, nil
+}
+
+// VerifyProof checks that the block of the given proof is signed by more than two-thirds of the
+// trusted voting power and that the oracle data is part of the state committed in the block.
+func (v *Verifier) VerifyProof(p proof.JsonProof) error {
+	if err := v.VerifyBlockRelay(p.BlockHeight, p.BlockRelayProof); err != nil {
+		return err
+	}
+	return VerifyOracleData(p.BlockRelayProof.MultiStoreProof.OracleIAVLStateHash, p.OracleDataProof)
+}
+
+// VerifyEVMProofBytes decodes the given proof bytes for the bridge's relayAndVerify function and
+// verifies them. Returns the decoded proof if it is valid.
+func (v *Verifier) VerifyEVMProofBytes(bz []byte) (proof.JsonProof, error) {
+	p, err := proof.DecodeEVMProofBytes(bz)
+	if err != nil {
+		return proof.JsonProof{}, err
+	}
+	if err := v.VerifyProof(p); err != nil {
+		return proof.JsonProof{}, err
+	}
+	return p, nil
+}
+
+// VerifyBlockRelay checks that the block at the given height, rebuilt from the relay proof, is
+// signed by more than two-thirds of the trusted voting power. Signatures must be sorted by
+// signer address. Signatures of unknown signers count for nothing.
+func (v *Verifier) VerifyBlockRelay(blockHeight uint64, relay proof.BlockRelayProof) error {
+	appHash := GetAppHash(relay.MultiStoreProof)
+	blockHash := GetBlockHash(relay.BlockHeaderMerkleParts, appHash, blockHeight)
+	lastSigner := common.Address{}
+	sumPower := uint64(0)
+	for _, sig := range relay.Signatures {
+		signer, err := RecoverSigner(sig, blockHash)
+		if err != nil {
+			return err
+		}
+		if bytes.Compare(signer.Bytes(), lastSigner.Bytes()) <= 0 {
+			return ErrInvalidSignerOrder
+		}
+		sumPower += v.powers[signer]
+		lastSigner = signer
+	}
+	if sumPower*3 <= v.totalPower*2 {
+		return ErrInsufficientSignatures
+	}
+	return nil
+}
+
+// VerifyStoreProof checks that the block of the given proof is signed by more than two-thirds of
+// the trusted voting power and that the oracle store entry is part of the state committed in the
+// block.
+func (v *Verifier) VerifyStoreProof(p proof.JsonStoreProof) error {
+	if err := v.VerifyBlockRelay(p.BlockHeight, p.BlockRelayProof); err != nil {
+		return err
+	}
+	return VerifyStoreValue(p.BlockRelayProof.MultiStoreProof.OracleIAVLStateHash, p.StoreValueProof)
+}
+
+// VerifyOracleData checks that the given oracle data is in the oracle IAVL tree with the given
+// root hash.
+func VerifyOracleData(oracleStateHash []byte, data proof.OracleDataProof) error {
+	return verifyIAVLValue(
+		oracleStateHash,
+		types.ResultStoreKey(data.ResponsePacket.RequestID),
+		obi.MustEncode(data.RequestPacket, data.ResponsePacket),
+		data.Version,
+		data.MerklePaths,
+	)
+}
+
+// VerifyStoreValue checks that the given oracle store entry is in the oracle IAVL tree with the
+// given root hash.
+func VerifyStoreValue(oracleStateHash []byte, value proof.StoreValueProof) error {
+	return verifyIAVLValue(oracleStateHash, value.Key, value.Value, value.Version, value.MerklePaths)
+}
+
+// verifyIAVLValue checks that the given key and value is in the IAVL tree with the given root
+// hash, by computing the hash of the leaf node up to the root following the given paths.
+func verifyIAVLValue(
+	rootHash []byte, key []byte, value []byte, version uint64, paths []proof.IAVLMerklePath,
+) error {
+	valueHash := sha256.Sum256(value)
+	// Leaf node with height 0 and size 1.
+	hash := sha256.Sum256(concat(
+		encodeVarint(0),
+		encodeVarint(1),
+		encodeVarint(int64(version)),
+		encodeBytes(key),
+		encodeBytes(valueHash[:]),
+	))
+	for _, path := range paths {
+		hash = GetParentHash(path, hash)
+	}
+	if !bytes.Equal(hash[:], rootHash) {
+		return ErrInvalidOracleDataProof
+	}
+	return nil
+}
+
+// GetAppHash computes the app hash from the given multistore proof. See bridge's MultiStore.sol
+// for the Merkle tree layout.
+func GetAppHash(m proof.MultiStoreProof) []byte {
+	oracleStoreHash := sha256.Sum256(m.OracleIAVLStateHash)
+	oracleStoreHash = sha256.Sum256(oracleStoreHash[:])
+	return innerHash(
+		innerHash(
+			m.AccToGovStoresMerkleHash,
+			innerHash(
+				m.MainAndMintStoresMerkleHash,
+				innerHash(
+					leafHash(concat(encodeBytes([]byte(types.StoreKey)), encodeBytes(oracleStoreHash[:]))),
+					m.ParamsStoresMerkleHash,
+				),
+			),
+		),
+		m.SlashingToUpgradeStoresMerkleHash,
+	)
+}
+
+// GetBlockHash computes Tendermint's block header hash of the given height from the given merkle
+// parts and app hash. See bridge's BlockHeaderMerkleParts.sol for the Merkle tree layout.
+func GetBlockHash(parts proof.BlockHeaderMerkleParts, appHash []byte, blockHeight uint64) []byte {
+	return innerHash(
+		innerHash(
+			innerHash(
+				parts.VersionAndChainIdHash,
+				innerHash(leafHash(encodeUvarint(blockHeight)), parts.TimeHash),
+			),
+			parts.LastBlockIDAndOther,
+		),
+		innerHash(
+			innerHash(
+				parts.NextValidatorHashAndConsensusHash,
+				innerHash(leafHash(encodeBytes(appHash)), parts.LastResultsHash),
+			),
+			parts.EvidenceAndProposerHash,
+		),
+	)
+}
+
+// RecoverSigner returns the Ethereum address of the validator that signed the vote of the given
+// block hash.
+func RecoverSigner(sig proof.TMSignature, blockHash []byte) (common.Address, error) {
+	if len(sig.R) != 32 || len(sig.S) != 32 || (sig.V != 27 && sig.V != 28) {
+		return common.Address{}, ErrInvalidSignature
+	}
+	msg := sha256.Sum256(concat(sig.SignedDataPrefix, blockHash, sig.SignedDataSuffix))
+	pub, err := crypto.SigToPub(msg[:], concat(sig.R, sig.S, []byte{sig.V - 27}))
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// GetParentHash computes the hash of the IAVL parent node of the given subtree hash.
+func GetParentHash(path proof.IAVLMerklePath, subtreeHash [32]byte) [32]byte {
+	left, right := subtreeHash[:], []byte(path.SiblingHash)
+	if path.IsDataOnRight {
+		left, right = right, left
+	}
+	return sha256.Sum256(concat(
+		encodeVarint(int64(path.SubtreeHeight)),
+		encodeVarint(int64(path.SubtreeSize)),
+		encodeVarint(int64(path.SubtreeVersion)),
+		encodeBytes(left),
+		encodeBytes(right),
+	))
+}
+
+func leafHash(value []byte) []byte {
+	hash := sha256.Sum256(concat([]byte{0}, value))
+	return hash[:]
+}
+
+func innerHash(left, right []byte) []byte {
+	hash := sha256.Sum256(concat([]byte{1}, left, right))
+	return hash[:]
+}
+
+func encodeVarint(value int64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(buf, value)
+	return buf[:n]
+}
+
+func encodeUvarint(value uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, value)
+	return buf[:n]
+}
+
+// encodeBytes returns the given bytes prefixed with its length in uvarint.
+func encodeBytes(bz []byte) []byte {
+	return concat(encodeUvarint(uint64(len(bz))), bz)
+}
+
+func concat(bzs ...[]byte) []byte {
+	res := []byte{}
+	for _, bz := range bzs {
+		res = append(res, bz...)
+	}
+	return res
+}
@@ -0,0 +1,241 @@
+package verifier
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/pkg/obi"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/client/common/proof"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func hexToBytes(hexstr string) []byte {
+	b, err := hex.DecodeString(hexstr)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// testProof returns the proof of request #1 at block #191 of the local testnet used in the bridge
+// contract tests.
+func testProof() proof.JsonProof {
+	return proof.JsonProof{
+		BlockHeight: 191,
+		OracleDataProof: proof.OracleDataProof{
+			Version: 180,
+			RequestPacket: types.OracleRequestPacketData{
+				ClientID:       "test",
+				OracleScriptID: 1,
+				Calldata:       hexToBytes("000000034254430000000000000064"),
+				AskCount:       4,
+				MinCount:       4,
+			},
+			ResponsePacket: types.OracleResponsePacketData{
+				ClientID:      "test",
+				RequestID:     1,
+				AnsCount:      4,
+				RequestTime:   1592898765,
+				ResolveTime:   1592898773,
+				ResolveStatus: 1,
+				Result:        hexToBytes("00000000000eb9e6"),
+			},
+			MerklePaths: []proof.IAVLMerklePath{
+				{
+					IsDataOnRight:  true,
+					SubtreeHeight:  1,
+					SubtreeSize:    2,
+					SubtreeVersion: 180,
+					SiblingHash:    hexToBytes("108873CE6589ADD98E40996E7EA55D6592CFEE6EBF140CFBE7F0C7EAF554B8BD"),
+				},
+				{
+					IsDataOnRight:  true,
+					SubtreeHeight:  2,
+					SubtreeSize:    3,
+					SubtreeVersion: 180,
+					SiblingHash:    hexToBytes("796AEB2094F52E848A9C9ACC57C380F92010FA19956CCF9E2C6E8E7D1E490F0C"),
+				},
+				{
+					IsDataOnRight:  true,
+					SubtreeHeight:  3,
+					SubtreeSize:    5,
+					SubtreeVersion: 180,
+					SiblingHash:    hexToBytes("423F98BE29BD72613A6815E696ECF229E2B489198E9385D3BF1F743B99CA1573"),
+				},
+				{
+					IsDataOnRight:  true,
+					SubtreeHeight:  4,
+					SubtreeSize:    8,
+					SubtreeVersion: 180,
+					SiblingHash:    hexToBytes("386E60DB569E57EAF6F2DBFA0E87AC5DAFB849EBF1DB7C6B29C0231D644AD92E"),
+				},
+				{
+					IsDataOnRight:  true,
+					SubtreeHeight:  5,
+					SubtreeSize:    21,
+					SubtreeVersion: 180,
+					SiblingHash:    hexToBytes("465252AF4FE160AD23C446CE54DFC3EECAD65B96BDA8C68871A244033424616A"),
+				},
+				{
+					IsDataOnRight:  true,
+					SubtreeHeight:  6,
+					SubtreeSize:    41,
+					SubtreeVersion: 180,
+					SiblingHash:    hexToBytes("203800627751EE71822B76CF8C24F806D2528DC82247F5A49E42AF9DF04ADC65"),
+				},
+				{
+					IsDataOnRight:  true,
+					SubtreeHeight:  7,
+					SubtreeSize:    77,
+					SubtreeVersion: 190,
+					SiblingHash:    hexToBytes("68B4E7B02288DCE3C92C590CF09C96B64D18FF48C92D57D1F14D877C6A2720F9"),
+				},
+			},
+		},
+		BlockRelayProof: proof.BlockRelayProof{
+			MultiStoreProof: proof.MultiStoreProof{
+				AccToGovStoresMerkleHash:          hexToBytes("685430546D23A44E6B8034EAAFBC2F4CD7FEF54B54D5B66528CB4E5225BD74FB"),
+				MainAndMintStoresMerkleHash:       hexToBytes("4F8D0BB0CD3EB9DC70B4DBBEA5F0CBD5B523195F7BAE02BB401BB00A93ABA08E"),
+				OracleIAVLStateHash:               hexToBytes("1912057FFF0B3E85ABF1A319F75D37B21B430F3DA7DB9E486A5041DE47C686D3"),
+				ParamsStoresMerkleHash:            hexToBytes("B1F2FD852E790E735CA2D3014F96A2A53C60393E9C6BBF941B9A6DD6A05CF6F9"),
+				SlashingToUpgradeStoresMerkleHash: hexToBytes("91CC906286235B676AD402FC04ED768EB2BFECA664E8D595C286571DA1433C60"),
+			},
+			BlockHeaderMerkleParts: proof.BlockHeaderMerkleParts{
+				VersionAndChainIdHash:             hexToBytes("32FA694879095840619F5E49380612BD296FF7E950EAFB66FF654D99CA70869E"),
+				TimeHash:                          hexToBytes("D9F175396C0E2D0E77F69856ABF5D8E69283CB915EB8886262FEDA1D519B3005"),
+				LastBlockIDAndOther:               hexToBytes("4F4D548668A3986DB253689234B9CAC96303A128B7081B16E53B79AB9E65B887"),
+				NextValidatorHashAndConsensusHash: hexToBytes("004209A161040AB1778E2F2C00EE482F205B28EFBA439FCB04EA283F619478D9"),
+				LastResultsHash:                   hexToBytes("6E340B9CFFB37A989CA544E6BB780A2C78901D3FB33738768511A30617AFA01D"),
+				EvidenceAndProposerHash:           hexToBytes("D991DA4D4E69473CC75A4B819F9E07D4956671A6F4A74DF4CC16596FCBE68137"),
+			},
+			Signatures: []proof.TMSignature{
+				{
+					R:                hexToBytes("9279257914BFEE6FAEC46DF086E9A673A4C1576FB299094D45F77E12FA3728E7"),
+					S:                hexToBytes("04DBF23F5EBB07BA8FD7CA06843DFE363B5E86596930E1889D9BD5BD3E98FC53"),
+					V:                28,
+					SignedDataPrefix: hexToBytes("6E080211BF0000000000000022480A20"),
+					SignedDataSuffix: hexToBytes("12240A2066424E8F0417945A71067A55B7121282A90524E3C0709D8F8ADDB6ADC8FD46D110012A0C08E6E9C6F70510979FBD9801320962616E64636861696E"),
+				},
+				{
+					R:                hexToBytes("826BB17B714EBCD8199EE2A01334102F19248087CFDEEE42EBD406B3991C3895"),
+					S:                hexToBytes("621281EEDF97F3A9EC121224CEF9F8C07872D2C81CCE44DD846BB3678DD50523"),
+					V:                27,
+					SignedDataPrefix: hexToBytes("6E080211BF0000000000000022480A20"),
+					SignedDataSuffix: hexToBytes("12240A2066424E8F0417945A71067A55B7121282A90524E3C0709D8F8ADDB6ADC8FD46D110012A0C08E6E9C6F70510DD89E98A01320962616E64636861696E"),
+				},
+				{
+					R:                hexToBytes("D775FD0E1580499EF16A4AB1998DCB4CBD47CF6F342CDC51A9552D1434552ED8"),
+					S:                hexToBytes("5A1A2075AE97A6BBD07A5A40EFE287ECEAB37C7A7CAAE82E6C997C51C6233470"),
+					V:                27,
+					SignedDataPrefix: hexToBytes("6E080211BF0000000000000022480A20"),
+					SignedDataSuffix: hexToBytes("12240A2066424E8F0417945A71067A55B7121282A90524E3C0709D8F8ADDB6ADC8FD46D110012A0C08E6E9C6F70510AB94A59201320962616E64636861696E"),
+				},
+			},
+		},
+	}
+}
+
+func testValidators() []Validator {
+	return []Validator{
+		{Address: common.HexToAddress("652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5"), Power: 100},
+		{Address: common.HexToAddress("88e1cd00710495EEB93D4f522d16bC8B87Cb00FE"), Power: 100},
+		{Address: common.HexToAddress("aAA22E077492CbaD414098EBD98AA8dc1C7AE8D9"), Power: 100},
+		{Address: common.HexToAddress("B956589b6fC5523eeD0d9eEcfF06262Ce84ff260"), Power: 100},
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	v, err := NewVerifier(testValidators())
+	require.NoError(t, err)
+	require.NoError(t, v.VerifyProof(testProof()))
+}
+
+func TestVerifyProofInsufficientSignatures(t *testing.T) {
+	// The 3 signers hold 300 out of 500 voting power, which is not more than two-thirds.
+	validators := append(testValidators(), Validator{
+		Address: common.HexToAddress("85109F11A7E1385ee826FbF5dA97bB97dba0D76f"), Power: 100,
+	})
+	v, err := NewVerifier(validators)
+	require.NoError(t, err)
+	require.Equal(t, ErrInsufficientSignatures, v.VerifyProof(testProof()))
+}
+
+func TestVerifyProofInvalidSignerOrder(t *testing.T) {
+	v, err := NewVerifier(testValidators())
+	require.NoError(t, err)
+	p := testProof()
+	sigs := p.BlockRelayProof.Signatures
+	sigs[0], sigs[1] = sigs[1], sigs[0]
+	require.Equal(t, ErrInvalidSignerOrder, v.VerifyProof(p))
+}
+
+func TestVerifyProofWrongBlock(t *testing.T) {
+	v, err := NewVerifier(testValidators())
+	require.NoError(t, err)
+	// Signers recovered from a different block hash are not in the validator set.
+	p := testProof()
+	p.BlockHeight = 192
+	require.Error(t, v.VerifyProof(p))
+	p = testProof()
+	p.BlockRelayProof.MultiStoreProof.OracleIAVLStateHash = hexToBytes("0000000000000000000000000000000000000000000000000000000000000000")
+	require.Error(t, v.VerifyProof(p))
+}
+
+func TestVerifyProofTamperedOracleData(t *testing.T) {
+	v, err := NewVerifier(testValidators())
+	require.NoError(t, err)
+	p := testProof()
+	p.OracleDataProof.ResponsePacket.Result = hexToBytes("00000000000eb9e7")
+	require.Equal(t, ErrInvalidOracleDataProof, v.VerifyProof(p))
+	p = testProof()
+	p.OracleDataProof.Version = 181
+	require.Equal(t, ErrInvalidOracleDataProof, v.VerifyProof(p))
+	p = testProof()
+	p.OracleDataProof.ResponsePacket.RequestID = 2
+	require.Equal(t, ErrInvalidOracleDataProof, v.VerifyProof(p))
+}
+
+func TestVerifyStoreProof(t *testing.T) {
+	v, err := NewVerifier(testValidators())
+	require.NoError(t, err)
+	// The result of a request is an oracle store entry, so its proof is also a store proof.
+	p := testProof()
+	oracleData := p.OracleDataProof
+	storeProof := proof.JsonStoreProof{
+		BlockHeight: p.BlockHeight,
+		StoreValueProof: proof.StoreValueProof{
+			Key:         types.ResultStoreKey(oracleData.ResponsePacket.RequestID),
+			Value:       obi.MustEncode(oracleData.RequestPacket, oracleData.ResponsePacket),
+			Version:     oracleData.Version,
+			MerklePaths: oracleData.MerklePaths,
+		},
+		BlockRelayProof: p.BlockRelayProof,
+	}
+	require.NoError(t, v.VerifyStoreProof(storeProof))
+	storeProof.StoreValueProof.Value = append(storeProof.StoreValueProof.Value, 0)
+	require.Equal(t, ErrInvalidOracleDataProof, v.VerifyStoreProof(storeProof))
+	storeProof.StoreValueProof.Value = obi.MustEncode(oracleData.RequestPacket, oracleData.ResponsePacket)
+	storeProof.StoreValueProof.Key = types.DataSourceStoreKey(1)
+	require.Equal(t, ErrInvalidOracleDataProof, v.VerifyStoreProof(storeProof))
+}
+
+func TestNewVerifier(t *testing.T) {
+	_, err := NewVerifier(append(testValidators(), testValidators()[0]))
+	require.Equal(t, ErrDuplicateValidator, err)
+	_, err = NewVerifier(nil)
+	require.Equal(t, ErrEmptyValidatorSet, err)
+}
+
+func TestGetAppHashAndBlockHash(t *testing.T) {
+	p := testProof()
+	appHash := GetAppHash(p.BlockRelayProof.MultiStoreProof)
+	blockHash := GetBlockHash(p.BlockRelayProof.BlockHeaderMerkleParts, appHash, p.BlockHeight)
+	signer, err := RecoverSigner(p.BlockRelayProof.Signatures[0], blockHash)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5"), signer)
+	_, err = RecoverSigner(proof.TMSignature{R: []byte{1}, S: []byte{2}, V: 27}, blockHash)
+	require.Equal(t, ErrInvalidSignature, err)
+}
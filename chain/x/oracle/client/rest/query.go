@@ -1,7 +1,9 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -60,6 +62,58 @@ func getDataByHashHandler(cliCtx context.CLIContext, route string) http.HandlerF
 	}
 }
 
+func getVersionsHandler(cliCtx context.CLIContext, route string, query string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+		bz, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", route, query, vars[idTag]))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		clientcmn.PostProcessQueryResponse(w, cliCtx.WithHeight(height), bz)
+	}
+}
+
+func getVersionCodeHandler(cliCtx context.CLIContext, route string, query string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+		bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s/%s", route, query, vars[idTag], vars[versionTag]))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		var result types.QueryResult
+		if err := json.Unmarshal(bz, &result); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if result.Status != http.StatusOK {
+			var msg string
+			if err := json.Unmarshal(result.Result, &msg); err != nil {
+				msg = string(result.Result)
+			}
+			rest.WriteErrorResponse(w, result.Status, msg)
+			return
+		}
+		var code []byte
+		if err := cliCtx.Codec.UnmarshalJSON(result.Result, &code); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Disposition", "attachment;")
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		w.Write(code)
+	}
+}
+
 func getDataSourceByIDHandler(cliCtx context.CLIContext, route string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -99,7 +153,11 @@ func getRequestByIDHandler(cliCtx context.CLIContext, route string) http.Handler
 			return
 		}
 		vars := mux.Vars(r)
-		bz, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", route, types.QueryRequests, vars[idTag]))
+		path := fmt.Sprintf("custom/%s/%s/%s", route, types.QueryRequests, vars[idTag])
+		if r.FormValue("decode") == "true" {
+			path = fmt.Sprintf("%s/%s", path, types.QueryDecodeOption)
+		}
+		bz, height, err := cliCtx.Query(path)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -114,10 +172,73 @@ func getRequestSearchHandler(cliCtx context.CLIContext, route string) http.Handl
 		if !ok {
 			return
 		}
-		bz, height, err := clientcmn.QuerySearchLatestRequest(
-			route, cliCtx,
+		bz, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s/%s/%s/%s",
+			route, types.QueryRequestSearch,
 			r.FormValue("oid"), r.FormValue("calldata"), r.FormValue("ask_count"), r.FormValue("min_count"),
-		)
+		))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		clientcmn.PostProcessQueryResponse(w, cliCtx.WithHeight(height), bz)
+	}
+}
+
+// muxVar returns a function that extracts the given route variable from an HTTP request.
+func muxVar(tag string) func(r *http.Request) string {
+	return func(r *http.Request) string {
+		return mux.Vars(r)[tag]
+	}
+}
+
+// formValue returns a function that extracts the given query parameter from an HTTP request.
+func formValue(key string) func(r *http.Request) string {
+	return func(r *http.Request) string {
+		return r.FormValue(key)
+	}
+}
+
+// getRequestIndexHandler returns the handler for a paginated request index query, where the
+// index key is extracted from the HTTP request by the given function.
+func getRequestIndexHandler(
+	cliCtx context.CLIContext, route string, query string, indexKey func(r *http.Request) string,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgs(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		bz, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d/%d/%s", route, query, page, limit, indexKey(r)))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		clientcmn.PostProcessQueryResponse(w, cliCtx.WithHeight(height), bz)
+	}
+}
+
+func postSimulateHandler(cliCtx context.CLIContext, route string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var params types.QuerySimulateParams
+		if err := cliCtx.Codec.UnmarshalJSON(body, &params); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		bz, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", route, types.QuerySimulate), cliCtx.Codec.MustMarshalJSON(params))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -157,3 +278,19 @@ func getReportersHandler(cliCtx context.CLIContext, route string) http.HandlerFu
 		clientcmn.PostProcessQueryResponse(w, cliCtx.WithHeight(height), bz)
 	}
 }
+
+func getStandingRequestByIDHandler(cliCtx context.CLIContext, route string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+		bz, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", route, types.QueryStandingRequests, vars[idTag]))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		clientcmn.PostProcessQueryResponse(w, cliCtx.WithHeight(height), bz)
+	}
+}
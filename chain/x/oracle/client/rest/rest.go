@@ -7,12 +7,15 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/bandprotocol/bandchain/chain/x/oracle/client/common/proof"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
 )
 
 const (
 	idTag               = "idTag"
 	dataHashTag         = "dataHashTag"
 	validatorAddressTag = "validatorAddressTag"
+	versionTag          = "versionTag"
+	resolveStatusTag    = "resolveStatusTag"
 )
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
@@ -20,10 +23,24 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	r.HandleFunc(fmt.Sprintf("/%s/counts", storeName), getCountsHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/data/{%s}", storeName, dataHashTag), getDataByHashHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/data_sources/{%s}", storeName, idTag), getDataSourceByIDHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/data_sources/{%s}/versions", storeName, idTag), getVersionsHandler(cliCtx, storeName, types.QueryDataSourceVersions)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/data_sources/{%s}/versions/{%s}/code", storeName, idTag, versionTag), getVersionCodeHandler(cliCtx, storeName, types.QueryDataSourceVersionCode)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/oracle_scripts/{%s}", storeName, idTag), getOracleScriptByIDHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/oracle_scripts/{%s}/versions", storeName, idTag), getVersionsHandler(cliCtx, storeName, types.QueryOracleScriptVersions)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/oracle_scripts/{%s}/versions/{%s}/code", storeName, idTag, versionTag), getVersionCodeHandler(cliCtx, storeName, types.QueryOracleScriptVersionCode)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/requests/{%s}", storeName, idTag), getRequestByIDHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/request_search", storeName), getRequestSearchHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/requests_by_oracle_script/{%s}", storeName, idTag), getRequestIndexHandler(cliCtx, storeName, types.QueryRequestsByOracleScript, muxVar(idTag))).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/requests_by_client_id", storeName), getRequestIndexHandler(cliCtx, storeName, types.QueryRequestsByClientID, formValue("client_id"))).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/requests_by_resolve_status/{%s}", storeName, resolveStatusTag), getRequestIndexHandler(cliCtx, storeName, types.QueryRequestsByResolveStatus, muxVar(resolveStatusTag))).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/simulate", storeName), postSimulateHandler(cliCtx, storeName)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/validators/{%s}", storeName, validatorAddressTag), getValidatorStatusHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/reporters/{%s}", storeName, validatorAddressTag), getReportersHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/standing_requests/{%s}", storeName, idTag), getStandingRequestByIDHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/proof/{%s}", storeName, proof.RequestIDTag), proof.GetProofHandlerFn(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/multi_proof", storeName), proof.GetMultiProofHandlerFn(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/data_source_proof/{%s}", storeName, proof.DataSourceIDTag), proof.GetDataSourceProofHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/oracle_script_proof/{%s}", storeName, proof.OracleScriptIDTag), proof.GetOracleScriptProofHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/data_source_proof/{%s}/versions/{%s}", storeName, proof.DataSourceIDTag, proof.VersionTag), proof.GetDataSourceVersionProofHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/oracle_script_proof/{%s}/versions/{%s}", storeName, proof.OracleScriptIDTag, proof.VersionTag), proof.GetOracleScriptVersionProofHandlerFn(cliCtx)).Methods("GET")
 }
@@ -2,27 +2,130 @@ package oracle
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/bandprotocol/bandchain/chain/x/oracle/keeper"
 	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
 )
 
 // GenesisState is the oracle state that must be provided at genesis.
 type GenesisState struct {
-	Params        types.Params         `json:"params" yaml:"params"`
-	DataSources   []types.DataSource   `json:"data_sources"  yaml:"data_sources"`
-	OracleScripts []types.OracleScript `json:"oracle_scripts"  yaml:"oracle_scripts"`
+	Params               types.Params                  `json:"params" yaml:"params"`
+	DataSources          []types.DataSource            `json:"data_sources"  yaml:"data_sources"`
+	OracleScripts        []types.OracleScript          `json:"oracle_scripts"  yaml:"oracle_scripts"`
+	RequestCount         int64                         `json:"request_count" yaml:"request_count"`
+	RequestLastExpired   types.RequestID               `json:"request_last_expired" yaml:"request_last_expired"`
+	RequestLastPruned    types.RequestID               `json:"request_last_pruned" yaml:"request_last_pruned"`
+	RollingSeed          []byte                        `json:"rolling_seed" yaml:"rolling_seed"`
+	PendingResolveList   []types.RequestID             `json:"pending_resolve_list" yaml:"pending_resolve_list"`
+	Requests             []GenesisRequest              `json:"requests" yaml:"requests"`
+	Reports              []GenesisReport               `json:"reports" yaml:"reports"`
+	ReportCommits        []GenesisReportCommit         `json:"report_commits" yaml:"report_commits"`
+	Results              []GenesisResult               `json:"results" yaml:"results"`
+	Reporters            []GenesisReporter             `json:"reporters" yaml:"reporters"`
+	ValidatorStatuses    []GenesisValidatorStatus      `json:"validator_statuses" yaml:"validator_statuses"`
+	ReportInfos          []GenesisReportInfo           `json:"report_infos" yaml:"report_infos"`
+	ValidatorReportStats []GenesisValidatorReportStat  `json:"validator_report_stats" yaml:"validator_report_stats"`
+	StandingRequestCount int64                         `json:"standing_request_count" yaml:"standing_request_count"`
+	StandingRequests     []GenesisStandingRequest      `json:"standing_requests" yaml:"standing_requests"`
+	DataSourceVersions   []GenesisDataSourceVersions   `json:"data_source_versions" yaml:"data_source_versions"`
+	OracleScriptVersions []GenesisOracleScriptVersions `json:"oracle_script_versions" yaml:"oracle_script_versions"`
+}
+
+// GenesisRequest is a request together with its ID, as stored in the genesis state.
+type GenesisRequest struct {
+	RequestID types.RequestID `json:"request_id" yaml:"request_id"`
+	Request   types.Request   `json:"request" yaml:"request"`
+}
+
+// GenesisReport is a validator's report to a request, as stored in the genesis state.
+type GenesisReport struct {
+	RequestID types.RequestID `json:"request_id" yaml:"request_id"`
+	Report    types.Report    `json:"report" yaml:"report"`
+}
+
+// GenesisReportCommit is a validator's commit to a report that is not yet revealed, as stored
+// in the genesis state.
+type GenesisReportCommit struct {
+	RequestID    types.RequestID    `json:"request_id" yaml:"request_id"`
+	ReportCommit types.ReportCommit `json:"report_commit" yaml:"report_commit"`
+}
+
+// GenesisResult is the result of a request, as stored in the genesis state.
+type GenesisResult struct {
+	RequestID types.RequestID `json:"request_id" yaml:"request_id"`
+	Result    types.Result    `json:"result" yaml:"result"`
+}
+
+// GenesisReporter is a reporter authorized to report on behalf of a validator.
+type GenesisReporter struct {
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+	Reporter  sdk.AccAddress `json:"reporter" yaml:"reporter"`
+}
+
+// GenesisReportInfo is the report window of a validator, as stored in the genesis state.
+// MissedReports lists the indexes of the requests missed within the window.
+type GenesisReportInfo struct {
+	Validator     sdk.ValAddress   `json:"validator" yaml:"validator"`
+	ReportInfo    types.ReportInfo `json:"report_info" yaml:"report_info"`
+	MissedReports []int64          `json:"missed_reports" yaml:"missed_reports"`
+}
+
+// GenesisValidatorReportStat is the reports delivered by a validator since the last oracle reward
+// allocation, as stored in the genesis state.
+type GenesisValidatorReportStat struct {
+	Validator  sdk.ValAddress            `json:"validator" yaml:"validator"`
+	ReportStat types.ValidatorReportStat `json:"report_stat" yaml:"report_stat"`
+}
+
+// GenesisValidatorStatus is the oracle status of a validator, as stored in the genesis state.
+type GenesisValidatorStatus struct {
+	Validator sdk.ValAddress        `json:"validator" yaml:"validator"`
+	Status    types.ValidatorStatus `json:"status" yaml:"status"`
+}
+
+// GenesisStandingRequest is a standing request together with its ID, as stored in the genesis
+// state. The remaining budget is held by the escrow account in the auth genesis state.
+type GenesisStandingRequest struct {
+	StandingRequestID types.StandingRequestID `json:"standing_request_id" yaml:"standing_request_id"`
+	StandingRequest   types.StandingRequest   `json:"standing_request" yaml:"standing_request"`
+}
+
+// GenesisDataSourceVersions is the version history of a data source, as stored in the genesis state.
+type GenesisDataSourceVersions struct {
+	DataSourceID types.DataSourceID        `json:"data_source_id" yaml:"data_source_id"`
+	Versions     []types.DataSourceVersion `json:"versions" yaml:"versions"`
+}
+
+// GenesisOracleScriptVersions is the version history of an oracle script, as stored in the genesis state.
+type GenesisOracleScriptVersions struct {
+	OracleScriptID types.OracleScriptID        `json:"oracle_script_id" yaml:"oracle_script_id"`
+	Versions       []types.OracleScriptVersion `json:"versions" yaml:"versions"`
 }
 
 // DefaultGenesisState returns the default oracle genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:        types.DefaultParams(),
-		DataSources:   []types.DataSource{},
-		OracleScripts: []types.OracleScript{},
+		Params:               types.DefaultParams(),
+		DataSources:          []types.DataSource{},
+		OracleScripts:        []types.OracleScript{},
+		RollingSeed:          make([]byte, types.RollingSeedSizeInBytes),
+		PendingResolveList:   []types.RequestID{},
+		Requests:             []GenesisRequest{},
+		Reports:              []GenesisReport{},
+		ReportCommits:        []GenesisReportCommit{},
+		Results:              []GenesisResult{},
+		Reporters:            []GenesisReporter{},
+		ValidatorStatuses:    []GenesisValidatorStatus{},
+		ReportInfos:          []GenesisReportInfo{},
+		ValidatorReportStats: []GenesisValidatorReportStat{},
+		StandingRequests:     []GenesisStandingRequest{},
+		DataSourceVersions:   []GenesisDataSourceVersions{},
+		OracleScriptVersions: []GenesisOracleScriptVersions{},
 	}
 }
 
@@ -36,27 +139,385 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorU
 	k.SetParam(ctx, types.KeySamplingTryCount, data.Params.SamplingTryCount)
 	k.SetParam(ctx, types.KeyOracleRewardPercentage, data.Params.OracleRewardPercentage)
 	k.SetParam(ctx, types.KeyInactivePenaltyDuration, data.Params.InactivePenaltyDuration)
+	k.SetParam(ctx, types.KeyRequestRetentionBlockCount, data.Params.RequestRetentionBlockCount)
+	k.SetParam(ctx, types.KeyMaxPruneCountPerBlock, data.Params.MaxPruneCountPerBlock)
+	k.SetParamBool(ctx, types.KeyPruneResult, data.Params.PruneResult)
+	k.SetParam(ctx, types.KeyCommitBlockCount, data.Params.CommitBlockCount)
+	k.SetParam(ctx, types.KeyReportWindow, data.Params.ReportWindow)
+	k.SetParam(ctx, types.KeyMinReportPercentage, data.Params.MinReportPercentage)
+	k.SetParam(ctx, types.KeyMissedReportSlashPercentage, data.Params.MissedReportSlashPercentage)
+	k.SetParam(ctx, types.KeyRewardWeightingMode, data.Params.RewardWeightingMode)
+	k.SetParam(ctx, types.KeyInBeforeResolveBonusPercentage, data.Params.InBeforeResolveBonusPercentage)
+	k.SetParam(ctx, types.KeyStandingRequestFee, data.Params.StandingRequestFee)
+	k.SetParam(ctx, types.KeyMinStandingRequestInterval, data.Params.MinStandingRequestInterval)
+	k.SetParam(ctx, types.KeyMaxStandingRequestsPerBlock, data.Params.MaxStandingRequestsPerBlock)
+	k.SetParam(ctx, types.KeyMaxStandingRequestFailures, data.Params.MaxStandingRequestFailures)
+	k.SetParam(ctx, types.KeyMissedReportJailDuration, data.Params.MissedReportJailDuration)
+	k.SetParam(ctx, types.KeyRewardPeriod, data.Params.RewardPeriod)
 	k.SetDataSourceCount(ctx, 0)
 	k.SetOracleScriptCount(ctx, 0)
-	k.SetRequestCount(ctx, 0)
-	k.SetRequestLastExpired(ctx, 0)
-	k.SetRollingSeed(ctx, make([]byte, types.RollingSeedSizeInBytes))
+	k.SetRequestCount(ctx, data.RequestCount)
+	k.SetRequestLastExpired(ctx, data.RequestLastExpired)
+	k.SetRequestLastPruned(ctx, data.RequestLastPruned)
+	rollingSeed := data.RollingSeed
+	if len(rollingSeed) == 0 { // Genesis files without rolling seed start with all zeroes.
+		rollingSeed = make([]byte, types.RollingSeedSizeInBytes)
+	}
+	k.SetRollingSeed(ctx, rollingSeed)
+	k.SetPendingResolveList(ctx, data.PendingResolveList)
 	for _, dataSource := range data.DataSources {
 		_ = k.AddDataSource(ctx, dataSource)
 	}
 	for _, oracleScript := range data.OracleScripts {
 		_ = k.AddOracleScript(ctx, oracleScript)
 	}
+	for _, history := range data.DataSourceVersions {
+		for idx, version := range history.Versions {
+			k.SetDataSourceVersion(ctx, history.DataSourceID, int64(idx+1), version)
+		}
+	}
+	for _, history := range data.OracleScriptVersions {
+		for idx, version := range history.Versions {
+			k.SetOracleScriptVersion(ctx, history.OracleScriptID, int64(idx+1), version)
+		}
+	}
+	// Data sources and oracle scripts without version history start with their current state
+	// as the first version, edited by their owners.
+	for idx, dataSource := range data.DataSources {
+		id := types.DataSourceID(idx + 1)
+		if k.GetDataSourceVersionCount(ctx, id) == 0 {
+			k.AddDataSourceVersion(ctx, id, dataSource.Owner)
+		}
+	}
+	for idx, oracleScript := range data.OracleScripts {
+		id := types.OracleScriptID(idx + 1)
+		if k.GetOracleScriptVersionCount(ctx, id) == 0 {
+			k.AddOracleScriptVersion(ctx, id, oracleScript.Owner)
+		}
+	}
+	for _, req := range data.Requests {
+		k.SetRequest(ctx, req.RequestID, req.Request)
+	}
+	for _, rep := range data.Reports {
+		k.SetReport(ctx, rep.RequestID, rep.Report)
+	}
+	for _, commit := range data.ReportCommits {
+		k.SetReportCommit(ctx, commit.RequestID, commit.ReportCommit)
+	}
+	for _, res := range data.Results {
+		k.SetResult(ctx, res.RequestID, res.Result)
+	}
+	// Request indexes are derived from requests and results, so they are rebuilt rather than exported.
+	for _, req := range data.Requests {
+		k.IndexRequest(ctx, req.RequestID, req.Request)
+	}
+	for _, reporter := range data.Reporters {
+		err := k.AddReporter(ctx, reporter.Validator, reporter.Reporter)
+		if err != nil {
+			panic(err)
+		}
+	}
+	for _, status := range data.ValidatorStatuses {
+		k.SetValidatorStatus(ctx, status.Validator, status.Status)
+	}
+	k.SetReportWindow(ctx, data.Params.ReportWindow)
+	for _, info := range data.ReportInfos {
+		k.SetReportInfo(ctx, info.Validator, info.ReportInfo)
+		for _, index := range info.MissedReports {
+			k.SetMissedReportBitArray(ctx, info.Validator, index, true)
+		}
+	}
+	for _, stat := range data.ValidatorReportStats {
+		k.SetValidatorReportStat(ctx, stat.Validator, stat.ReportStat)
+	}
+	k.SetStandingRequestCount(ctx, data.StandingRequestCount)
+	for _, sr := range data.StandingRequests {
+		k.SetStandingRequest(ctx, sr.StandingRequestID, sr.StandingRequest)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
-	return GenesisState{
-		Params:        k.GetParams(ctx),
-		DataSources:   k.GetAllDataSources(ctx),
-		OracleScripts: k.GetAllOracleScripts(ctx),
+	data := DefaultGenesisState()
+	data.Params = k.GetParams(ctx)
+	data.DataSources = k.GetAllDataSources(ctx)
+	data.OracleScripts = k.GetAllOracleScripts(ctx)
+	data.RequestCount = k.GetRequestCount(ctx)
+	data.RequestLastExpired = k.GetRequestLastExpired(ctx)
+	data.RequestLastPruned = k.GetRequestLastPruned(ctx)
+	data.RollingSeed = k.GetRollingSeed(ctx)
+	data.PendingResolveList = k.GetPendingResolveList(ctx)
+	k.IterateRequests(ctx, func(id types.RequestID, req types.Request) bool {
+		data.Requests = append(data.Requests, GenesisRequest{RequestID: id, Request: req})
+		return false
+	})
+	k.IterateReports(ctx, func(rid types.RequestID, rep types.Report) bool {
+		data.Reports = append(data.Reports, GenesisReport{RequestID: rid, Report: rep})
+		return false
+	})
+	k.IterateReportCommits(ctx, func(rid types.RequestID, commit types.ReportCommit) bool {
+		data.ReportCommits = append(data.ReportCommits, GenesisReportCommit{RequestID: rid, ReportCommit: commit})
+		return false
+	})
+	k.IterateResults(ctx, func(id types.RequestID, result types.Result) bool {
+		data.Results = append(data.Results, GenesisResult{RequestID: id, Result: result})
+		return false
+	})
+	k.IterateReporters(ctx, func(val sdk.ValAddress, addr sdk.AccAddress) bool {
+		data.Reporters = append(data.Reporters, GenesisReporter{Validator: val, Reporter: addr})
+		return false
+	})
+	k.IterateValidatorStatuses(ctx, func(val sdk.ValAddress, status types.ValidatorStatus) bool {
+		data.ValidatorStatuses = append(data.ValidatorStatuses, GenesisValidatorStatus{Validator: val, Status: status})
+		return false
+	})
+	// Report infos tracked with a report window other than the current one are reset on the next
+	// report, so they are not exported.
+	if k.GetReportWindow(ctx) == data.Params.ReportWindow {
+		k.IterateReportInfos(ctx, func(val sdk.ValAddress, info types.ReportInfo) bool {
+			missed := []int64{}
+			k.IterateMissedReports(ctx, val, func(index int64) bool {
+				missed = append(missed, index)
+				return false
+			})
+			data.ReportInfos = append(data.ReportInfos, GenesisReportInfo{
+				Validator: val, ReportInfo: info, MissedReports: missed,
+			})
+			return false
+		})
+	}
+	k.IterateValidatorReportStats(ctx, func(val sdk.ValAddress, stat types.ValidatorReportStat) bool {
+		data.ValidatorReportStats = append(data.ValidatorReportStats, GenesisValidatorReportStat{
+			Validator: val, ReportStat: stat,
+		})
+		return false
+	})
+	for idx := range data.DataSources {
+		id := types.DataSourceID(idx + 1)
+		data.DataSourceVersions = append(data.DataSourceVersions, GenesisDataSourceVersions{
+			DataSourceID: id, Versions: k.GetDataSourceVersions(ctx, id),
+		})
+	}
+	for idx := range data.OracleScripts {
+		id := types.OracleScriptID(idx + 1)
+		data.OracleScriptVersions = append(data.OracleScriptVersions, GenesisOracleScriptVersions{
+			OracleScriptID: id, Versions: k.GetOracleScriptVersions(ctx, id),
+		})
+	}
+	data.StandingRequestCount = k.GetStandingRequestCount(ctx)
+	k.IterateStandingRequests(ctx, func(id types.StandingRequestID, sr types.StandingRequest) bool {
+		data.StandingRequests = append(data.StandingRequests, GenesisStandingRequest{StandingRequestID: id, StandingRequest: sr})
+		return false
+	})
+	return data
+}
+
+// ValidateGenesis checks that the given genesis state is well-formed and self-consistent.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if len(data.RollingSeed) != 0 && len(data.RollingSeed) != types.RollingSeedSizeInBytes {
+		return fmt.Errorf("invalid rolling seed size: %d", len(data.RollingSeed))
+	}
+	if !(0 <= data.RequestLastPruned &&
+		data.RequestLastPruned <= data.RequestLastExpired &&
+		int64(data.RequestLastExpired) <= data.RequestCount) {
+		return fmt.Errorf("inconsistent request counters: pruned: %d, expired: %d, count: %d",
+			data.RequestLastPruned, data.RequestLastExpired, data.RequestCount)
+	}
+	requests := make(map[types.RequestID]types.Request)
+	for _, req := range data.Requests {
+		if req.RequestID <= data.RequestLastPruned || int64(req.RequestID) > data.RequestCount {
+			return fmt.Errorf("request id out of range: %d", req.RequestID)
+		}
+		if _, found := requests[req.RequestID]; found {
+			return fmt.Errorf("duplicate request: %d", req.RequestID)
+		}
+		if int(req.Request.OracleScriptID) <= 0 || int(req.Request.OracleScriptID) > len(data.OracleScripts) {
+			return fmt.Errorf("request %d: oracle script not found: %d", req.RequestID, req.Request.OracleScriptID)
+		}
+		for _, raw := range req.Request.RawRequests {
+			if int(raw.DataSourceID) <= 0 || int(raw.DataSourceID) > len(data.DataSources) {
+				return fmt.Errorf("request %d: data source not found: %d", req.RequestID, raw.DataSourceID)
+			}
+		}
+		requests[req.RequestID] = req.Request
+	}
+	// Requests are only ever removed by pruning, so all non-pruned requests must exist.
+	if int64(len(requests)) != data.RequestCount-int64(data.RequestLastPruned) {
+		return fmt.Errorf("missing requests: got %d, expect %d",
+			len(requests), data.RequestCount-int64(data.RequestLastPruned))
+	}
+	reported := make(map[string]bool)
+	for _, rep := range data.Reports {
+		req, found := requests[rep.RequestID]
+		if !found {
+			return fmt.Errorf("report to unknown request: %d", rep.RequestID)
+		}
+		key := string(types.ReportsOfValidatorPrefixKey(rep.RequestID, rep.Report.Validator))
+		if reported[key] {
+			return fmt.Errorf("duplicate report: %d, %s", rep.RequestID, rep.Report.Validator)
+		}
+		reported[key] = true
+		if !keeper.ContainsVal(req.RequestedValidators, rep.Report.Validator) {
+			return fmt.Errorf("report from unrequested validator: %d, %s", rep.RequestID, rep.Report.Validator)
+		}
+	}
+	committed := make(map[string]bool)
+	for _, commit := range data.ReportCommits {
+		// Commits are removed once their requests expire.
+		if commit.RequestID <= data.RequestLastExpired {
+			return fmt.Errorf("report commit to expired request: %d", commit.RequestID)
+		}
+		req, found := requests[commit.RequestID]
+		if !found {
+			return fmt.Errorf("report commit to unknown request: %d", commit.RequestID)
+		}
+		key := string(types.ReportCommitOfValidatorKey(commit.RequestID, commit.ReportCommit.Validator))
+		if committed[key] {
+			return fmt.Errorf("duplicate report commit: %d, %s", commit.RequestID, commit.ReportCommit.Validator)
+		}
+		committed[key] = true
+		if !keeper.ContainsVal(req.RequestedValidators, commit.ReportCommit.Validator) {
+			return fmt.Errorf("report commit from unrequested validator: %d, %s",
+				commit.RequestID, commit.ReportCommit.Validator)
+		}
+	}
+	resolved := make(map[types.RequestID]bool)
+	for _, res := range data.Results {
+		if res.RequestID <= 0 || int64(res.RequestID) > data.RequestCount {
+			return fmt.Errorf("result id out of range: %d", res.RequestID)
+		}
+		if resolved[res.RequestID] {
+			return fmt.Errorf("duplicate result: %d", res.RequestID)
+		}
+		resolved[res.RequestID] = true
+	}
+	// Every expired request that is not yet pruned must have been resolved.
+	for id := data.RequestLastPruned + 1; id <= data.RequestLastExpired; id++ {
+		if !resolved[id] {
+			return fmt.Errorf("expired request without result: %d", id)
+		}
+	}
+	for _, id := range data.PendingResolveList {
+		if _, found := requests[id]; !found {
+			return fmt.Errorf("pending resolve request not found: %d", id)
+		}
+		if resolved[id] {
+			return fmt.Errorf("pending resolve request already resolved: %d", id)
+		}
+	}
+	reporters := make(map[string]bool)
+	for _, reporter := range data.Reporters {
+		if err := sdk.VerifyAddressFormat(reporter.Validator); err != nil {
+			return fmt.Errorf("invalid reporter validator: %w", err)
+		}
+		if err := sdk.VerifyAddressFormat(reporter.Reporter); err != nil {
+			return fmt.Errorf("invalid reporter address: %w", err)
+		}
+		if sdk.ValAddress(reporter.Reporter).Equals(reporter.Validator) {
+			return fmt.Errorf("validator cannot be its own reporter: %s", reporter.Validator)
+		}
+		key := string(types.ReporterStoreKey(reporter.Validator, reporter.Reporter))
+		if reporters[key] {
+			return fmt.Errorf("duplicate reporter: %s, %s", reporter.Validator, reporter.Reporter)
+		}
+		reporters[key] = true
+	}
+	statuses := make(map[string]bool)
+	for _, status := range data.ValidatorStatuses {
+		if err := sdk.VerifyAddressFormat(status.Validator); err != nil {
+			return fmt.Errorf("invalid validator status address: %w", err)
+		}
+		if statuses[status.Validator.String()] {
+			return fmt.Errorf("duplicate validator status: %s", status.Validator)
+		}
+		statuses[status.Validator.String()] = true
+	}
+	reportInfos := make(map[string]bool)
+	for _, info := range data.ReportInfos {
+		if err := sdk.VerifyAddressFormat(info.Validator); err != nil {
+			return fmt.Errorf("invalid report info address: %w", err)
+		}
+		if reportInfos[info.Validator.String()] {
+			return fmt.Errorf("duplicate report info: %s", info.Validator)
+		}
+		reportInfos[info.Validator.String()] = true
+		if int64(len(info.MissedReports)) != info.ReportInfo.MissedReportsCounter {
+			return fmt.Errorf("inconsistent missed reports: %s, got %d, expect %d",
+				info.Validator, len(info.MissedReports), info.ReportInfo.MissedReportsCounter)
+		}
+		missedIndexes := make(map[int64]bool)
+		for _, index := range info.MissedReports {
+			if index < 0 || uint64(index) >= data.Params.ReportWindow {
+				return fmt.Errorf("missed report index out of window: %s, %d", info.Validator, index)
+			}
+			if missedIndexes[index] {
+				return fmt.Errorf("duplicate missed report index: %s, %d", info.Validator, index)
+			}
+			missedIndexes[index] = true
+		}
+	}
+	reportStats := make(map[string]bool)
+	for _, stat := range data.ValidatorReportStats {
+		if err := sdk.VerifyAddressFormat(stat.Validator); err != nil {
+			return fmt.Errorf("invalid report stat address: %w", err)
+		}
+		if reportStats[stat.Validator.String()] {
+			return fmt.Errorf("duplicate report stat: %s", stat.Validator)
+		}
+		reportStats[stat.Validator.String()] = true
+		if stat.ReportStat.InBeforeResolveCount > stat.ReportStat.ReportCount {
+			return fmt.Errorf("inconsistent report stat: %s, in before resolve count %d exceeds report count %d",
+				stat.Validator, stat.ReportStat.InBeforeResolveCount, stat.ReportStat.ReportCount)
+		}
+	}
+	dataSourceHistories := make(map[types.DataSourceID]bool)
+	for _, history := range data.DataSourceVersions {
+		if int(history.DataSourceID) <= 0 || int(history.DataSourceID) > len(data.DataSources) {
+			return fmt.Errorf("versions of unknown data source: %d", history.DataSourceID)
+		}
+		if dataSourceHistories[history.DataSourceID] {
+			return fmt.Errorf("duplicate data source versions: %d", history.DataSourceID)
+		}
+		dataSourceHistories[history.DataSourceID] = true
+	}
+	oracleScriptHistories := make(map[types.OracleScriptID]bool)
+	for _, history := range data.OracleScriptVersions {
+		if int(history.OracleScriptID) <= 0 || int(history.OracleScriptID) > len(data.OracleScripts) {
+			return fmt.Errorf("versions of unknown oracle script: %d", history.OracleScriptID)
+		}
+		if oracleScriptHistories[history.OracleScriptID] {
+			return fmt.Errorf("duplicate oracle script versions: %d", history.OracleScriptID)
+		}
+		oracleScriptHistories[history.OracleScriptID] = true
+	}
+	standingRequests := make(map[types.StandingRequestID]bool)
+	for _, sr := range data.StandingRequests {
+		if sr.StandingRequestID <= 0 || int64(sr.StandingRequestID) > data.StandingRequestCount {
+			return fmt.Errorf("standing request id out of range: %d", sr.StandingRequestID)
+		}
+		if standingRequests[sr.StandingRequestID] {
+			return fmt.Errorf("duplicate standing request: %d", sr.StandingRequestID)
+		}
+		standingRequests[sr.StandingRequestID] = true
+		if err := sdk.VerifyAddressFormat(sr.StandingRequest.Owner); err != nil {
+			return fmt.Errorf("standing request %d: invalid owner: %w", sr.StandingRequestID, err)
+		}
+		if int(sr.StandingRequest.OracleScriptID) <= 0 || int(sr.StandingRequest.OracleScriptID) > len(data.OracleScripts) {
+			return fmt.Errorf("standing request %d: oracle script not found: %d",
+				sr.StandingRequestID, sr.StandingRequest.OracleScriptID)
+		}
+		if sr.StandingRequest.Interval == 0 {
+			return fmt.Errorf("standing request %d: zero interval", sr.StandingRequestID)
+		}
+		if _, err := sdk.ParseCoins(sr.StandingRequest.FeeLimit); err != nil {
+			return fmt.Errorf("standing request %d: invalid fee limit: %w", sr.StandingRequestID, err)
+		}
 	}
+	return nil
 }
 
 // GetGenesisStateFromAppState returns x/oracle GenesisState given raw application genesis state.
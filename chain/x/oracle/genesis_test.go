@@ -0,0 +1,141 @@
+package oracle_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/testapp"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestDefaultGenesisValid(t *testing.T) {
+	require.NoError(t, oracle.ValidateGenesis(oracle.DefaultGenesisState()))
+}
+
+func TestExportGenesis(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// Add a request with one report and a result, plus one pending request.
+	req := types.NewRequest(
+		1, []byte("beeb"), []sdk.ValAddress{testapp.Validator1.ValAddress}, 1, 1,
+		testapp.ParseTime(1000), "CID", []types.RawRequest{types.NewRawRequest(1, 1, []byte("beeb"), 0)},
+		0,
+	)
+	rep := types.NewReport(testapp.Validator1.ValAddress, true, []types.RawReport{
+		types.NewRawReport(1, 0, []byte("data")),
+	})
+	k.AddRequest(ctx, req)
+	k.AddRequest(ctx, req)
+	k.SetReport(ctx, 1, rep)
+	k.ResolveSuccess(ctx, 1, []byte("result"))
+	k.SetRequestLastExpired(ctx, 1)
+	k.AddPendingRequest(ctx, 2)
+	commit := types.NewReportCommit(testapp.Validator1.ValAddress, make([]byte, 32))
+	k.SetReportCommit(ctx, 2, commit)
+	k.SetRollingSeed(ctx, []byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, k.AddReporter(ctx, testapp.Validator1.ValAddress, testapp.Alice.Address))
+	k.HandleValidatorReport(ctx, testapp.Validator2.ValAddress, false)
+	k.HandleValidatorReport(ctx, testapp.Validator2.ValAddress, true)
+	k.RecordReport(ctx, rep)
+	sr := types.NewStandingRequest(testapp.Alice.Address, 1, []byte("beeb"), 1, 1, "CID", 10, "", 42, 0)
+	_, err := k.AddStandingRequest(ctx, sr, sdk.NewCoins())
+	require.NoError(t, err)
+	// Every piece of state should be exported.
+	data := oracle.ExportGenesis(ctx, k)
+	require.NoError(t, oracle.ValidateGenesis(data))
+	require.Equal(t, int64(2), data.RequestCount)
+	require.Equal(t, types.RequestID(1), data.RequestLastExpired)
+	require.Equal(t, []byte("0123456789abcdef0123456789abcdef"), data.RollingSeed)
+	require.Equal(t, []types.RequestID{2}, data.PendingResolveList)
+	require.Equal(t, []oracle.GenesisRequest{{RequestID: 1, Request: req}, {RequestID: 2, Request: req}}, data.Requests)
+	require.Equal(t, []oracle.GenesisReport{{RequestID: 1, Report: rep}}, data.Reports)
+	require.Equal(t, []oracle.GenesisReportCommit{{RequestID: 2, ReportCommit: commit}}, data.ReportCommits)
+	require.Len(t, data.Results, 1)
+	require.Equal(t, k.MustGetResult(ctx, 1), data.Results[0].Result)
+	require.Equal(t, []oracle.GenesisReporter{
+		{Validator: testapp.Validator1.ValAddress, Reporter: testapp.Alice.Address},
+	}, data.Reporters)
+	require.Len(t, data.ValidatorStatuses, 3)
+	require.Equal(t, []oracle.GenesisReportInfo{{
+		Validator: testapp.Validator2.ValAddress, ReportInfo: types.NewReportInfo(2, 1), MissedReports: []int64{1},
+	}}, data.ReportInfos)
+	require.Equal(t, []oracle.GenesisValidatorReportStat{{
+		Validator: testapp.Validator1.ValAddress, ReportStat: types.NewValidatorReportStat(1, 1),
+	}}, data.ValidatorReportStats)
+	require.Equal(t, int64(1), data.StandingRequestCount)
+	require.Equal(t, []oracle.GenesisStandingRequest{{StandingRequestID: 1, StandingRequest: sr}}, data.StandingRequests)
+	require.Len(t, data.DataSourceVersions, len(data.DataSources))
+	require.Equal(t, k.GetDataSourceVersions(ctx, 1), data.DataSourceVersions[0].Versions)
+	require.Len(t, data.OracleScriptVersions, len(data.OracleScripts))
+	require.Equal(t, k.GetOracleScriptVersions(ctx, 1), data.OracleScriptVersions[0].Versions)
+}
+
+func TestValidateGenesisInconsistent(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	req := types.NewRequest(
+		1, []byte("beeb"), []sdk.ValAddress{testapp.Validator1.ValAddress}, 1, 1,
+		testapp.ParseTime(1000), "CID", []types.RawRequest{types.NewRawRequest(1, 1, []byte("beeb"), 0)},
+		0,
+	)
+	k.AddRequest(ctx, req)
+	good := oracle.ExportGenesis(ctx, k)
+	require.NoError(t, oracle.ValidateGenesis(good))
+	// Request count must cover all requests.
+	data := oracle.ExportGenesis(ctx, k)
+	data.RequestCount = 2
+	require.Error(t, oracle.ValidateGenesis(data))
+	// Expired requests must have results.
+	data = oracle.ExportGenesis(ctx, k)
+	data.RequestLastExpired = 1
+	require.Error(t, oracle.ValidateGenesis(data))
+	// Reports must refer to existing requests from requested validators.
+	data = oracle.ExportGenesis(ctx, k)
+	data.Reports = []oracle.GenesisReport{{RequestID: 1, Report: types.NewReport(testapp.Validator2.ValAddress, true, nil)}}
+	require.Error(t, oracle.ValidateGenesis(data))
+	// Pending resolve list must refer to existing requests.
+	data = oracle.ExportGenesis(ctx, k)
+	data.PendingResolveList = []types.RequestID{42}
+	require.Error(t, oracle.ValidateGenesis(data))
+	// Report commits must refer to existing requests from requested validators.
+	data = oracle.ExportGenesis(ctx, k)
+	data.ReportCommits = []oracle.GenesisReportCommit{{
+		RequestID: 1, ReportCommit: types.NewReportCommit(testapp.Validator2.ValAddress, nil),
+	}}
+	require.Error(t, oracle.ValidateGenesis(data))
+	// Missed reports must be consistent with the report info.
+	data = oracle.ExportGenesis(ctx, k)
+	data.ReportInfos = []oracle.GenesisReportInfo{{
+		Validator: testapp.Validator1.ValAddress, ReportInfo: types.NewReportInfo(1, 1), MissedReports: []int64{},
+	}}
+	require.Error(t, oracle.ValidateGenesis(data))
+	// Reports in before resolve cannot outnumber all reports.
+	data = oracle.ExportGenesis(ctx, k)
+	data.ValidatorReportStats = []oracle.GenesisValidatorReportStat{{
+		Validator: testapp.Validator1.ValAddress, ReportStat: types.NewValidatorReportStat(1, 2),
+	}}
+	require.Error(t, oracle.ValidateGenesis(data))
+	// The commit window must close before requests expire.
+	data = oracle.ExportGenesis(ctx, k)
+	data.Params.CommitBlockCount = data.Params.ExpirationBlockCount
+	require.Error(t, oracle.ValidateGenesis(data))
+	// Rolling seed must have the correct size.
+	data = oracle.ExportGenesis(ctx, k)
+	data.RollingSeed = []byte("short")
+	require.Error(t, oracle.ValidateGenesis(data))
+	// Standing requests must be covered by the standing request count.
+	data = oracle.ExportGenesis(ctx, k)
+	data.StandingRequests = []oracle.GenesisStandingRequest{{
+		StandingRequestID: 1,
+		StandingRequest:   types.NewStandingRequest(testapp.Alice.Address, 1, nil, 1, 1, "", 10, "", 1, 0),
+	}}
+	require.Error(t, oracle.ValidateGenesis(data))
+	// Version histories must refer to existing data sources and oracle scripts.
+	data = oracle.ExportGenesis(ctx, k)
+	data.DataSourceVersions = append(data.DataSourceVersions, oracle.GenesisDataSourceVersions{DataSourceID: 42})
+	require.Error(t, oracle.ValidateGenesis(data))
+	data = oracle.ExportGenesis(ctx, k)
+	data.OracleScriptVersions = append(data.OracleScriptVersions, data.OracleScriptVersions[0])
+	require.Error(t, oracle.ValidateGenesis(data))
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	"github.com/bandprotocol/bandchain/chain/pkg/gzip"
+	"github.com/bandprotocol/bandchain/chain/pkg/obi"
 	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewHandler creates the msg handler of this module, as required by Cosmos-SDK standard.
@@ -26,18 +28,41 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgRequestData(ctx, k, msg)
 		case MsgReportData:
 			return handleMsgReportData(ctx, k, msg)
+		case MsgCommitReport:
+			return handleMsgCommitReport(ctx, k, msg)
+		case MsgRevealReport:
+			return handleMsgRevealReport(ctx, k, msg)
 		case MsgActivate:
 			return handleMsgActivate(ctx, k, msg)
 		case MsgAddReporter:
 			return handleMsgAddReporter(ctx, k, msg)
 		case MsgRemoveReporter:
 			return handleMsgRemoveReporter(ctx, k, msg)
+		case MsgCreateStandingRequest:
+			return handleMsgCreateStandingRequest(ctx, k, msg)
+		case MsgCancelStandingRequest:
+			return handleMsgCancelStandingRequest(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
 	}
 }
 
+// NewParamChangeProposalHandler wraps the given param change proposal handler to reject proposals
+// that leave the oracle params inconsistent with each other, which the params module cannot check
+// as it validates each param on its own. Gov discards the changes of a failed proposal.
+func NewParamChangeProposalHandler(k Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+		if err := k.GetParams(ctx).Validate(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+		}
+		return nil
+	}
+}
+
 func handleMsgCreateDataSource(ctx sdk.Context, k Keeper, m MsgCreateDataSource) (*sdk.Result, error) {
 	if gzip.IsGzipped(m.Executable) {
 		var err error
@@ -47,8 +72,9 @@ func handleMsgCreateDataSource(ctx sdk.Context, k Keeper, m MsgCreateDataSource)
 		}
 	}
 	id := k.AddDataSource(ctx, types.NewDataSource(
-		m.Owner, m.Name, m.Description, k.AddExecutableFile(m.Executable),
+		m.Owner, m.Name, m.Description, k.AddExecutableFile(m.Executable), m.Fee,
 	))
+	k.AddDataSourceVersion(ctx, id, m.Sender)
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeCreateDataSource,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
@@ -72,8 +98,12 @@ func handleMsgEditDataSource(ctx sdk.Context, k Keeper, m MsgEditDataSource) (*s
 	}
 	// Can safely use MustEdit here, as we already checked that the data source exists above.
 	k.MustEditDataSource(ctx, m.DataSourceID, types.NewDataSource(
-		m.Owner, m.Name, m.Description, k.AddExecutableFile(m.Executable),
+		m.Owner, m.Name, m.Description, k.AddExecutableFile(m.Executable), m.Fee,
 	))
+	// Only a change of the executable makes a new version.
+	if k.MustGetDataSource(ctx, m.DataSourceID).Filename != dataSource.Filename {
+		k.AddDataSourceVersion(ctx, m.DataSourceID, m.Sender)
+	}
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeEditDataSource,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", m.DataSourceID)),
@@ -81,7 +111,31 @@ func handleMsgEditDataSource(ctx sdk.Context, k Keeper, m MsgEditDataSource) (*s
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+// checkSchema returns an error if the given oracle script schema is not a valid OBI schema with
+// well-formed input and output types. An empty schema is allowed for scripts that declare none.
+// Types whose smallest encoding exceeds MaxDataSize, such as huge fixed arrays, are rejected as no
+// calldata or result of a reasonable size can ever match them.
+func checkSchema(schema string) error {
+	if schema == "" {
+		return nil
+	}
+	parsed, err := obi.ParseSchema(schema)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidSchema, err.Error())
+	}
+	if size := obi.MinSize(parsed.Input); size > types.MaxDataSize {
+		return sdkerrors.Wrapf(types.ErrInvalidSchema, "input takes at least %d bytes, max %d", size, types.MaxDataSize)
+	}
+	if size := obi.MinSize(parsed.Output); size > types.MaxDataSize {
+		return sdkerrors.Wrapf(types.ErrInvalidSchema, "output takes at least %d bytes, max %d", size, types.MaxDataSize)
+	}
+	return nil
+}
+
 func handleMsgCreateOracleScript(ctx sdk.Context, k Keeper, m MsgCreateOracleScript) (*sdk.Result, error) {
+	if err := checkSchema(m.Schema); err != nil {
+		return nil, err
+	}
 	if gzip.IsGzipped(m.Code) {
 		var err error
 		m.Code, err = gzip.Uncompress(m.Code, types.MaxWasmCodeSize)
@@ -96,6 +150,7 @@ func handleMsgCreateOracleScript(ctx sdk.Context, k Keeper, m MsgCreateOracleScr
 	id := k.AddOracleScript(ctx, types.NewOracleScript(
 		m.Owner, m.Name, m.Description, filename, m.Schema, m.SourceCodeURL,
 	))
+	k.AddOracleScriptVersion(ctx, id, m.Sender)
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeCreateOracleScript,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
@@ -111,6 +166,11 @@ func handleMsgEditOracleScript(ctx sdk.Context, k Keeper, m MsgEditOracleScript)
 	if !oracleScript.Owner.Equals(m.Sender) {
 		return nil, types.ErrEditorNotAuthorized
 	}
+	if m.Schema != types.DoNotModify {
+		if err := checkSchema(m.Schema); err != nil {
+			return nil, err
+		}
+	}
 	if gzip.IsGzipped(m.Code) {
 		m.Code, err = gzip.Uncompress(m.Code, types.MaxWasmCodeSize)
 		if err != nil {
@@ -124,6 +184,11 @@ func handleMsgEditOracleScript(ctx sdk.Context, k Keeper, m MsgEditOracleScript)
 	k.MustEditOracleScript(ctx, m.OracleScriptID, types.NewOracleScript(
 		m.Owner, m.Name, m.Description, filename, m.Schema, m.SourceCodeURL,
 	))
+	// Only a change of the code or the schema makes a new version.
+	if edited := k.MustGetOracleScript(ctx, m.OracleScriptID); edited.Filename != oracleScript.Filename ||
+		edited.Schema != oracleScript.Schema {
+		k.AddOracleScriptVersion(ctx, m.OracleScriptID, m.Sender)
+	}
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeEditOracleScript,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", m.OracleScriptID)),
@@ -132,7 +197,11 @@ func handleMsgEditOracleScript(ctx sdk.Context, k Keeper, m MsgEditOracleScript)
 }
 
 func handleMsgRequestData(ctx sdk.Context, k Keeper, m MsgRequestData) (*sdk.Result, error) {
-	err := k.PrepareRequest(ctx, &m)
+	feeLimit, err := sdk.ParseCoins(m.FeeLimit)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	err = k.PrepareRequest(ctx, &m, m.Sender, feeLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +212,9 @@ func handleMsgReportData(ctx sdk.Context, k Keeper, m MsgReportData) (*sdk.Resul
 	if !k.IsReporter(ctx, m.Validator, m.Reporter) {
 		return nil, types.ErrReporterNotAuthorized
 	}
+	if k.GetParam(ctx, types.KeyCommitBlockCount) > 0 {
+		return nil, types.ErrCommitRevealRequired
+	}
 	if m.RequestID <= k.GetRequestLastExpired(ctx) {
 		return nil, types.ErrRequestAlreadyExpired
 	}
@@ -150,16 +222,55 @@ func handleMsgReportData(ctx sdk.Context, k Keeper, m MsgReportData) (*sdk.Resul
 	if err != nil {
 		return nil, err
 	}
-	req := k.MustGetRequest(ctx, m.RequestID)
-	if k.GetReportCount(ctx, m.RequestID) == req.MinCount {
+	return handleReportAdded(ctx, k, m.RequestID, m.Validator)
+}
+
+func handleMsgCommitReport(ctx sdk.Context, k Keeper, m MsgCommitReport) (*sdk.Result, error) {
+	if !k.IsReporter(ctx, m.Validator, m.Reporter) {
+		return nil, types.ErrReporterNotAuthorized
+	}
+	if m.RequestID <= k.GetRequestLastExpired(ctx) {
+		return nil, types.ErrRequestAlreadyExpired
+	}
+	err := k.AddReportCommit(ctx, m.RequestID, types.NewReportCommit(m.Validator, m.CommitHash))
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCommitReport,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", m.RequestID)),
+		sdk.NewAttribute(types.AttributeKeyValidator, m.Validator.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevealReport(ctx sdk.Context, k Keeper, m MsgRevealReport) (*sdk.Result, error) {
+	if !k.IsReporter(ctx, m.Validator, m.Reporter) {
+		return nil, types.ErrReporterNotAuthorized
+	}
+	if m.RequestID <= k.GetRequestLastExpired(ctx) {
+		return nil, types.ErrRequestAlreadyExpired
+	}
+	err := k.RevealReport(ctx, m.RequestID, types.NewReport(m.Validator, !k.HasResult(ctx, m.RequestID), m.RawReports), m.Salt)
+	if err != nil {
+		return nil, err
+	}
+	return handleReportAdded(ctx, k, m.RequestID, m.Validator)
+}
+
+// handleReportAdded adds the request to the pending resolve list if the report just added is
+// the one that makes the number of reports sufficient, and emits the report event.
+func handleReportAdded(ctx sdk.Context, k Keeper, rid types.RequestID, val sdk.ValAddress) (*sdk.Result, error) {
+	req := k.MustGetRequest(ctx, rid)
+	if k.GetReportCount(ctx, rid) == req.MinCount {
 		// At the exact moment when the number of reports is sufficient, we add the request to
 		// the pending resolve list. This can happen at most one time for any request.
-		k.AddPendingRequest(ctx, m.RequestID)
+		k.AddPendingRequest(ctx, rid)
 	}
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeReport,
-		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", m.RequestID)),
-		sdk.NewAttribute(types.AttributeKeyValidator, m.Validator.String()),
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", rid)),
+		sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
 	))
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
@@ -201,3 +312,45 @@ func handleMsgRemoveReporter(ctx sdk.Context, k Keeper, m MsgRemoveReporter) (*s
 	))
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgCreateStandingRequest(ctx sdk.Context, k Keeper, m MsgCreateStandingRequest) (*sdk.Result, error) {
+	budget, err := sdk.ParseCoins(m.Budget)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if minInterval := k.GetParam(ctx, types.KeyMinStandingRequestInterval); m.Interval < minInterval {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInterval, "got: %d, min: %d", m.Interval, minInterval)
+	}
+	// The first request is sent at the beginning of the next block.
+	id, err := k.AddStandingRequest(ctx, types.NewStandingRequest(
+		m.Sender, m.OracleScriptID, m.Calldata, m.AskCount, m.MinCount, m.ClientID,
+		m.Interval, m.FeeLimit, ctx.BlockHeight()+1, 0,
+	), budget)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateStandingRequest,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelStandingRequest(ctx sdk.Context, k Keeper, m MsgCancelStandingRequest) (*sdk.Result, error) {
+	standingRequest, err := k.GetStandingRequest(ctx, m.StandingRequestID)
+	if err != nil {
+		return nil, err
+	}
+	if !standingRequest.Owner.Equals(m.Sender) {
+		return nil, types.ErrNotStandingRequestOwner
+	}
+	err = k.CloseStandingRequest(ctx, m.StandingRequestID, "cancelled by owner")
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelStandingRequest,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", m.StandingRequestID)),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
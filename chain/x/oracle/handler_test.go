@@ -10,6 +10,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/stretchr/testify/require"
 
 	"github.com/bandprotocol/bandchain/chain/x/oracle"
@@ -26,12 +27,12 @@ func TestCreateDataSourceSuccess(t *testing.T) {
 	executable := []byte("executable")
 	executableHash := sha256.Sum256(executable)
 	filename := hex.EncodeToString(executableHash[:])
-	msg := types.NewMsgCreateDataSource(owner, name, description, executable, testapp.Alice.Address)
+	msg := types.NewMsgCreateDataSource(owner, name, description, executable, testapp.Alice.Address, "")
 	res, err := oracle.NewHandler(k)(ctx, msg)
 	require.NoError(t, err)
 	ds, err := k.GetDataSource(ctx, types.DataSourceID(dsCount+1))
 	require.NoError(t, err)
-	require.Equal(t, types.NewDataSource(testapp.Owner.Address, name, description, filename), ds)
+	require.Equal(t, types.NewDataSource(testapp.Owner.Address, name, description, filename, ""), ds)
 	require.Equal(t, sdk.Events{sdk.NewEvent(
 		types.EventTypeCreateDataSource,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", dsCount+1)),
@@ -51,12 +52,12 @@ func TestCreateGzippedExecutableDataSourceSuccess(t *testing.T) {
 	zw := gz.NewWriter(&buf)
 	zw.Write(executable)
 	zw.Close()
-	msg := types.NewMsgCreateDataSource(owner, name, description, buf.Bytes(), testapp.Alice.Address)
+	msg := types.NewMsgCreateDataSource(owner, name, description, buf.Bytes(), testapp.Alice.Address, "")
 	res, err := oracle.NewHandler(k)(ctx, msg)
 	require.NoError(t, err)
 	ds, err := k.GetDataSource(ctx, types.DataSourceID(dsCount+1))
 	require.NoError(t, err)
-	require.Equal(t, types.NewDataSource(testapp.Owner.Address, name, description, filename), ds)
+	require.Equal(t, types.NewDataSource(testapp.Owner.Address, name, description, filename, ""), ds)
 	require.Equal(t, sdk.Events{sdk.NewEvent(
 		types.EventTypeCreateDataSource,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", dsCount+1)),
@@ -74,7 +75,7 @@ func TestCreateGzippedExecutableDataSourceFail(t *testing.T) {
 	zw.Write(executable)
 	zw.Close()
 	sender := testapp.Alice.Address
-	msg := types.NewMsgCreateDataSource(owner, name, description, buf.Bytes()[:5], sender)
+	msg := types.NewMsgCreateDataSource(owner, name, description, buf.Bytes()[:5], sender, "")
 	res, err := oracle.NewHandler(k)(ctx, msg)
 	require.EqualError(t, err, "uncompression failed: unexpected EOF")
 	require.Nil(t, res)
@@ -87,30 +88,54 @@ func TestEditDataSourceSuccess(t *testing.T) {
 	newExecutable := []byte("executable2")
 	newExecutableHash := sha256.Sum256(newExecutable)
 	newFilename := hex.EncodeToString(newExecutableHash[:])
-	msg := types.NewMsgEditDataSource(1, testapp.Owner.Address, newName, newDescription, newExecutable, testapp.Owner.Address)
+	msg := types.NewMsgEditDataSource(1, testapp.Owner.Address, newName, newDescription, newExecutable, testapp.Owner.Address, "")
 	res, err := oracle.NewHandler(k)(ctx, msg)
 	require.NoError(t, err)
 	ds, err := k.GetDataSource(ctx, 1)
 	require.NoError(t, err)
-	require.Equal(t, types.NewDataSource(testapp.Owner.Address, newName, newDescription, newFilename), ds)
+	require.Equal(t, types.NewDataSource(testapp.Owner.Address, newName, newDescription, newFilename, ""), ds)
+	// The edit is recorded as the second version, after the genesis one.
+	require.Equal(t, int64(2), k.GetDataSourceVersionCount(ctx, 1))
+	version, err := k.GetDataSourceVersion(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.NewDataSourceVersion(newFilename, testapp.Owner.Address, ctx.BlockHeight()), version)
 	require.Equal(t, sdk.Events{sdk.NewEvent(
 		types.EventTypeEditDataSource,
 		sdk.NewAttribute(types.AttributeKeyID, "1"),
 	)}, res.Events)
 }
 
+func TestEditDataSourceNoNewVersion(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(false)
+	// Editing only the name does not make a new version.
+	msg := types.NewMsgEditDataSource(1, testapp.Owner.Address, "beeb", types.DoNotModify, types.DoNotModifyBytes, testapp.Owner.Address, types.DoNotModify)
+	_, err := oracle.NewHandler(k)(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, "beeb", k.MustGetDataSource(ctx, 1).Name)
+	require.Equal(t, int64(1), k.GetDataSourceVersionCount(ctx, 1))
+	// Neither does submitting the same executable again.
+	newExecutable := []byte("executable2")
+	msg = types.NewMsgEditDataSource(1, testapp.Owner.Address, types.DoNotModify, types.DoNotModify, newExecutable, testapp.Owner.Address, types.DoNotModify)
+	_, err = oracle.NewHandler(k)(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), k.GetDataSourceVersionCount(ctx, 1))
+	_, err = oracle.NewHandler(k)(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), k.GetDataSourceVersionCount(ctx, 1))
+}
+
 func TestEditDataSourceFail(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(false)
 	newName := "beeb"
 	newDescription := "new_description"
 	newExecutable := []byte("executable2")
 	// Bad ID
-	msg := types.NewMsgEditDataSource(42, testapp.Owner.Address, newName, newDescription, newExecutable, testapp.Owner.Address)
+	msg := types.NewMsgEditDataSource(42, testapp.Owner.Address, newName, newDescription, newExecutable, testapp.Owner.Address, "")
 	res, err := oracle.NewHandler(k)(ctx, msg)
 	require.EqualError(t, err, "data source not found: id: 42")
 	require.Nil(t, res)
 	// Not owner
-	msg = types.NewMsgEditDataSource(1, testapp.Owner.Address, newName, newDescription, newExecutable, testapp.Bob.Address)
+	msg = types.NewMsgEditDataSource(1, testapp.Owner.Address, newName, newDescription, newExecutable, testapp.Bob.Address, "")
 	res, err = oracle.NewHandler(k)(ctx, msg)
 	require.EqualError(t, err, "editor not authorized")
 	require.Nil(t, res)
@@ -119,7 +144,7 @@ func TestEditDataSourceFail(t *testing.T) {
 	zw := gz.NewWriter(&buf)
 	zw.Write(newExecutable)
 	zw.Close()
-	msg = types.NewMsgEditDataSource(1, testapp.Owner.Address, newName, newDescription, buf.Bytes()[:5], testapp.Owner.Address)
+	msg = types.NewMsgEditDataSource(1, testapp.Owner.Address, newName, newDescription, buf.Bytes()[:5], testapp.Owner.Address, "")
 	res, err = oracle.NewHandler(k)(ctx, msg)
 	require.EqualError(t, err, "uncompression failed: unexpected EOF")
 	require.Nil(t, res)
@@ -131,7 +156,7 @@ func TestCreateOracleScriptSuccess(t *testing.T) {
 	name := "os_1"
 	description := "beeb"
 	code := testapp.WasmExtra1
-	schema := "schema"
+	schema := "{symbol:string}/{px:u64}"
 	url := "url"
 	msg := types.NewMsgCreateOracleScript(testapp.Owner.Address, name, description, code, schema, url, testapp.Alice.Address)
 	res, err := oracle.NewHandler(k)(ctx, msg)
@@ -150,7 +175,7 @@ func TestCreateGzippedOracleScriptSuccess(t *testing.T) {
 	osCount := k.GetOracleScriptCount(ctx)
 	name := "os_1"
 	description := "beeb"
-	schema := "schema"
+	schema := "{symbol:string}/{px:u64}"
 	url := "url"
 	var buf bytes.Buffer
 	zw := gz.NewWriter(&buf)
@@ -172,7 +197,7 @@ func TestCreateOracleScriptFail(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(false)
 	name := "os_1"
 	description := "beeb"
-	schema := "schema"
+	schema := "{symbol:string}/{px:u64}"
 	url := "url"
 	// Bad Owasm code
 	msg := types.NewMsgCreateOracleScript(testapp.Owner.Address, name, description, []byte("BAD"), schema, url, testapp.Alice.Address)
@@ -188,6 +213,23 @@ func TestCreateOracleScriptFail(t *testing.T) {
 	res, err = oracle.NewHandler(k)(ctx, msg)
 	require.EqualError(t, err, "uncompression failed: unexpected EOF")
 	require.Nil(t, res)
+	// Bad schema
+	msg = types.NewMsgCreateOracleScript(testapp.Owner.Address, name, description, testapp.WasmExtra1, "{symbol:string}", url, testapp.Alice.Address)
+	res, err = oracle.NewHandler(k)(ctx, msg)
+	require.EqualError(t, err, "invalid schema: obi: expect exactly one forward slash in schema")
+	require.Nil(t, res)
+	msg = types.NewMsgCreateOracleScript(testapp.Owner.Address, name, description, testapp.WasmExtra1, "{symbol:string}/{px:float}", url, testapp.Alice.Address)
+	res, err = oracle.NewHandler(k)(ctx, msg)
+	require.EqualError(t, err, "invalid schema: obi: invalid schema at position 9: unknown type \"float\"")
+	require.Nil(t, res)
+	msg = types.NewMsgCreateOracleScript(testapp.Owner.Address, name, description, testapp.WasmExtra1, "{x:[[u8;0]]}/{y:u8}", url, testapp.Alice.Address)
+	res, err = oracle.NewHandler(k)(ctx, msg)
+	require.EqualError(t, err, "invalid schema: obi: invalid schema at position 9: invalid array length, expect 1 to 65536")
+	require.Nil(t, res)
+	msg = types.NewMsgCreateOracleScript(testapp.Owner.Address, name, description, testapp.WasmExtra1, "{x:u8}/{y:[[u64;65536];65536]}", url, testapp.Alice.Address)
+	res, err = oracle.NewHandler(k)(ctx, msg)
+	require.EqualError(t, err, "invalid schema: output takes at least 2147483647 bytes, max 1024")
+	require.Nil(t, res)
 }
 
 func TestEditOracleScriptSuccess(t *testing.T) {
@@ -195,7 +237,7 @@ func TestEditOracleScriptSuccess(t *testing.T) {
 	newName := "os_2"
 	newDescription := "beebbeeb"
 	newCode := testapp.WasmExtra2
-	newSchema := "new_schema"
+	newSchema := "{symbol:string,multiplier:u64}/{px:u64}"
 	newURL := "new_url"
 	msg := types.NewMsgEditOracleScript(1, testapp.Owner.Address, newName, newDescription, newCode, newSchema, newURL, testapp.Owner.Address)
 	res, err := oracle.NewHandler(k)(ctx, msg)
@@ -203,18 +245,39 @@ func TestEditOracleScriptSuccess(t *testing.T) {
 	os, err := k.GetOracleScript(ctx, 1)
 	require.NoError(t, err)
 	require.Equal(t, types.NewOracleScript(testapp.Owner.Address, newName, newDescription, testapp.WasmExtra2FileName, newSchema, newURL), os)
+	// The edit is recorded as the second version, after the genesis one.
+	require.Equal(t, int64(2), k.GetOracleScriptVersionCount(ctx, 1))
+	version, err := k.GetOracleScriptVersion(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.NewOracleScriptVersion(testapp.WasmExtra2FileName, newSchema, testapp.Owner.Address, ctx.BlockHeight()), version)
 	require.Equal(t, sdk.Events{sdk.NewEvent(
 		types.EventTypeEditOracleScript,
 		sdk.NewAttribute(types.AttributeKeyID, "1"),
 	)}, res.Events)
 }
 
+func TestEditOracleScriptNoNewVersion(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(false)
+	// Editing neither the code nor the schema does not make a new version.
+	msg := types.NewMsgEditOracleScript(1, testapp.Owner.Address, "os_2", types.DoNotModify, types.DoNotModifyBytes, types.DoNotModify, "new_url", testapp.Owner.Address)
+	_, err := oracle.NewHandler(k)(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, "os_2", k.MustGetOracleScript(ctx, 1).Name)
+	require.Equal(t, int64(1), k.GetOracleScriptVersionCount(ctx, 1))
+	// A new schema makes a new version.
+	newSchema := "{symbol:string,multiplier:u64}/{px:u64}"
+	msg = types.NewMsgEditOracleScript(1, testapp.Owner.Address, types.DoNotModify, types.DoNotModify, types.DoNotModifyBytes, newSchema, types.DoNotModify, testapp.Owner.Address)
+	_, err = oracle.NewHandler(k)(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), k.GetOracleScriptVersionCount(ctx, 1))
+}
+
 func TestEditOracleScriptFail(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(false)
 	newName := "os_2"
 	newDescription := "beebbeeb"
 	newCode := testapp.WasmExtra2
-	newSchema := "new_schema"
+	newSchema := "{symbol:string,multiplier:u64}/{px:u64}"
 	newURL := "new_url"
 	// Bad ID
 	msg := types.NewMsgEditOracleScript(999, testapp.Owner.Address, newName, newDescription, newCode, newSchema, newURL, testapp.Owner.Address)
@@ -240,12 +303,17 @@ func TestEditOracleScriptFail(t *testing.T) {
 	res, err = oracle.NewHandler(k)(ctx, msg)
 	require.EqualError(t, err, "uncompression failed: unexpected EOF")
 	require.Nil(t, res)
+	// Bad schema
+	msg = types.NewMsgEditOracleScript(1, testapp.Owner.Address, newName, newDescription, newCode, "new_schema", newURL, testapp.Owner.Address)
+	res, err = oracle.NewHandler(k)(ctx, msg)
+	require.EqualError(t, err, "invalid schema: obi: expect exactly one forward slash in schema")
+	require.Nil(t, res)
 }
 
 func TestRequestDataSuccess(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
 	ctx = ctx.WithBlockHeight(124).WithBlockTime(testapp.ParseTime(1581589790))
-	msg := types.NewMsgRequestData(1, []byte("beeb"), 2, 2, "CID", testapp.Alice.Address)
+	msg := types.NewMsgRequestData(1, []byte("beeb"), 2, 2, "CID", testapp.Alice.Address, "")
 	res, err := oracle.NewHandler(k)(ctx, msg)
 	require.NoError(t, err)
 	require.Equal(t, types.NewRequest(
@@ -257,10 +325,11 @@ func TestRequestDataSuccess(t *testing.T) {
 		testapp.ParseTime(1581589790),
 		"CID",
 		[]types.RawRequest{
-			types.NewRawRequest(1, 1, []byte("beeb")),
-			types.NewRawRequest(2, 2, []byte("beeb")),
-			types.NewRawRequest(3, 3, []byte("beeb")),
+			types.NewRawRequest(1, 1, []byte("beeb"), 1),
+			types.NewRawRequest(2, 2, []byte("beeb"), 1),
+			types.NewRawRequest(3, 3, []byte("beeb"), 1),
 		},
+		1,
 	), k.MustGetRequest(ctx, 1))
 	require.Equal(t, sdk.Events{sdk.NewEvent(
 		types.EventTypeRequest,
@@ -296,17 +365,17 @@ func TestRequestDataSuccess(t *testing.T) {
 func TestRequestDataFail(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(false)
 	// No active oracle validators
-	res, err := oracle.NewHandler(k)(ctx, types.NewMsgRequestData(1, []byte("beeb"), 2, 2, "CID", testapp.Alice.Address))
+	res, err := oracle.NewHandler(k)(ctx, types.NewMsgRequestData(1, []byte("beeb"), 2, 2, "CID", testapp.Alice.Address, ""))
 	require.EqualError(t, err, "insufficent available validators: 0 < 2")
 	require.Nil(t, res)
 	k.Activate(ctx, testapp.Validator1.ValAddress)
 	k.Activate(ctx, testapp.Validator2.ValAddress)
 	// Too high ask count
-	res, err = oracle.NewHandler(k)(ctx, types.NewMsgRequestData(1, []byte("beeb"), 3, 2, "CID", testapp.Alice.Address))
+	res, err = oracle.NewHandler(k)(ctx, types.NewMsgRequestData(1, []byte("beeb"), 3, 2, "CID", testapp.Alice.Address, ""))
 	require.EqualError(t, err, "insufficent available validators: 2 < 3")
 	require.Nil(t, res)
 	// Bad oracle script ID
-	res, err = oracle.NewHandler(k)(ctx, types.NewMsgRequestData(999, []byte("beeb"), 2, 2, "CID", testapp.Alice.Address))
+	res, err = oracle.NewHandler(k)(ctx, types.NewMsgRequestData(999, []byte("beeb"), 2, 2, "CID", testapp.Alice.Address, ""))
 	require.EqualError(t, err, "oracle script not found: id: 999")
 	require.Nil(t, res)
 }
@@ -323,9 +392,10 @@ func TestReportSuccess(t *testing.T) {
 		testapp.ParseTime(1581589790),
 		"CID",
 		[]types.RawRequest{
-			types.NewRawRequest(1, 1, []byte("beeb")),
-			types.NewRawRequest(2, 2, []byte("beeb")),
+			types.NewRawRequest(1, 1, []byte("beeb"), 0),
+			types.NewRawRequest(2, 2, []byte("beeb"), 0),
 		},
+		0,
 	))
 	// Common raw reports for everyone.
 	reports := []types.RawReport{types.NewRawReport(1, 0, []byte("data1")), types.NewRawReport(2, 0, []byte("data2"))}
@@ -375,9 +445,10 @@ func TestReportFail(t *testing.T) {
 		testapp.ParseTime(1581589790),
 		"CID",
 		[]types.RawRequest{
-			types.NewRawRequest(1, 1, []byte("beeb")),
-			types.NewRawRequest(2, 2, []byte("beeb")),
+			types.NewRawRequest(1, 1, []byte("beeb"), 0),
+			types.NewRawRequest(2, 2, []byte("beeb"), 0),
 		},
+		0,
 	))
 	// Common raw reports for everyone.
 	reports := []types.RawReport{types.NewRawReport(1, 0, []byte("data1")), types.NewRawReport(2, 0, []byte("data2"))}
@@ -408,6 +479,69 @@ func TestReportFail(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestCommitRevealReport(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyCommitBlockCount, 5)
+	// Set up a mock request at height 124 asking 3 validators with min count 2.
+	k.SetRequest(ctx, 42, types.NewRequest(
+		1,
+		[]byte("beeb"),
+		[]sdk.ValAddress{testapp.Validator3.ValAddress, testapp.Validator2.ValAddress, testapp.Validator1.ValAddress},
+		2,
+		124,
+		testapp.ParseTime(1581589790),
+		"CID",
+		[]types.RawRequest{
+			types.NewRawRequest(1, 1, []byte("beeb"), 0),
+			types.NewRawRequest(2, 2, []byte("beeb"), 0),
+		},
+		0,
+	))
+	reports := []types.RawReport{types.NewRawReport(1, 0, []byte("data1")), types.NewRawReport(2, 0, []byte("data2"))}
+	salt := []byte("salt")
+	ctx = ctx.WithBlockHeight(125)
+	// Plain reports are not accepted under commit-reveal.
+	_, err := oracle.NewHandler(k)(ctx, types.NewMsgReportData(42, reports, testapp.Validator1.ValAddress, testapp.Validator1.Address))
+	require.EqualError(t, err, "commit-reveal required")
+	// Validator1 and Validator2 commit to their reports.
+	for _, val := range []testapp.Account{testapp.Validator1, testapp.Validator2} {
+		hash := types.ReportCommitHash(42, val.ValAddress, reports, salt)
+		res, err := oracle.NewHandler(k)(ctx, types.NewMsgCommitReport(42, hash, val.ValAddress, val.Address))
+		require.NoError(t, err)
+		require.Equal(t, sdk.Events{sdk.NewEvent(
+			types.EventTypeCommitReport,
+			sdk.NewAttribute(types.AttributeKeyID, "42"),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.ValAddress.String()),
+		)}, res.Events)
+	}
+	// Revealing before the commit window closes fails.
+	_, err = oracle.NewHandler(k)(ctx, types.NewMsgRevealReport(42, reports, salt, testapp.Validator1.ValAddress, testapp.Validator1.Address))
+	require.EqualError(t, err, "commit window still open: reqID: 42")
+	// Committing after the commit window closes fails.
+	ctx = ctx.WithBlockHeight(129)
+	hash := types.ReportCommitHash(42, testapp.Validator3.ValAddress, reports, salt)
+	_, err = oracle.NewHandler(k)(ctx, types.NewMsgCommitReport(42, hash, testapp.Validator3.ValAddress, testapp.Validator3.Address))
+	require.EqualError(t, err, "commit window closed: reqID: 42")
+	// Revealing data that does not match the commit fails.
+	_, err = oracle.NewHandler(k)(ctx, types.NewMsgRevealReport(42, reports, []byte("pepper"), testapp.Validator1.ValAddress, testapp.Validator1.Address))
+	require.EqualError(t, err, fmt.Sprintf("report does not match commit: reqID: 42, val: %s", testapp.Validator1.ValAddress.String()))
+	// Both validators reveal. The request moves to pending resolve after the second reveal.
+	res, err := oracle.NewHandler(k)(ctx, types.NewMsgRevealReport(42, reports, salt, testapp.Validator1.ValAddress, testapp.Validator1.Address))
+	require.NoError(t, err)
+	require.Equal(t, []types.RequestID{}, k.GetPendingResolveList(ctx))
+	require.Equal(t, sdk.Events{sdk.NewEvent(
+		types.EventTypeReport,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyValidator, testapp.Validator1.ValAddress.String()),
+	)}, res.Events)
+	_, err = oracle.NewHandler(k)(ctx, types.NewMsgRevealReport(42, reports, salt, testapp.Validator2.ValAddress, testapp.Validator2.Address))
+	require.NoError(t, err)
+	require.Equal(t, []types.RequestID{42}, k.GetPendingResolveList(ctx))
+	// Validator3 never committed, so it cannot reveal.
+	_, err = oracle.NewHandler(k)(ctx, types.NewMsgRevealReport(42, reports, salt, testapp.Validator3.ValAddress, testapp.Validator3.Address))
+	require.EqualError(t, err, fmt.Sprintf("report commit not found: reqID: 42, val: %s", testapp.Validator3.ValAddress.String()))
+}
+
 func TestActivateSuccess(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(false)
 	ctx = ctx.WithBlockTime(testapp.ParseTime(1000000))
@@ -498,3 +632,94 @@ func TestRemoveReporterFail(t *testing.T) {
 	require.EqualError(t, err, fmt.Sprintf("reporter not found: val: %s, addr: %s", testapp.Alice.ValAddress.String(), testapp.Bob.Address.String()))
 	require.Nil(t, res)
 }
+
+func TestCreateStandingRequestSuccess(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	ctx = ctx.WithBlockHeight(42)
+	msg := types.NewMsgCreateStandingRequest(1, []byte("beeb"), 2, 1, "CID", 10, "100uband", "", testapp.Alice.Address)
+	res, err := oracle.NewHandler(k)(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, types.NewStandingRequest(
+		testapp.Alice.Address, 1, []byte("beeb"), 2, 1, "CID", 10, "", 43, 0,
+	), k.MustGetStandingRequest(ctx, 1))
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+		app.BankKeeper.GetCoins(ctx, types.StandingRequestEscrowAddress(1)),
+	)
+	// The budget transfer emits its own events before the standing request event.
+	require.Equal(t, sdk.NewEvent(
+		types.EventTypeCreateStandingRequest,
+		sdk.NewAttribute(types.AttributeKeyID, "1"),
+	), res.Events[len(res.Events)-1])
+}
+
+func TestCreateStandingRequestFail(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// Should fail because the oracle script does not exist.
+	msg := types.NewMsgCreateStandingRequest(42, []byte("beeb"), 2, 1, "CID", 10, "100uband", "", testapp.Alice.Address)
+	res, err := oracle.NewHandler(k)(ctx, msg)
+	require.EqualError(t, err, "oracle script not found: id: 42")
+	require.Nil(t, res)
+	// Should fail because Alice does not have enough coins for the budget.
+	msg = types.NewMsgCreateStandingRequest(1, []byte("beeb"), 2, 1, "CID", 10, "2000000uband", "", testapp.Alice.Address)
+	res, err = oracle.NewHandler(k)(ctx, msg)
+	require.Error(t, err)
+	require.Nil(t, res)
+	// Should fail because the interval is shorter than MinStandingRequestInterval.
+	k.SetParam(ctx, types.KeyMinStandingRequestInterval, 20)
+	msg = types.NewMsgCreateStandingRequest(1, []byte("beeb"), 2, 1, "CID", 10, "100uband", "", testapp.Alice.Address)
+	res, err = oracle.NewHandler(k)(ctx, msg)
+	require.EqualError(t, err, "invalid interval: got: 10, min: 20")
+	require.Nil(t, res)
+}
+
+func TestCancelStandingRequestSuccess(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	msg := types.NewMsgCreateStandingRequest(1, []byte("beeb"), 2, 1, "CID", 10, "100uband", "", testapp.Alice.Address)
+	_, err := oracle.NewHandler(k)(ctx, msg)
+	require.NoError(t, err)
+	res, err := oracle.NewHandler(k)(ctx, types.NewMsgCancelStandingRequest(1, testapp.Alice.Address))
+	require.NoError(t, err)
+	require.False(t, k.HasStandingRequest(ctx, 1))
+	require.Equal(t, testapp.Coins1000000uband, app.BankKeeper.GetCoins(ctx, testapp.Alice.Address))
+	// The refund transfer emits its own events before the standing request events.
+	require.Equal(t, sdk.Events{sdk.NewEvent(
+		types.EventTypeCloseStandingRequest,
+		sdk.NewAttribute(types.AttributeKeyID, "1"),
+		sdk.NewAttribute(types.AttributeKeyReason, "cancelled by owner"),
+	), sdk.NewEvent(
+		types.EventTypeCancelStandingRequest,
+		sdk.NewAttribute(types.AttributeKeyID, "1"),
+	)}, res.Events[len(res.Events)-2:])
+}
+
+func TestCancelStandingRequestFail(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// Should fail because the standing request does not exist.
+	res, err := oracle.NewHandler(k)(ctx, types.NewMsgCancelStandingRequest(1, testapp.Alice.Address))
+	require.EqualError(t, err, "standing request not found: id: 1")
+	require.Nil(t, res)
+	msg := types.NewMsgCreateStandingRequest(1, []byte("beeb"), 2, 1, "CID", 10, "100uband", "", testapp.Alice.Address)
+	_, err = oracle.NewHandler(k)(ctx, msg)
+	require.NoError(t, err)
+	// Should fail because Bob is not the owner.
+	res, err = oracle.NewHandler(k)(ctx, types.NewMsgCancelStandingRequest(1, testapp.Bob.Address))
+	require.EqualError(t, err, "not standing request owner")
+	require.Nil(t, res)
+}
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	handler := oracle.NewParamChangeProposalHandler(k, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+	proposal := func(key string, value string) params.ParameterChangeProposal {
+		return params.NewParameterChangeProposal("title", "description", []params.ParamChange{
+			params.NewParamChange(oracle.DefaultParamspace, key, value),
+		})
+	}
+	k.SetParam(ctx, types.KeyExpirationBlockCount, 100)
+	// Each param is valid on its own, but the commit window must close before requests expire.
+	require.NoError(t, handler(ctx, proposal("CommitBlockCount", `"99"`)))
+	require.Equal(t, uint64(99), k.GetParam(ctx, types.KeyCommitBlockCount))
+	err := handler(ctx, proposal("ExpirationBlockCount", `"99"`))
+	require.EqualError(t, err, "invalid params: commit block count 99 must be less than expiration block count 99")
+}
@@ -54,6 +54,7 @@ func (k Keeper) MustEditDataSource(ctx sdk.Context, id types.DataSourceID, new t
 	dataSource.Name = modify(dataSource.Name, new.Name)
 	dataSource.Description = modify(dataSource.Description, new.Description)
 	dataSource.Filename = modify(dataSource.Filename, new.Filename)
+	dataSource.Fee = modify(dataSource.Fee, new.Fee)
 	k.SetDataSource(ctx, id, dataSource)
 }
 
@@ -15,7 +15,7 @@ func TestHasDataSource(t *testing.T) {
 	require.False(t, k.HasDataSource(ctx, 42))
 	// After we set it, we should be able to find it.
 	k.SetDataSource(ctx, 42, types.NewDataSource(
-		testapp.Owner.Address, BasicName, BasicDesc, BasicFilename,
+		testapp.Owner.Address, BasicName, BasicDesc, BasicFilename, "",
 	))
 	require.True(t, k.HasDataSource(ctx, 42))
 }
@@ -27,8 +27,8 @@ func TestSetterGetterDataSource(t *testing.T) {
 	require.Error(t, err)
 	require.Panics(t, func() { _ = k.MustGetDataSource(ctx, 42) })
 	// Creates some basic data sources.
-	dataSource1 := types.NewDataSource(testapp.Alice.Address, "NAME1", "DESCRIPTION1", "filename1")
-	dataSource2 := types.NewDataSource(testapp.Bob.Address, "NAME2", "DESCRIPTION2", "filename2")
+	dataSource1 := types.NewDataSource(testapp.Alice.Address, "NAME1", "DESCRIPTION1", "filename1", "")
+	dataSource2 := types.NewDataSource(testapp.Bob.Address, "NAME2", "DESCRIPTION2", "filename2", "")
 	// Sets id 42 with data soure 1 and id 42 with data source 2.
 	k.SetDataSource(ctx, 42, dataSource1)
 	k.SetDataSource(ctx, 43, dataSource2)
@@ -50,8 +50,8 @@ func TestSetterGetterDataSource(t *testing.T) {
 func TestAddDataSourceEditDataSourceBasic(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
 	// Creates some basic data sources.
-	dataSource1 := types.NewDataSource(testapp.Alice.Address, "NAME1", "DESCRIPTION1", "FILENAME1")
-	dataSource2 := types.NewDataSource(testapp.Bob.Address, "NAME2", "DESCRIPTION2", "FILENAME2")
+	dataSource1 := types.NewDataSource(testapp.Alice.Address, "NAME1", "DESCRIPTION1", "FILENAME1", "")
+	dataSource2 := types.NewDataSource(testapp.Bob.Address, "NAME2", "DESCRIPTION2", "FILENAME2", "10uband")
 	// Adds a new data source to the store. We should be able to retreive it back.
 	id := k.AddDataSource(ctx, dataSource1)
 	require.Equal(t, dataSource1, k.MustGetDataSource(ctx, id))
@@ -59,6 +59,7 @@ func TestAddDataSourceEditDataSourceBasic(t *testing.T) {
 	// Edits the data source. We should get the updated data source.
 	k.MustEditDataSource(ctx, id, types.NewDataSource(
 		dataSource2.Owner, dataSource2.Name, dataSource2.Description, dataSource2.Filename,
+		dataSource2.Fee,
 	))
 	require.NotEqual(t, dataSource1, k.MustGetDataSource(ctx, id))
 	require.Equal(t, dataSource2, k.MustGetDataSource(ctx, id))
@@ -67,8 +68,8 @@ func TestAddDataSourceEditDataSourceBasic(t *testing.T) {
 func TestEditDataSourceDoNotModify(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
 	// Creates some basic data sources.
-	dataSource1 := types.NewDataSource(testapp.Alice.Address, "NAME1", "DESCRIPTION1", "FILENAME1")
-	dataSource2 := types.NewDataSource(testapp.Bob.Address, types.DoNotModify, types.DoNotModify, "FILENAME2")
+	dataSource1 := types.NewDataSource(testapp.Alice.Address, "NAME1", "DESCRIPTION1", "FILENAME1", "10uband")
+	dataSource2 := types.NewDataSource(testapp.Bob.Address, types.DoNotModify, types.DoNotModify, "FILENAME2", types.DoNotModify)
 	// Adds a new data source to the store. We should be able to retreive it back.
 	id := k.AddDataSource(ctx, dataSource1)
 	require.Equal(t, dataSource1, k.MustGetDataSource(ctx, id))
@@ -82,6 +83,7 @@ func TestEditDataSourceDoNotModify(t *testing.T) {
 	require.Equal(t, dataSourceRes.Name, dataSource1.Name)
 	require.Equal(t, dataSourceRes.Description, dataSource1.Description)
 	require.Equal(t, dataSourceRes.Filename, dataSource2.Filename)
+	require.Equal(t, dataSourceRes.Fee, dataSource1.Fee)
 }
 
 func TestAddDataSourceDataSourceMustReturnCorrectID(t *testing.T) {
@@ -90,10 +92,10 @@ func TestAddDataSourceDataSourceMustReturnCorrectID(t *testing.T) {
 	genesisCount := int64(len(testapp.DataSources)) - 1
 	require.Equal(t, genesisCount, k.GetDataSourceCount(ctx))
 	// Every new data source we add should return a new ID.
-	id1 := k.AddDataSource(ctx, types.NewDataSource(testapp.Owner.Address, BasicName, BasicDesc, BasicFilename))
+	id1 := k.AddDataSource(ctx, types.NewDataSource(testapp.Owner.Address, BasicName, BasicDesc, BasicFilename, ""))
 	require.Equal(t, types.DataSourceID(genesisCount+1), id1)
 	// Adds another data source so now ID should increase by 2.
-	id2 := k.AddDataSource(ctx, types.NewDataSource(testapp.Owner.Address, BasicName, BasicDesc, BasicFilename))
+	id2 := k.AddDataSource(ctx, types.NewDataSource(testapp.Owner.Address, BasicName, BasicDesc, BasicFilename, ""))
 	require.Equal(t, types.DataSourceID(genesisCount+2), id2)
 	// Finally we expect the data source to increase as well.
 	require.Equal(t, genesisCount+2, k.GetDataSourceCount(ctx))
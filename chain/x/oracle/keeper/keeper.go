@@ -22,16 +22,18 @@ type Keeper struct {
 	fileCache        filecache.Cache
 	feeCollectorName string
 	paramSpace       params.Subspace
+	bankKeeper       types.BankKeeper
 	supplyKeeper     types.SupplyKeeper
 	stakingKeeper    types.StakingKeeper
 	distrKeeper      types.DistrKeeper
+	slashingKeeper   types.SlashingKeeper
 }
 
 // NewKeeper creates a new oracle Keeper instance.
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, fileDir string, feeCollectorName string,
-	paramSpace params.Subspace, supplyKeeper types.SupplyKeeper,
-	stakingKeeper types.StakingKeeper, distrKeeper types.DistrKeeper,
+	paramSpace params.Subspace, bankKeeper types.BankKeeper, supplyKeeper types.SupplyKeeper,
+	stakingKeeper types.StakingKeeper, distrKeeper types.DistrKeeper, slashingKeeper types.SlashingKeeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(ParamKeyTable())
@@ -42,9 +44,11 @@ func NewKeeper(
 		fileCache:        filecache.New(fileDir),
 		feeCollectorName: feeCollectorName,
 		paramSpace:       paramSpace,
+		bankKeeper:       bankKeeper,
 		supplyKeeper:     supplyKeeper,
 		stakingKeeper:    stakingKeeper,
 		distrKeeper:      distrKeeper,
+		slashingKeeper:   slashingKeeper,
 	}
 }
 
@@ -69,6 +73,17 @@ func (k Keeper) SetParam(ctx sdk.Context, key []byte, value uint64) {
 	k.paramSpace.Set(ctx, key, value)
 }
 
+// GetParamBool returns the parameter as specified by key as a bool.
+func (k Keeper) GetParamBool(ctx sdk.Context, key []byte) (res bool) {
+	k.paramSpace.Get(ctx, key, &res)
+	return res
+}
+
+// SetParamBool saves the given key-value boolean parameter to the store.
+func (k Keeper) SetParamBool(ctx sdk.Context, key []byte, value bool) {
+	k.paramSpace.Set(ctx, key, value)
+}
+
 // GetParams returns all current parameters as a types.Params instance.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -113,6 +128,20 @@ func (k Keeper) GetRequestLastExpired(ctx sdk.Context) types.RequestID {
 	return requestNumber
 }
 
+// SetRequestLastPruned sets the ID of the last pruned request.
+func (k Keeper) SetRequestLastPruned(ctx sdk.Context, id types.RequestID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RequestLastPrunedStoreKey, k.cdc.MustMarshalBinaryLengthPrefixed(id))
+}
+
+// GetRequestLastPruned returns the ID of the last pruned request.
+func (k Keeper) GetRequestLastPruned(ctx sdk.Context) types.RequestID {
+	var requestNumber types.RequestID
+	bz := ctx.KVStore(k.storeKey).Get(types.RequestLastPrunedStoreKey)
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &requestNumber)
+	return requestNumber
+}
+
 // GetNextRequestID increments and returns the current number of requests.
 func (k Keeper) GetNextRequestID(ctx sdk.Context) types.RequestID {
 	requestNumber := k.GetRequestCount(ctx)
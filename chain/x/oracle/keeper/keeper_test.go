@@ -35,6 +35,14 @@ func TestGetSetRequestLastExpiredID(t *testing.T) {
 	require.Equal(t, types.RequestID(20), k.GetRequestLastExpired(ctx))
 }
 
+func TestGetSetRequestLastPrunedID(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// Initially last pruned request must be 0.
+	require.Equal(t, types.RequestID(0), k.GetRequestLastPruned(ctx))
+	k.SetRequestLastPruned(ctx, 20)
+	require.Equal(t, types.RequestID(20), k.GetRequestLastPruned(ctx))
+}
+
 func TestGetSetParams(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
 	k.SetParam(ctx, types.KeyMaxRawRequestCount, 1)
@@ -45,7 +53,22 @@ func TestGetSetParams(t *testing.T) {
 	k.SetParam(ctx, types.KeySamplingTryCount, 3)
 	k.SetParam(ctx, types.KeyOracleRewardPercentage, 50)
 	k.SetParam(ctx, types.KeyInactivePenaltyDuration, 1000)
-	require.Equal(t, types.NewParams(1, 10, 30, 50000, 3000, 3, 50, 1000), k.GetParams(ctx))
+	k.SetParam(ctx, types.KeyRequestRetentionBlockCount, 0)
+	k.SetParam(ctx, types.KeyMaxPruneCountPerBlock, 100)
+	k.SetParamBool(ctx, types.KeyPruneResult, false)
+	k.SetParam(ctx, types.KeyCommitBlockCount, 0)
+	k.SetParam(ctx, types.KeyReportWindow, 100)
+	k.SetParam(ctx, types.KeyMinReportPercentage, 50)
+	k.SetParam(ctx, types.KeyMissedReportSlashPercentage, 1)
+	k.SetParam(ctx, types.KeyRewardWeightingMode, 0)
+	k.SetParam(ctx, types.KeyInBeforeResolveBonusPercentage, 20)
+	k.SetParam(ctx, types.KeyStandingRequestFee, 1000)
+	k.SetParam(ctx, types.KeyMinStandingRequestInterval, 10)
+	k.SetParam(ctx, types.KeyMaxStandingRequestsPerBlock, 10)
+	k.SetParam(ctx, types.KeyMaxStandingRequestFailures, 10)
+	k.SetParam(ctx, types.KeyMissedReportJailDuration, 600)
+	k.SetParam(ctx, types.KeyRewardPeriod, 1)
+	require.Equal(t, types.NewParams(1, 10, 30, 50000, 3000, 3, 50, 1000, 0, 100, false, 0, 100, 50, 1, 0, 20, 1000, 10, 10, 10, 600, 1), k.GetParams(ctx))
 	k.SetParam(ctx, types.KeyMaxRawRequestCount, 2)
 	k.SetParam(ctx, types.KeyMaxAskCount, 20)
 	k.SetParam(ctx, types.KeyExpirationBlockCount, 40)
@@ -54,5 +77,20 @@ func TestGetSetParams(t *testing.T) {
 	k.SetParam(ctx, types.KeySamplingTryCount, 5)
 	k.SetParam(ctx, types.KeyOracleRewardPercentage, 80)
 	k.SetParam(ctx, types.KeyInactivePenaltyDuration, 10000)
-	require.Equal(t, types.NewParams(2, 20, 40, 150000, 30000, 5, 80, 10000), k.GetParams(ctx))
+	k.SetParam(ctx, types.KeyRequestRetentionBlockCount, 1000)
+	k.SetParam(ctx, types.KeyMaxPruneCountPerBlock, 50)
+	k.SetParamBool(ctx, types.KeyPruneResult, true)
+	k.SetParam(ctx, types.KeyCommitBlockCount, 5)
+	k.SetParam(ctx, types.KeyReportWindow, 200)
+	k.SetParam(ctx, types.KeyMinReportPercentage, 80)
+	k.SetParam(ctx, types.KeyMissedReportSlashPercentage, 5)
+	k.SetParam(ctx, types.KeyRewardWeightingMode, 2)
+	k.SetParam(ctx, types.KeyInBeforeResolveBonusPercentage, 50)
+	k.SetParam(ctx, types.KeyStandingRequestFee, 0)
+	k.SetParam(ctx, types.KeyMinStandingRequestInterval, 1)
+	k.SetParam(ctx, types.KeyMaxStandingRequestsPerBlock, 5)
+	k.SetParam(ctx, types.KeyMaxStandingRequestFailures, 3)
+	k.SetParam(ctx, types.KeyMissedReportJailDuration, 6000)
+	k.SetParam(ctx, types.KeyRewardPeriod, 100)
+	require.Equal(t, types.NewParams(2, 20, 40, 150000, 30000, 5, 80, 10000, 1000, 50, true, 5, 200, 80, 5, 2, 50, 0, 1, 5, 3, 6000, 100), k.GetParams(ctx))
 }
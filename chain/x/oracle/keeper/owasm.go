@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,8 +42,11 @@ func (k Keeper) GetRandomValidators(ctx sdk.Context, size int, id int64) ([]sdk.
 }
 
 // PrepareRequest takes an request specification object, performs the prepare call, and saves
-// the request object to store. Also emits events related to the request.
-func (k Keeper) PrepareRequest(ctx sdk.Context, r types.RequestSpec) error {
+// the request object to store. The payer pays data source fees up to the given fee limit.
+// Also emits events related to the request.
+func (k Keeper) PrepareRequest(
+	ctx sdk.Context, r types.RequestSpec, payer sdk.AccAddress, feeLimit sdk.Coins,
+) error {
 	askCount := r.GetAskCount()
 	if askCount > k.GetParam(ctx, types.KeyMaxAskCount) {
 		return sdkerrors.Wrapf(types.ErrInvalidAskCount, "got: %d, max: %d", askCount, k.GetParam(ctx, types.KeyMaxAskCount))
@@ -59,22 +63,17 @@ func (k Keeper) PrepareRequest(ctx sdk.Context, r types.RequestSpec) error {
 	req := types.NewRequest(
 		r.GetOracleScriptID(), r.GetCalldata(), validators, r.GetMinCount(),
 		ctx.BlockHeight(), ctx.BlockTime(), r.GetClientID(), nil,
+		k.GetOracleScriptVersionCount(ctx, r.GetOracleScriptID()),
 	)
-	// Create an execution environment and call Owasm prepare function.
-	env := types.NewPrepareEnv(req, int64(k.GetParam(ctx, types.KeyMaxRawRequestCount)))
-	script, err := k.GetOracleScript(ctx, req.OracleScriptID)
+	// Call Owasm prepare function to collect raw requests.
+	req.RawRequests, err = k.prepareRawRequests(ctx, req)
 	if err != nil {
 		return err
 	}
-	code := k.GetFile(script.Filename)
-	err = owasm.Prepare(code, types.WasmPrepareGas, types.MaxDataSize, env)
+	// Collect data source fees from the payer and send them to the data source owners.
+	err = k.CollectFee(ctx, payer, feeLimit, req.RawRequests)
 	if err != nil {
-		return sdkerrors.Wrapf(types.ErrBadWasmExecution, err.Error())
-	}
-	// Preparation complete! It's time to collect raw request ids.
-	req.RawRequests = env.GetRawRequests()
-	if len(req.RawRequests) == 0 {
-		return types.ErrEmptyRawRequests
+		return err
 	}
 	// We now have everything we need to the request, so let's add it to the store.
 	id := k.AddRequest(ctx, req)
@@ -93,7 +92,7 @@ func (k Keeper) PrepareRequest(ctx sdk.Context, r types.RequestSpec) error {
 	}
 	ctx.EventManager().EmitEvent(event)
 	// Emit an event for each of the raw data requests.
-	for _, rawReq := range env.GetRawRequests() {
+	for _, rawReq := range req.RawRequests {
 		ds, err := k.GetDataSource(ctx, rawReq.DataSourceID)
 		if err != nil {
 			return err
@@ -109,19 +108,91 @@ func (k Keeper) PrepareRequest(ctx sdk.Context, r types.RequestSpec) error {
 	return nil
 }
 
+// prepareRawRequests creates an execution environment for the given request, calls Owasm prepare
+// function of its oracle script, and returns the raw requests with their data source versions.
+func (k Keeper) prepareRawRequests(ctx sdk.Context, req types.Request) ([]types.RawRequest, error) {
+	env := types.NewPrepareEnv(req, int64(k.GetParam(ctx, types.KeyMaxRawRequestCount)))
+	script, err := k.GetOracleScript(ctx, req.OracleScriptID)
+	if err != nil {
+		return nil, err
+	}
+	code := k.GetFile(script.Filename)
+	err = owasm.Prepare(code, types.WasmPrepareGas, types.MaxDataSize, env)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrBadWasmExecution, err.Error())
+	}
+	// Preparation complete! It's time to collect raw request ids.
+	rawRequests := env.GetRawRequests()
+	if len(rawRequests) == 0 {
+		return nil, types.ErrEmptyRawRequests
+	}
+	// Record the exact data source versions used, so results can be traced back to the code.
+	for idx := range rawRequests {
+		rawRequests[idx].DataSourceVersion = k.GetDataSourceVersionCount(ctx, rawRequests[idx].DataSourceID)
+	}
+	return rawRequests, nil
+}
+
+// CollectFee sends the fee of every data source used in the given raw requests from the payer
+// to the data source owners. Returns error if the total fee exceeds the given limit.
+func (k Keeper) CollectFee(
+	ctx sdk.Context, payer sdk.AccAddress, feeLimit sdk.Coins, rawRequests []types.RawRequest,
+) error {
+	totalFee := sdk.NewCoins()
+	owners := make([]sdk.AccAddress, len(rawRequests))
+	fees := make([]sdk.Coins, len(rawRequests))
+	for idx, rawReq := range rawRequests {
+		ds, err := k.GetDataSource(ctx, rawReq.DataSourceID)
+		if err != nil {
+			return err
+		}
+		fee, err := sdk.ParseCoins(ds.Fee)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "data source: %d", rawReq.DataSourceID)
+		}
+		owners[idx] = ds.Owner
+		fees[idx] = fee
+		totalFee = totalFee.Add(fee...)
+	}
+	if !totalFee.IsAllLTE(feeLimit) {
+		return sdkerrors.Wrapf(types.ErrNotEnoughFee, "require: %s, limit: %s", totalFee, feeLimit)
+	}
+	for idx, fee := range fees {
+		if fee.IsZero() {
+			continue
+		}
+		err := k.bankKeeper.SendCoins(ctx, payer, owners[idx], fee)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ResolveRequest resolves the given request and saves the result to the store. The function
 // assumes that the given request is in a resolvable state with sufficient reporters.
 func (k Keeper) ResolveRequest(ctx sdk.Context, reqID types.RequestID) {
 	req := k.MustGetRequest(ctx, reqID)
-	env := types.NewExecuteEnv(req, k.GetReports(ctx, reqID))
+	result, err := k.executeRequest(ctx, req, k.GetReports(ctx, reqID))
+	if err != nil {
+		k.ResolveFailure(ctx, reqID, err.Error())
+	} else {
+		k.ResolveSuccess(ctx, reqID, result)
+	}
+}
+
+// executeRequest creates an execution environment for the given request and reports, calls
+// Owasm execute function of its oracle script, and returns the result.
+func (k Keeper) executeRequest(ctx sdk.Context, req types.Request, reports []types.Report) ([]byte, error) {
+	env := types.NewExecuteEnv(req, reports)
 	script := k.MustGetOracleScript(ctx, req.OracleScriptID)
 	code := k.GetFile(script.Filename)
 	err := owasm.Execute(code, types.WasmExecuteGas, types.MaxDataSize, env)
 	if err != nil {
-		k.ResolveFailure(ctx, reqID, err.Error())
-	} else if env.Retdata == nil {
-		k.ResolveFailure(ctx, reqID, "no return data")
-	} else {
-		k.ResolveSuccess(ctx, reqID, env.Retdata)
+		return nil, err
+	}
+	if env.Retdata == nil {
+		return nil, errors.New("no return data")
 	}
+	return env.Retdata, nil
 }
@@ -81,16 +81,17 @@ func TestPrepareRequestSuccessBasic(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
 	ctx = ctx.WithBlockTime(testapp.ParseTime(1581589790)).WithBlockHeight(42)
 	// OracleScript#1: Prepare asks for DS#1,2,3 with ExtID#1,2,3 and calldata "beeb"
-	m := types.NewMsgRequestData(1, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	m := types.NewMsgRequestData(1, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.NoError(t, err)
 	require.Equal(t, types.NewRequest(
 		1, BasicCalldata, []sdk.ValAddress{testapp.Validator1.ValAddress}, 1,
 		42, testapp.ParseTime(1581589790), BasicClientID, []types.RawRequest{
-			types.NewRawRequest(1, 1, []byte("beeb")),
-			types.NewRawRequest(2, 2, []byte("beeb")),
-			types.NewRawRequest(3, 3, []byte("beeb")),
+			types.NewRawRequest(1, 1, []byte("beeb"), 1),
+			types.NewRawRequest(2, 2, []byte("beeb"), 1),
+			types.NewRawRequest(3, 3, []byte("beeb"), 1),
 		},
+		1,
 	), k.MustGetRequest(ctx, 1))
 	require.Equal(t, sdk.Events{sdk.NewEvent(
 		types.EventTypeRequest,
@@ -122,17 +123,42 @@ func TestPrepareRequestSuccessBasic(t *testing.T) {
 	)}, ctx.EventManager().Events())
 }
 
+func TestPrepareRequestWithFee(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	// Data sources #1 and #2 charge 10uband and 20uband per request respectively.
+	ds1 := k.MustGetDataSource(ctx, 1)
+	ds1.Fee = "10uband"
+	k.SetDataSource(ctx, 1, ds1)
+	ds2 := k.MustGetDataSource(ctx, 2)
+	ds2.Fee = "20uband"
+	k.SetDataSource(ctx, 2, ds2)
+	// OracleScript#1: Prepare asks for DS#1,2,3, so the total fee is 30uband.
+	m := types.NewMsgRequestData(1, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, sdk.NewCoins(sdk.NewInt64Coin("uband", 29)))
+	require.EqualError(t, err, "not enough fee: require: 30uband, limit: 29uband")
+	err = k.PrepareRequest(ctx, &m, testapp.Alice.Address, sdk.NewCoins(sdk.NewInt64Coin("uband", 30)))
+	require.NoError(t, err)
+	require.Equal(t,
+		testapp.Coins1000000uband.Sub(sdk.NewCoins(sdk.NewInt64Coin("uband", 30))),
+		app.BankKeeper.GetCoins(ctx, testapp.Alice.Address),
+	)
+	require.Equal(t,
+		testapp.Coins1000000uband.Add(sdk.NewInt64Coin("uband", 30)),
+		app.BankKeeper.GetCoins(ctx, testapp.Owner.Address),
+	)
+}
+
 func TestPrepareRequestInvalidAskCountFail(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
 	k.SetParam(ctx, types.KeyMaxAskCount, 5)
-	m := types.NewMsgRequestData(1, BasicCalldata, 10, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	m := types.NewMsgRequestData(1, BasicCalldata, 10, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "invalid ask count: got: 10, max: 5")
-	m = types.NewMsgRequestData(1, BasicCalldata, 4, 1, BasicClientID, testapp.Alice.Address)
-	err = k.PrepareRequest(ctx, &m)
+	m = types.NewMsgRequestData(1, BasicCalldata, 4, 1, BasicClientID, testapp.Alice.Address, "")
+	err = k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "insufficent available validators: 3 < 4")
-	m = types.NewMsgRequestData(1, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
-	err = k.PrepareRequest(ctx, &m)
+	m = types.NewMsgRequestData(1, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err = k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.NoError(t, err)
 }
 
@@ -140,11 +166,11 @@ func TestPrepareRequestBaseRequestFeePanic(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
 	k.SetParam(ctx, types.KeyBaseRequestGas, 100000) // Set BaseRequestGas to 100000
 	k.SetParam(ctx, types.KeyPerValidatorRequestGas, 0)
-	m := types.NewMsgRequestData(1, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
+	m := types.NewMsgRequestData(1, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
 	ctx = ctx.WithGasMeter(sdk.NewGasMeter(90000))
-	require.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "BASE_REQUEST_FEE"}, func() { k.PrepareRequest(ctx, &m) })
+	require.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "BASE_REQUEST_FEE"}, func() { k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil) })
 	ctx = ctx.WithGasMeter(sdk.NewGasMeter(200000))
-	err := k.PrepareRequest(ctx, &m)
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.NoError(t, err)
 }
 
@@ -152,40 +178,40 @@ func TestPrepareRequestPerValidatorRequestFeePanic(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
 	k.SetParam(ctx, types.KeyBaseRequestGas, 100000)
 	k.SetParam(ctx, types.KeyPerValidatorRequestGas, 50000) // Set erValidatorRequestGas to 50000
-	m := types.NewMsgRequestData(1, BasicCalldata, 2, 1, BasicClientID, testapp.Alice.Address)
+	m := types.NewMsgRequestData(1, BasicCalldata, 2, 1, BasicClientID, testapp.Alice.Address, "")
 	ctx = ctx.WithGasMeter(sdk.NewGasMeter(190000))
-	require.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "PER_VALIDATOR_REQUEST_FEE"}, func() { k.PrepareRequest(ctx, &m) })
-	m = types.NewMsgRequestData(1, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
+	require.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "PER_VALIDATOR_REQUEST_FEE"}, func() { k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil) })
+	m = types.NewMsgRequestData(1, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
 	ctx = ctx.WithGasMeter(sdk.NewGasMeter(190000))
-	err := k.PrepareRequest(ctx, &m)
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.NoError(t, err)
 }
 
 func TestPrepareRequestEmptyCalldata(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true) // Send nil while oracle script expects calldata
-	m := types.NewMsgRequestData(4, nil, 1, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	m := types.NewMsgRequestData(4, nil, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "bad wasm execution: runtime error while executing the Wasm script")
 }
 
 func TestPrepareRequestOracleScriptNotFound(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
-	m := types.NewMsgRequestData(999, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	m := types.NewMsgRequestData(999, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "oracle script not found: id: 999")
 }
 
 func TestPrepareRequestBadWasmExecutionFail(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
-	m := types.NewMsgRequestData(2, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	m := types.NewMsgRequestData(2, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "bad wasm execution: OEI action to invoke is not available")
 }
 
 func TestPrepareRequestWithEmptyRawRequest(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
-	m := types.NewMsgRequestData(3, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	m := types.NewMsgRequestData(3, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "empty raw requests")
 }
 
@@ -194,8 +220,8 @@ func TestPrepareRequestUnknownDataSource(t *testing.T) {
 	m := types.NewMsgRequestData(4, obi.MustEncode(testapp.Wasm4Input{
 		IDs:      []int64{1, 2, 99},
 		Calldata: "beeb",
-	}), 1, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	}), 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "data source not found: id: 99")
 }
 
@@ -205,34 +231,34 @@ func TestPrepareRequestInvalidDataSourceCount(t *testing.T) {
 	m := types.NewMsgRequestData(4, obi.MustEncode(testapp.Wasm4Input{
 		IDs:      []int64{1, 2, 3, 4},
 		Calldata: "beeb",
-	}), 1, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	}), 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "bad wasm execution: too many external data requests")
 	m = types.NewMsgRequestData(4, obi.MustEncode(testapp.Wasm4Input{
 		IDs:      []int64{1, 2, 3},
 		Calldata: "beeb",
-	}), 1, 1, BasicClientID, testapp.Alice.Address)
-	err = k.PrepareRequest(ctx, &m)
+	}), 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err = k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.NoError(t, err)
 }
 
 func TestPrepareRequestTooMuchWasmGas(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
-	m := types.NewMsgRequestData(5, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	m := types.NewMsgRequestData(5, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.NoError(t, err)
-	m = types.NewMsgRequestData(6, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
-	err = k.PrepareRequest(ctx, &m)
+	m = types.NewMsgRequestData(6, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err = k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "bad wasm execution: out-of-gas while executing the wasm script")
 }
 
 func TestPrepareRequestTooLargeCalldata(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
-	m := types.NewMsgRequestData(7, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
-	err := k.PrepareRequest(ctx, &m)
+	m := types.NewMsgRequestData(7, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err := k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.NoError(t, err)
-	m = types.NewMsgRequestData(8, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address)
-	err = k.PrepareRequest(ctx, &m)
+	m = types.NewMsgRequestData(8, BasicCalldata, 1, 1, BasicClientID, testapp.Alice.Address, "")
+	err = k.PrepareRequest(ctx, &m, testapp.Alice.Address, nil)
 	require.EqualError(t, err, "bad wasm execution: span to write is too small")
 }
 
@@ -243,8 +269,9 @@ func TestResolveRequestSuccess(t *testing.T) {
 		// 1st Wasm - return "beeb"
 		1, BasicCalldata, []sdk.ValAddress{testapp.Validator1.ValAddress, testapp.Validator2.ValAddress}, 1,
 		42, testapp.ParseTime(1581589790), BasicClientID, []types.RawRequest{
-			types.NewRawRequest(1, 1, []byte("beeb")),
+			types.NewRawRequest(1, 1, []byte("beeb"), 0),
 		},
+		0,
 	))
 	k.SetReport(ctx, 42, types.NewReport(
 		testapp.Validator1.ValAddress, true, []types.RawReport{
@@ -276,9 +303,10 @@ func TestResolveRequestSuccessComplex(t *testing.T) {
 			Calldata: string(BasicCalldata),
 		}), []sdk.ValAddress{testapp.Validator1.ValAddress, testapp.Validator2.ValAddress}, 1,
 		42, testapp.ParseTime(1581589790), BasicClientID, []types.RawRequest{
-			types.NewRawRequest(0, 1, BasicCalldata),
-			types.NewRawRequest(1, 2, BasicCalldata),
+			types.NewRawRequest(0, 1, BasicCalldata, 0),
+			types.NewRawRequest(1, 2, BasicCalldata, 0),
 		},
+		0,
 	))
 	k.SetReport(ctx, 42, types.NewReport(
 		testapp.Validator1.ValAddress, true, []types.RawReport{
@@ -320,8 +348,9 @@ func TestResolveRequestNoReturnData(t *testing.T) {
 		// 3rd Wasm - do nothing
 		3, BasicCalldata, []sdk.ValAddress{testapp.Validator1.ValAddress, testapp.Validator2.ValAddress}, 1,
 		42, testapp.ParseTime(1581589790), BasicClientID, []types.RawRequest{
-			types.NewRawRequest(1, 1, []byte("beeb")),
+			types.NewRawRequest(1, 1, []byte("beeb"), 0),
 		},
+		0,
 	))
 	k.SetReport(ctx, 42, types.NewReport(
 		testapp.Validator1.ValAddress, true, []types.RawReport{
@@ -350,8 +379,9 @@ func TestResolveRequestWasmFailure(t *testing.T) {
 		// 6th Wasm - out-of-gas
 		6, BasicCalldata, []sdk.ValAddress{testapp.Validator1.ValAddress, testapp.Validator2.ValAddress}, 1,
 		42, testapp.ParseTime(1581589790), BasicClientID, []types.RawRequest{
-			types.NewRawRequest(1, 1, []byte("beeb")),
+			types.NewRawRequest(1, 1, []byte("beeb"), 0),
 		},
+		0,
 	))
 	k.SetReport(ctx, 42, types.NewReport(
 		testapp.Validator1.ValAddress, true, []types.RawReport{
@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"encoding/hex"
+	"fmt"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/bandprotocol/bandchain/chain/pkg/obi"
 	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
 )
 
@@ -30,6 +34,26 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryValidatorStatus(ctx, path[1:], keeper)
 		case types.QueryReporters:
 			return queryReporters(ctx, path[1:], keeper)
+		case types.QueryStandingRequests:
+			return queryStandingRequestByID(ctx, path[1:], keeper)
+		case types.QueryDataSourceVersions:
+			return queryDataSourceVersions(ctx, path[1:], keeper)
+		case types.QueryDataSourceVersionCode:
+			return queryDataSourceVersionCode(ctx, path[1:], keeper)
+		case types.QueryOracleScriptVersions:
+			return queryOracleScriptVersions(ctx, path[1:], keeper)
+		case types.QueryOracleScriptVersionCode:
+			return queryOracleScriptVersionCode(ctx, path[1:], keeper)
+		case types.QueryRequestsByOracleScript:
+			return queryRequestsByOracleScript(ctx, path[1:], keeper)
+		case types.QueryRequestsByClientID:
+			return queryRequestsByClientID(ctx, path[1:], keeper)
+		case types.QueryRequestsByResolveStatus:
+			return queryRequestsByResolveStatus(ctx, path[1:], keeper)
+		case types.QueryRequestSearch:
+			return queryRequestSearch(ctx, path[1:], keeper)
+		case types.QuerySimulate:
+			return querySimulate(ctx, req.Data, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown oracle query endpoint")
 		}
@@ -86,7 +110,7 @@ func queryOracleScriptByID(ctx sdk.Context, path []string, k Keeper) ([]byte, er
 }
 
 func queryRequestByID(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
-	if len(path) != 1 {
+	if len(path) != 1 && (len(path) != 2 || path[1] != types.QueryDecodeOption) {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "request not specified")
 	}
 	id, err := strconv.ParseInt(path[0], 10, 64)
@@ -97,20 +121,167 @@ func queryRequestByID(ctx sdk.Context, path []string, k Keeper) ([]byte, error)
 	if err != nil {
 		return types.QueryNotFound(err.Error())
 	}
-	reports := k.GetReports(ctx, types.RequestID(id))
-	if !k.HasResult(ctx, types.RequestID(id)) {
-		return types.QueryOK(types.QueryRequestResult{
+	result := getRequestResult(ctx, k, types.RequestID(id), request)
+	if len(path) == 2 {
+		result.Decoded = decodeRequestResult(ctx, k, result)
+	}
+	return types.QueryOK(result)
+}
+
+// decodeRequestResult decodes the calldata and the result, if resolved successfully, of the given
+// request query result with the schema of the oracle script version that the request was made to.
+func decodeRequestResult(ctx sdk.Context, k Keeper, res types.QueryRequestResult) *types.QueryDecodedRequest {
+	req := res.Request
+	var schemaStr string
+	if version, err := k.GetOracleScriptVersion(ctx, req.OracleScriptID, req.OracleScriptVersion); err == nil {
+		schemaStr = version.Schema
+	} else if oracleScript, err := k.GetOracleScript(ctx, req.OracleScriptID); err == nil {
+		schemaStr = oracleScript.Schema
+	}
+	if schemaStr == "" {
+		return &types.QueryDecodedRequest{Error: "oracle script has no schema"}
+	}
+	schema, err := obi.ParseSchema(schemaStr)
+	if err != nil {
+		return &types.QueryDecodedRequest{Error: err.Error()}
+	}
+	if len(req.Calldata) > types.MaxDecodeDataSize {
+		return &types.QueryDecodedRequest{Error: "calldata is too large to decode"}
+	}
+	calldata, err := schema.DecodeInputJSON(req.Calldata)
+	if err != nil {
+		return &types.QueryDecodedRequest{Error: err.Error()}
+	}
+	decoded := &types.QueryDecodedRequest{Calldata: calldata}
+	if res.Result != nil && res.Result.ResponsePacketData.ResolveStatus == types.ResolveStatus_Success {
+		result := res.Result.ResponsePacketData.Result
+		if len(result) > types.MaxDecodeDataSize {
+			decoded.Error = "result is too large to decode"
+			return decoded
+		}
+		decoded.Result, err = schema.DecodeOutputJSON(result)
+		if err != nil {
+			decoded.Error = err.Error()
+		}
+	}
+	return decoded
+}
+
+// getRequestResult returns the request query result of the given request.
+func getRequestResult(ctx sdk.Context, k Keeper, id types.RequestID, request types.Request) types.QueryRequestResult {
+	reports := k.GetReports(ctx, id)
+	if !k.HasResult(ctx, id) {
+		return types.QueryRequestResult{
 			Request: request,
 			Reports: reports,
 			Result:  nil,
-		})
+		}
 	}
-	result := k.MustGetResult(ctx, types.RequestID(id))
-	return types.QueryOK(types.QueryRequestResult{
+	result := k.MustGetResult(ctx, id)
+	return types.QueryRequestResult{
 		Request: request,
 		Reports: reports,
 		Result:  &result,
+	}
+}
+
+// parsePagination parses the page and limit from the first two elements of the given path.
+func parsePagination(path []string) (page int, limit int, err error) {
+	page, err = strconv.Atoi(path[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	if page <= 0 {
+		return 0, 0, fmt.Errorf("page must be positive: %d", page)
+	}
+	limit, err = strconv.Atoi(path[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	if limit <= 0 || limit > types.MaxQueryLimit {
+		return 0, 0, fmt.Errorf("limit must be between 1 and %d: %d", types.MaxQueryLimit, limit)
+	}
+	return page, limit, nil
+}
+
+func queryRequestsByOracleScript(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) != 3 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "page, limit, or oracle script not specified")
+	}
+	page, limit, err := parsePagination(path)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	id, err := strconv.ParseInt(path[2], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	return types.QueryOK(k.GetRequestIDsByOracleScript(ctx, types.OracleScriptID(id), page, limit))
+}
+
+func queryRequestsByClientID(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) < 3 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "page, limit, or client ID not specified")
+	}
+	page, limit, err := parsePagination(path)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	// Client IDs may contain slashes, so the rest of the path is the client ID.
+	clientID := strings.Join(path[2:], "/")
+	return types.QueryOK(k.GetRequestIDsByClientID(ctx, clientID, page, limit))
+}
+
+func queryRequestsByResolveStatus(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) != 3 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "page, limit, or resolve status not specified")
+	}
+	page, limit, err := parsePagination(path)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	status, err := types.ParseResolveStatus(path[2])
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	return types.QueryOK(k.GetRequestIDsByResolveStatus(ctx, status, page, limit))
+}
+
+func queryRequestSearch(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) != 4 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "oracle script, calldata, ask count, or min count not specified")
+	}
+	oid, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	calldata, err := hex.DecodeString(path[1])
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	askCount, err := strconv.ParseUint(path[2], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	minCount, err := strconv.ParseUint(path[3], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	// Find the latest resolved request with the given specification. Only requests matching the
+	// specification are visited, so unresolved ones are the only misses.
+	var found *types.QueryRequestResult
+	k.IterateRequestIDsBySpec(ctx, types.OracleScriptID(oid), calldata, askCount, minCount, func(id types.RequestID) bool {
+		if !k.HasResult(ctx, id) {
+			return false
+		}
+		result := getRequestResult(ctx, k, id, k.MustGetRequest(ctx, id))
+		found = &result
+		return true
 	})
+	if found == nil {
+		return types.QueryNotFound("request with specified specification not found")
+	}
+	return types.QueryOK(*found)
 }
 
 func queryValidatorStatus(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
@@ -134,3 +305,109 @@ func queryReporters(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
 	}
 	return types.QueryOK(k.GetReporters(ctx, validatorAddress))
 }
+
+func queryStandingRequestByID(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "standing request not specified")
+	}
+	id, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	standingRequest, err := k.GetStandingRequest(ctx, types.StandingRequestID(id))
+	if err != nil {
+		return types.QueryNotFound(err.Error())
+	}
+	escrow := types.StandingRequestEscrowAddress(types.StandingRequestID(id))
+	return types.QueryOK(types.QueryStandingRequestResult{
+		StandingRequest: standingRequest,
+		EscrowAddress:   escrow,
+		Budget:          k.bankKeeper.GetCoins(ctx, escrow),
+	})
+}
+
+func queryDataSourceVersions(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "data source not specified")
+	}
+	id, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	if !k.HasDataSource(ctx, types.DataSourceID(id)) {
+		return types.QueryNotFound(sdkerrors.Wrapf(types.ErrDataSourceNotFound, "id: %d", id).Error())
+	}
+	return types.QueryOK(k.GetDataSourceVersions(ctx, types.DataSourceID(id)))
+}
+
+func queryDataSourceVersionCode(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) != 2 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "data source or version not specified")
+	}
+	id, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	version, err := strconv.ParseInt(path[1], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	dataSourceVersion, err := k.GetDataSourceVersion(ctx, types.DataSourceID(id), version)
+	if err != nil {
+		return types.QueryNotFound(err.Error())
+	}
+	code, err := k.fileCache.GetFile(dataSourceVersion.Filename)
+	if err != nil {
+		return nil, err
+	}
+	return types.QueryOK(code)
+}
+
+func queryOracleScriptVersions(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "oracle script not specified")
+	}
+	id, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	if !k.HasOracleScript(ctx, types.OracleScriptID(id)) {
+		return types.QueryNotFound(sdkerrors.Wrapf(types.ErrOracleScriptNotFound, "id: %d", id).Error())
+	}
+	return types.QueryOK(k.GetOracleScriptVersions(ctx, types.OracleScriptID(id)))
+}
+
+func queryOracleScriptVersionCode(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) != 2 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "oracle script or version not specified")
+	}
+	id, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	version, err := strconv.ParseInt(path[1], 10, 64)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	oracleScriptVersion, err := k.GetOracleScriptVersion(ctx, types.OracleScriptID(id), version)
+	if err != nil {
+		return types.QueryNotFound(err.Error())
+	}
+	code, err := k.fileCache.GetFile(oracleScriptVersion.Filename)
+	if err != nil {
+		return nil, err
+	}
+	return types.QueryOK(code)
+}
+
+func querySimulate(ctx sdk.Context, data []byte, k Keeper) ([]byte, error) {
+	var params types.QuerySimulateParams
+	if err := k.cdc.UnmarshalJSON(data, &params); err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	result, err := k.Simulate(ctx, params)
+	if err != nil {
+		return types.QueryBadRequest(err.Error())
+	}
+	return types.QueryOK(result)
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/bandprotocol/bandchain/chain/pkg/obi"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/keeper"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/testapp"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func queryRequest(t *testing.T, ctx sdk.Context, k keeper.Keeper, path ...string) types.QueryRequestResult {
+	bz, err := keeper.NewQuerier(k)(ctx, append([]string{types.QueryRequests}, path...), abci.RequestQuery{})
+	require.NoError(t, err)
+	var res types.QueryResult
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, http.StatusOK, res.Status)
+	var out types.QueryRequestResult
+	types.ModuleCdc.MustUnmarshalJSON(res.Result, &out)
+	return out
+}
+
+func TestQueryRequestDecode(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	oracleScript := k.MustGetOracleScript(ctx, 1)
+	oracleScript.Schema = "{symbol:string,multiplier:u64}/{px:u64}"
+	k.SetOracleScript(ctx, 1, oracleScript)
+	req := defaultRequest()
+	req.Calldata = obi.MustEncode("BTC", uint64(100))
+	req.OracleScriptVersion = k.AddOracleScriptVersion(ctx, 1, testapp.Owner.Address)
+	k.AddRequest(ctx, req)
+	// Not decoded unless asked for.
+	require.Nil(t, queryRequest(t, ctx, k, "1").Decoded)
+	// Only the calldata is decoded while the request is not resolved.
+	decoded := queryRequest(t, ctx, k, "1", types.QueryDecodeOption).Decoded
+	require.JSONEq(t, `{"symbol":"BTC","multiplier":100}`, string(decoded.Calldata))
+	require.Nil(t, decoded.Result)
+	k.ResolveSuccess(ctx, 1, obi.MustEncode(uint64(42)))
+	decoded = queryRequest(t, ctx, k, "1", types.QueryDecodeOption).Decoded
+	require.JSONEq(t, `{"symbol":"BTC","multiplier":100}`, string(decoded.Calldata))
+	require.JSONEq(t, `{"px":42}`, string(decoded.Result))
+	require.Empty(t, decoded.Error)
+}
+
+func TestQueryRequestDecodeSchemaMismatch(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	oracleScript := k.MustGetOracleScript(ctx, 1)
+	oracleScript.Schema = "{symbol:string,multiplier:u64}/{px:u64}"
+	k.SetOracleScript(ctx, 1, oracleScript)
+	req := defaultRequest()
+	req.OracleScriptVersion = k.AddOracleScriptVersion(ctx, 1, testapp.Owner.Address)
+	k.AddRequest(ctx, req)
+	// BasicCalldata is not OBI-encoded against the schema.
+	decoded := queryRequest(t, ctx, k, "1", types.QueryDecodeOption).Decoded
+	require.Nil(t, decoded.Calldata)
+	require.NotEmpty(t, decoded.Error)
+}
+
+func TestQueryRequestDecodeBounded(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	oracleScript := k.MustGetOracleScript(ctx, 1)
+	// Schemas stored before array lengths were capped fail to parse instead of being decoded.
+	oracleScript.Schema = "{x:[u8;4294967295]}/{y:u8}"
+	k.SetOracleScript(ctx, 1, oracleScript)
+	req := defaultRequest()
+	req.Calldata = []byte{0x01, 0x02, 0x03}
+	req.OracleScriptVersion = k.AddOracleScriptVersion(ctx, 1, testapp.Owner.Address)
+	k.AddRequest(ctx, req)
+	decoded := queryRequest(t, ctx, k, "1", types.QueryDecodeOption).Decoded
+	require.Nil(t, decoded.Calldata)
+	require.Contains(t, decoded.Error, "invalid array length")
+	// Results larger than MaxDecodeDataSize are not decoded.
+	oracleScript.Schema = "{x:[u8;3]}/{y:bytes}"
+	k.SetOracleScript(ctx, 1, oracleScript)
+	req.OracleScriptVersion = k.AddOracleScriptVersion(ctx, 1, testapp.Owner.Address)
+	k.AddRequest(ctx, req)
+	k.ResolveSuccess(ctx, 2, obi.MustEncode(make([]byte, types.MaxDecodeDataSize)))
+	decoded = queryRequest(t, ctx, k, "2", types.QueryDecodeOption).Decoded
+	require.JSONEq(t, `{"x":[1,2,3]}`, string(decoded.Calldata))
+	require.Nil(t, decoded.Result)
+	require.Equal(t, "result is too large to decode", decoded.Error)
+}
+
+func TestQueryVersionCode(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	query := func(path ...string) types.QueryResult {
+		bz, err := keeper.NewQuerier(k)(ctx, path, abci.RequestQuery{})
+		require.NoError(t, err)
+		var res types.QueryResult
+		require.NoError(t, json.Unmarshal(bz, &res))
+		return res
+	}
+	res := query(types.QueryDataSourceVersionCode, "1", "1")
+	require.Equal(t, http.StatusOK, res.Status)
+	var code []byte
+	types.ModuleCdc.MustUnmarshalJSON(res.Result, &code)
+	require.Equal(t, k.MustGetDataSource(ctx, 1).Filename, hex.EncodeToString(tmhash.Sum(code)))
+	require.Equal(t, http.StatusBadRequest, query(types.QueryDataSourceVersionCode, "abc", "1").Status)
+	require.Equal(t, http.StatusBadRequest, query(types.QueryOracleScriptVersionCode, "1", "abc").Status)
+	require.Equal(t, http.StatusNotFound, query(types.QueryDataSourceVersionCode, "1", "42").Status)
+	require.Equal(t, http.StatusNotFound, query(types.QueryOracleScriptVersionCode, "42", "1").Status)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -33,6 +35,17 @@ func (k Keeper) AddReport(ctx sdk.Context, rid types.RequestID, rep types.Report
 		return sdkerrors.Wrapf(
 			types.ErrValidatorAlreadyReported, "reqID: %d, val: %s", rid, rep.Validator.String())
 	}
+	// Under commit-reveal, reports can only be added in the reveal phase by validators that have
+	// committed to them during the commit phase.
+	if commitBlockCount := k.GetParam(ctx, types.KeyCommitBlockCount); commitBlockCount > 0 {
+		if ctx.BlockHeight() < req.RequestHeight+int64(commitBlockCount) {
+			return sdkerrors.Wrapf(types.ErrCommitWindowOpen, "reqID: %d", rid)
+		}
+		if !k.HasReportCommit(ctx, rid, rep.Validator) {
+			return sdkerrors.Wrapf(
+				types.ErrReportCommitNotFound, "reqID: %d, val: %s", rid, rep.Validator.String())
+		}
+	}
 	if len(rep.RawReports) != len(req.RawRequests) {
 		return types.ErrInvalidReportSize
 	}
@@ -45,9 +58,24 @@ func (k Keeper) AddReport(ctx sdk.Context, rid types.RequestID, rep types.Report
 		}
 	}
 	k.SetReport(ctx, rid, rep)
+	k.RecordReport(ctx, rep)
 	return nil
 }
 
+// RevealReport checks that the given report matches the commit hash of its validator with the
+// given salt and adds the report to the store.
+func (k Keeper) RevealReport(ctx sdk.Context, rid types.RequestID, rep types.Report, salt []byte) error {
+	commit, err := k.GetReportCommit(ctx, rid, rep.Validator)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(commit.CommitHash, types.ReportCommitHash(rid, rep.Validator, rep.RawReports, salt)) {
+		return sdkerrors.Wrapf(
+			types.ErrReportCommitMismatch, "reqID: %d, val: %s", rid, rep.Validator.String())
+	}
+	return k.AddReport(ctx, rid, rep)
+}
+
 // GetReportIterator returns the iterator for all reports of the given request ID.
 func (k Keeper) GetReportIterator(ctx sdk.Context, rid types.RequestID) sdk.Iterator {
 	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReportStoreKey(rid))
@@ -87,3 +115,17 @@ func (k Keeper) DeleteReports(ctx sdk.Context, rid types.RequestID) {
 		ctx.KVStore(k.storeKey).Delete(key)
 	}
 }
+
+// IterateReports iterates through all reports of all requests in the store. Stops iterating
+// once the callback returns true.
+func (k Keeper) IterateReports(ctx sdk.Context, cb func(rid types.RequestID, rep types.Report) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReportStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rep types.Report
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rep)
+		if cb(types.RequestIDFromStoreKey(iterator.Key()), rep) {
+			break
+		}
+	}
+}
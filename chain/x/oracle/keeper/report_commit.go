@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// HasReportCommit checks if the report commit of this request and validator exists in the storage.
+func (k Keeper) HasReportCommit(ctx sdk.Context, rid types.RequestID, val sdk.ValAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ReportCommitOfValidatorKey(rid, val))
+}
+
+// GetReportCommit returns the report commit of the given request and validator or error if not exists.
+func (k Keeper) GetReportCommit(ctx sdk.Context, rid types.RequestID, val sdk.ValAddress) (types.ReportCommit, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ReportCommitOfValidatorKey(rid, val))
+	if bz == nil {
+		return types.ReportCommit{}, sdkerrors.Wrapf(
+			types.ErrReportCommitNotFound, "reqID: %d, val: %s", rid, val.String())
+	}
+	var commit types.ReportCommit
+	k.cdc.MustUnmarshalBinaryBare(bz, &commit)
+	return commit, nil
+}
+
+// SetReportCommit saves the report commit to the storage without performing validation.
+func (k Keeper) SetReportCommit(ctx sdk.Context, rid types.RequestID, commit types.ReportCommit) {
+	key := types.ReportCommitOfValidatorKey(rid, commit.Validator)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(commit))
+}
+
+// AddReportCommit performs sanity checks and adds a new report commit from one validator to one
+// request. Commits are only accepted within the commit window that starts at the request height.
+func (k Keeper) AddReportCommit(ctx sdk.Context, rid types.RequestID, commit types.ReportCommit) error {
+	req, err := k.GetRequest(ctx, rid)
+	if err != nil {
+		return err
+	}
+	if !ContainsVal(req.RequestedValidators, commit.Validator) {
+		return sdkerrors.Wrapf(
+			types.ErrValidatorNotRequested, "reqID: %d, val: %s", rid, commit.Validator.String())
+	}
+	commitBlockCount := int64(k.GetParam(ctx, types.KeyCommitBlockCount))
+	if ctx.BlockHeight() >= req.RequestHeight+commitBlockCount {
+		return sdkerrors.Wrapf(types.ErrCommitWindowClosed, "reqID: %d", rid)
+	}
+	if k.HasReportCommit(ctx, rid, commit.Validator) {
+		return sdkerrors.Wrapf(
+			types.ErrAlreadyCommitted, "reqID: %d, val: %s", rid, commit.Validator.String())
+	}
+	k.SetReportCommit(ctx, rid, commit)
+	return nil
+}
+
+// DeleteReportCommits removes all report commits for the given request ID.
+func (k Keeper) DeleteReportCommits(ctx sdk.Context, rid types.RequestID) {
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReportCommitStoreKey(rid))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		ctx.KVStore(k.storeKey).Delete(key)
+	}
+}
+
+// IterateReportCommits iterates through all report commits of all requests in the store. Stops
+// iterating once the callback returns true.
+func (k Keeper) IterateReportCommits(
+	ctx sdk.Context, cb func(rid types.RequestID, commit types.ReportCommit) (stop bool),
+) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReportCommitStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var commit types.ReportCommit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &commit)
+		if cb(types.RequestIDFromStoreKey(iterator.Key()), commit) {
+			break
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/testapp"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestAddReportCommit(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyCommitBlockCount, 5)
+	k.SetRequest(ctx, 1, defaultRequest())
+	commit := types.NewReportCommit(testapp.Validator1.ValAddress, make([]byte, 32))
+	ctx = ctx.WithBlockHeight(4)
+	require.NoError(t, k.AddReportCommit(ctx, 1, commit))
+	require.True(t, k.HasReportCommit(ctx, 1, testapp.Validator1.ValAddress))
+	got, err := k.GetReportCommit(ctx, 1, testapp.Validator1.ValAddress)
+	require.NoError(t, err)
+	require.Equal(t, commit, got)
+	// Cannot commit twice.
+	require.Error(t, k.AddReportCommit(ctx, 1, commit))
+	// Cannot commit for unrequested validators.
+	require.Error(t, k.AddReportCommit(ctx, 1, types.NewReportCommit(testapp.Alice.ValAddress, make([]byte, 32))))
+	// Cannot commit after the commit window closes.
+	ctx = ctx.WithBlockHeight(5)
+	require.Error(t, k.AddReportCommit(ctx, 1, types.NewReportCommit(testapp.Validator2.ValAddress, make([]byte, 32))))
+	// Commits are removed all at once.
+	k.DeleteReportCommits(ctx, 1)
+	require.False(t, k.HasReportCommit(ctx, 1, testapp.Validator1.ValAddress))
+}
+
+func TestRevealReport(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyCommitBlockCount, 5)
+	k.SetRequest(ctx, 1, defaultRequest())
+	rawReports := []types.RawReport{
+		types.NewRawReport(42, 0, []byte("data1/1")),
+		types.NewRawReport(43, 1, []byte("data2/1")),
+	}
+	rep := types.NewReport(testapp.Validator1.ValAddress, true, rawReports)
+	hash := types.ReportCommitHash(1, testapp.Validator1.ValAddress, rawReports, []byte("salt"))
+	// Cannot reveal without a commit.
+	ctx = ctx.WithBlockHeight(5)
+	require.Error(t, k.RevealReport(ctx, 1, rep, []byte("salt")))
+	k.SetReportCommit(ctx, 1, types.NewReportCommit(testapp.Validator1.ValAddress, hash))
+	// Cannot reveal before the commit window closes.
+	require.Error(t, k.RevealReport(ctx.WithBlockHeight(4), 1, rep, []byte("salt")))
+	// Cannot reveal with a wrong salt or different data.
+	require.Error(t, k.RevealReport(ctx, 1, rep, []byte("pepper")))
+	require.Error(t, k.RevealReport(ctx, 1, types.NewReport(
+		testapp.Validator1.ValAddress, true, []types.RawReport{rawReports[1], rawReports[0]},
+	), []byte("salt")))
+	require.NoError(t, k.RevealReport(ctx, 1, rep, []byte("salt")))
+	require.Equal(t, []types.Report{rep}, k.GetReports(ctx, 1))
+}
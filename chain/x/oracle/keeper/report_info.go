@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// GetReportInfo returns the report info of the given validator. Note that report info is
+// default to [0, 0], so new validators start with an empty window.
+func (k Keeper) GetReportInfo(ctx sdk.Context, val sdk.ValAddress) types.ReportInfo {
+	bz := ctx.KVStore(k.storeKey).Get(types.ReportInfoStoreKey(val))
+	if bz == nil {
+		return types.NewReportInfo(0, 0)
+	}
+	var info types.ReportInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info
+}
+
+// SetReportInfo sets the report info of the given validator.
+func (k Keeper) SetReportInfo(ctx sdk.Context, val sdk.ValAddress, info types.ReportInfo) {
+	ctx.KVStore(k.storeKey).Set(types.ReportInfoStoreKey(val), k.cdc.MustMarshalBinaryBare(info))
+}
+
+// deleteReportInfo removes the report info of the given validator, so that it defaults to [0, 0].
+func (k Keeper) deleteReportInfo(ctx sdk.Context, val sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.ReportInfoStoreKey(val))
+}
+
+// GetReportWindow returns the report window that the report infos in the store are tracked with.
+func (k Keeper) GetReportWindow(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ReportWindowStoreKey)
+	if bz == nil {
+		return 0
+	}
+	var window uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &window)
+	return window
+}
+
+// SetReportWindow sets the report window that the report infos in the store are tracked with.
+func (k Keeper) SetReportWindow(ctx sdk.Context, window uint64) {
+	ctx.KVStore(k.storeKey).Set(types.ReportWindowStoreKey, k.cdc.MustMarshalBinaryLengthPrefixed(window))
+}
+
+// GetMissedReportBitArray returns whether the validator missed the request at the given index
+// of its report window.
+func (k Keeper) GetMissedReportBitArray(ctx sdk.Context, val sdk.ValAddress, index int64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MissedReportBitArrayKey(val, index))
+}
+
+// SetMissedReportBitArray sets whether the validator missed the request at the given index of
+// its report window. Only missed entries are kept in the store.
+func (k Keeper) SetMissedReportBitArray(ctx sdk.Context, val sdk.ValAddress, index int64, missed bool) {
+	if missed {
+		ctx.KVStore(k.storeKey).Set(types.MissedReportBitArrayKey(val, index), []byte{1})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.MissedReportBitArrayKey(val, index))
+	}
+}
+
+// IterateMissedReports iterates through the indexes of all missed entries in the given
+// validator's report window. Stops iterating once the callback returns true.
+func (k Keeper) IterateMissedReports(ctx sdk.Context, val sdk.ValAddress, cb func(index int64) (stop bool)) {
+	prefix := types.MissedReportBitArrayPrefixKey(val)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(int64(binary.BigEndian.Uint64(iterator.Key()[len(prefix):]))) {
+			break
+		}
+	}
+}
+
+// clearMissedReportBitArray removes all missed entries of the given validator's report window.
+func (k Keeper) clearMissedReportBitArray(ctx sdk.Context, val sdk.ValAddress) {
+	var indexes []int64
+	k.IterateMissedReports(ctx, val, func(index int64) bool {
+		indexes = append(indexes, index)
+		return false
+	})
+	for _, index := range indexes {
+		k.SetMissedReportBitArray(ctx, val, index, false)
+	}
+}
+
+// resetReportInfos removes the report infos and missed report bit arrays of all validators.
+func (k Keeper) resetReportInfos(ctx sdk.Context) {
+	var vals []sdk.ValAddress
+	k.IterateReportInfos(ctx, func(val sdk.ValAddress, _ types.ReportInfo) bool {
+		vals = append(vals, val)
+		return false
+	})
+	for _, val := range vals {
+		k.deleteReportInfo(ctx, val)
+		k.clearMissedReportBitArray(ctx, val)
+	}
+}
+
+// IterateReportInfos iterates through the report infos of all validators in the store. Stops
+// iterating once the callback returns true.
+func (k Keeper) IterateReportInfos(ctx sdk.Context, cb func(val sdk.ValAddress, info types.ReportInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReportInfoStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ReportInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+		if cb(sdk.ValAddress(iterator.Key()[1:]), info) {
+			break
+		}
+	}
+}
+
+// HandleValidatorReport records whether the validator missed its report to an expired request
+// in its sliding report window, similar to how x/slashing tracks missed blocks. Once the window
+// is filled and the validator reports to less than MinReportPercentage of the requests in it,
+// the validator is slashed by MissedReportSlashPercentage and jailed for MissedReportJailDuration,
+// during which x/slashing rejects its unjail messages. A zero ReportWindow disables the tracking
+// altogether. If governance changes ReportWindow, the windows of all validators start over, since
+// their entries no longer map to the indexes of the new window.
+func (k Keeper) HandleValidatorReport(ctx sdk.Context, val sdk.ValAddress, missed bool) {
+	window := int64(k.GetParam(ctx, types.KeyReportWindow))
+	if window == 0 {
+		return
+	}
+	if uint64(window) != k.GetReportWindow(ctx) {
+		k.resetReportInfos(ctx)
+		k.SetReportWindow(ctx, uint64(window))
+	}
+	info := k.GetReportInfo(ctx, val)
+	index := info.IndexOffset % window
+	info.IndexOffset++
+	// Update the bit array and the counter only if the entry at this index actually changes.
+	previous := k.GetMissedReportBitArray(ctx, val, index)
+	switch {
+	case !previous && missed:
+		k.SetMissedReportBitArray(ctx, val, index, true)
+		info.MissedReportsCounter++
+	case previous && !missed:
+		k.SetMissedReportBitArray(ctx, val, index, false)
+		info.MissedReportsCounter--
+	}
+	minReported := window * int64(k.GetParam(ctx, types.KeyMinReportPercentage)) / 100
+	maxMissed := window - minReported
+	if info.IndexOffset >= window && info.MissedReportsCounter > maxMissed {
+		validator := k.stakingKeeper.Validator(ctx, val)
+		if validator != nil && !validator.IsJailed() {
+			consAddr := validator.GetConsAddr()
+			power := validator.GetConsensusPower()
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSlash,
+				sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+				sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+				sdk.NewAttribute(types.AttributeKeyMissedReports, fmt.Sprintf("%d", info.MissedReportsCounter)),
+				sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+			))
+			slashFraction := sdk.NewDecWithPrec(int64(k.GetParam(ctx, types.KeyMissedReportSlashPercentage)), 2)
+			k.stakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, slashFraction)
+			k.stakingKeeper.Jail(ctx, consAddr)
+			jailDuration := time.Duration(k.GetParam(ctx, types.KeyMissedReportJailDuration))
+			k.slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockHeader().Time.Add(jailDuration))
+			// Reset the window so that the validator is not slashed again right after unjailing.
+			// The empty report info is deleted rather than stored, as it encodes to empty bytes.
+			k.deleteReportInfo(ctx, val)
+			k.clearMissedReportBitArray(ctx, val)
+			return
+		}
+		k.Logger(ctx).Info(fmt.Sprintf(
+			"validator %s would have been slashed for missing reports, but was either not found or already jailed", val,
+		))
+	}
+	k.SetReportInfo(ctx, val, info)
+}
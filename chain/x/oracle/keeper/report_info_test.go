@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/testapp"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestHandleValidatorReportWindow(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyReportWindow, 4)
+	val := testapp.Validator1.ValAddress
+	// Initially the report info is empty.
+	require.Equal(t, types.NewReportInfo(0, 0), k.GetReportInfo(ctx, val))
+	// Miss, report, miss. The counter tracks only missed entries.
+	k.HandleValidatorReport(ctx, val, true)
+	k.HandleValidatorReport(ctx, val, false)
+	k.HandleValidatorReport(ctx, val, true)
+	require.Equal(t, types.NewReportInfo(3, 2), k.GetReportInfo(ctx, val))
+	require.True(t, k.GetMissedReportBitArray(ctx, val, 0))
+	require.False(t, k.GetMissedReportBitArray(ctx, val, 1))
+	require.True(t, k.GetMissedReportBitArray(ctx, val, 2))
+	// Reporting at index 4 wraps around and overwrites the miss at index 0.
+	k.HandleValidatorReport(ctx, val, false)
+	k.HandleValidatorReport(ctx, val, false)
+	require.Equal(t, types.NewReportInfo(5, 1), k.GetReportInfo(ctx, val))
+	require.False(t, k.GetMissedReportBitArray(ctx, val, 0))
+	// Nothing is tracked once the window is disabled.
+	k.SetParam(ctx, types.KeyReportWindow, 0)
+	k.HandleValidatorReport(ctx, val, true)
+	require.Equal(t, types.NewReportInfo(5, 1), k.GetReportInfo(ctx, val))
+}
+
+func TestHandleValidatorReportSlash(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyReportWindow, 10)
+	k.SetParam(ctx, types.KeyMinReportPercentage, 50)
+	k.SetParam(ctx, types.KeyMissedReportSlashPercentage, 10)
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	val := testapp.Validator1.ValAddress
+	tokens := app.StakingKeeper.Validator(ctx, val).GetTokens()
+	// Missing 6 out of 10 reports is one too many, but nothing happens before the window fills.
+	for i := 0; i < 6; i++ {
+		k.HandleValidatorReport(ctx, val, true)
+	}
+	for i := 0; i < 3; i++ {
+		k.HandleValidatorReport(ctx, val, false)
+	}
+	require.False(t, app.StakingKeeper.Validator(ctx, val).IsJailed())
+	require.Equal(t, sdk.Events{}, ctx.EventManager().Events())
+	// The 10th request fills the window, so the validator gets slashed and jailed.
+	k.HandleValidatorReport(ctx, val, false)
+	validator := app.StakingKeeper.Validator(ctx, val)
+	require.True(t, validator.IsJailed())
+	require.True(t, validator.GetTokens().LT(tokens))
+	// Slashing burns tokens, which may emit events of its own after the slash event.
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSlash,
+		sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+		sdk.NewAttribute(types.AttributeKeyPower, "100"),
+		sdk.NewAttribute(types.AttributeKeyMissedReports, "6"),
+		sdk.NewAttribute(types.AttributeKeyJailed, validator.GetConsAddr().String()),
+	))
+	// The window is reset after slashing.
+	require.Equal(t, types.NewReportInfo(0, 0), k.GetReportInfo(ctx, val))
+	require.False(t, k.GetMissedReportBitArray(ctx, val, 0))
+}
+
+func TestHandleValidatorReportJailDuration(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyReportWindow, 2)
+	k.SetParam(ctx, types.KeyMinReportPercentage, 50)
+	k.SetParam(ctx, types.KeyMissedReportJailDuration, uint64(time.Hour))
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(time.Unix(1581589790, 0))
+	val := testapp.Validator1.ValAddress
+	k.HandleValidatorReport(ctx, val, true)
+	k.HandleValidatorReport(ctx, val, true)
+	require.True(t, app.StakingKeeper.Validator(ctx, val).IsJailed())
+	// The validator cannot unjail itself before the jail duration passes.
+	ctx = ctx.WithBlockHeight(21).WithBlockTime(time.Unix(1581589795, 0))
+	err := app.SlashingKeeper.Unjail(ctx, val)
+	require.True(t, slashing.ErrValidatorJailed.Is(err))
+	require.True(t, app.StakingKeeper.Validator(ctx, val).IsJailed())
+	// Once the jail duration passes, unjailing works.
+	ctx = ctx.WithBlockHeight(22).WithBlockTime(time.Unix(1581589790, 0).Add(time.Hour))
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, val))
+	require.False(t, app.StakingKeeper.Validator(ctx, val).IsJailed())
+}
+
+func TestHandleValidatorReportWindowChange(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyReportWindow, 4)
+	k.SetParam(ctx, types.KeyMinReportPercentage, 0)
+	val := testapp.Validator1.ValAddress
+	for i := 0; i < 4; i++ {
+		k.HandleValidatorReport(ctx, val, true)
+	}
+	require.Equal(t, types.NewReportInfo(4, 4), k.GetReportInfo(ctx, val))
+	require.True(t, k.GetMissedReportBitArray(ctx, val, 3))
+	// Shrinking the window starts it over, so misses beyond the new window are not counted.
+	k.SetParam(ctx, types.KeyReportWindow, 2)
+	k.HandleValidatorReport(ctx, val, false)
+	require.Equal(t, types.NewReportInfo(1, 0), k.GetReportInfo(ctx, val))
+	require.False(t, k.GetMissedReportBitArray(ctx, val, 3))
+	require.Equal(t, uint64(2), k.GetReportWindow(ctx))
+}
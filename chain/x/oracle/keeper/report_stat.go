@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// GetValidatorReportStat returns the reports delivered by the given validator since the last
+// oracle reward allocation. Note that report stat is default to [0, 0].
+func (k Keeper) GetValidatorReportStat(ctx sdk.Context, val sdk.ValAddress) types.ValidatorReportStat {
+	bz := ctx.KVStore(k.storeKey).Get(types.ValidatorReportStatStoreKey(val))
+	if bz == nil {
+		return types.NewValidatorReportStat(0, 0)
+	}
+	var stat types.ValidatorReportStat
+	k.cdc.MustUnmarshalBinaryBare(bz, &stat)
+	return stat
+}
+
+// SetValidatorReportStat sets the report stat of the given validator.
+func (k Keeper) SetValidatorReportStat(ctx sdk.Context, val sdk.ValAddress, stat types.ValidatorReportStat) {
+	ctx.KVStore(k.storeKey).Set(types.ValidatorReportStatStoreKey(val), k.cdc.MustMarshalBinaryBare(stat))
+}
+
+// RecordReport increments the report stat of the given report's validator.
+func (k Keeper) RecordReport(ctx sdk.Context, rep types.Report) {
+	stat := k.GetValidatorReportStat(ctx, rep.Validator)
+	stat.ReportCount++
+	if rep.InBeforeResolve {
+		stat.InBeforeResolveCount++
+	}
+	k.SetValidatorReportStat(ctx, rep.Validator, stat)
+}
+
+// IterateValidatorReportStats iterates through the report stats of all validators in the store.
+// Stops iterating once the callback returns true.
+func (k Keeper) IterateValidatorReportStats(
+	ctx sdk.Context, cb func(val sdk.ValAddress, stat types.ValidatorReportStat) (stop bool),
+) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorReportStatStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stat types.ValidatorReportStat
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stat)
+		if cb(sdk.ValAddress(iterator.Key()[1:]), stat) {
+			break
+		}
+	}
+}
+
+// clearValidatorReportStats removes the report stats of all validators, starting a new period.
+func (k Keeper) clearValidatorReportStats(ctx sdk.Context) {
+	var vals []sdk.ValAddress
+	k.IterateValidatorReportStats(ctx, func(val sdk.ValAddress, _ types.ValidatorReportStat) bool {
+		vals = append(vals, val)
+		return false
+	})
+	for _, val := range vals {
+		ctx.KVStore(k.storeKey).Delete(types.ValidatorReportStatStoreKey(val))
+	}
+}
@@ -16,9 +16,10 @@ func defaultRequest() types.Request {
 		[]sdk.ValAddress{testapp.Validator1.ValAddress, testapp.Validator2.ValAddress},
 		2, 0, testapp.ParseTime(0),
 		BasicClientID, []types.RawRequest{
-			types.NewRawRequest(42, 1, BasicCalldata),
-			types.NewRawRequest(43, 2, BasicCalldata),
+			types.NewRawRequest(42, 1, BasicCalldata, 0),
+			types.NewRawRequest(43, 2, BasicCalldata, 0),
 		},
+		0,
 	)
 }
 
@@ -47,6 +48,8 @@ func TestAddReportSuccess(t *testing.T) {
 			types.NewRawReport(43, 1, []byte("data2/1")),
 		}),
 	}, k.GetReports(ctx, 1))
+	// The report is counted towards the validator's report stat.
+	require.Equal(t, types.NewValidatorReportStat(1, 1), k.GetValidatorReportStat(ctx, testapp.Validator1.ValAddress))
 }
 
 func TestReportOnNonExistingRequest(t *testing.T) {
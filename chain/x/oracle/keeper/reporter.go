@@ -51,3 +51,18 @@ func (k Keeper) GetReporters(ctx sdk.Context, val sdk.ValAddress) (reporters []s
 	}
 	return reporters
 }
+
+// IterateReporters iterates through all validator-reporter pairs in the store, excluding the
+// implicit self reporters. Stops iterating once the callback returns true.
+func (k Keeper) IterateReporters(ctx sdk.Context, cb func(val sdk.ValAddress, addr sdk.AccAddress) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReporterStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		val := sdk.ValAddress(key[1 : 1+sdk.AddrLen])
+		addr := sdk.AccAddress(key[1+sdk.AddrLen:])
+		if cb(val, addr) {
+			break
+		}
+	}
+}
@@ -16,6 +16,9 @@ func (k Keeper) HasRequest(ctx sdk.Context, id types.RequestID) bool {
 func (k Keeper) GetRequest(ctx sdk.Context, id types.RequestID) (types.Request, error) {
 	bz := ctx.KVStore(k.storeKey).Get(types.RequestStoreKey(id))
 	if bz == nil {
+		if id > 0 && id <= k.GetRequestLastPruned(ctx) {
+			return types.Request{}, sdkerrors.Wrapf(types.ErrRequestPruned, "id: %d", id)
+		}
 		return types.Request{}, sdkerrors.Wrapf(types.ErrRequestNotFound, "id: %d", id)
 	}
 	var request types.Request
@@ -46,6 +49,7 @@ func (k Keeper) DeleteRequest(ctx sdk.Context, id types.RequestID) {
 func (k Keeper) AddRequest(ctx sdk.Context, req types.Request) types.RequestID {
 	id := k.GetNextRequestID(ctx)
 	k.SetRequest(ctx, id, req)
+	k.IndexRequest(ctx, id, req)
 	return id
 }
 
@@ -68,17 +72,54 @@ func (k Keeper) ProcessExpiredRequests(ctx sdk.Context) {
 		if !k.HasResult(ctx, currentReqID) {
 			k.ResolveExpired(ctx, currentReqID)
 		}
-		// Deactivate all validators that do not report to this request.
+		// Deactivate all validators that do not report to this request. Under commit-reveal, a
+		// validator that committed but never revealed its report did not report either.
 		for _, val := range req.RequestedValidators {
-			if !k.HasReport(ctx, currentReqID, val) {
+			missed := !k.HasReport(ctx, currentReqID, val)
+			if missed {
 				k.MissReport(ctx, val, req.RequestTime)
 			}
+			// Track the report in the validator's sliding window, which may lead to slashing.
+			k.HandleValidatorReport(ctx, val, missed)
 		}
+		// Commits are no longer needed once a request expires, revealed or not.
+		k.DeleteReportCommits(ctx, currentReqID)
 		// Set last expired request ID to be this current request.
 		k.SetRequestLastExpired(ctx, currentReqID)
 	}
 }
 
+// PruneRequests removes requests, reports, and optionally results that are older than the
+// retention window from the store. At most MaxPruneCountPerBlock requests are pruned per call.
+func (k Keeper) PruneRequests(ctx sdk.Context) {
+	retentionBlockCount := int64(k.GetParam(ctx, types.KeyRequestRetentionBlockCount))
+	if retentionBlockCount == 0 { // Pruning is disabled.
+		return
+	}
+	maxPruneCount := k.GetParam(ctx, types.KeyMaxPruneCountPerBlock)
+	pruneResult := k.GetParamBool(ctx, types.KeyPruneResult)
+	currentReqID := k.GetRequestLastPruned(ctx) + 1
+	lastReqID := k.GetRequestLastExpired(ctx)
+	// Loop through expired requests in chronological order. Only requests that are already
+	// expired can be pruned, as otherwise validators may still need to report on them.
+	for count := uint64(0); currentReqID <= lastReqID && count < maxPruneCount; count++ {
+		req := k.MustGetRequest(ctx, currentReqID)
+		// This request is still within the retention window, and so are all the requests
+		// that come after it. Thus we can just break the loop.
+		if req.RequestHeight+retentionBlockCount > ctx.BlockHeight() {
+			break
+		}
+		k.UnindexRequest(ctx, currentReqID, req)
+		k.DeleteRequest(ctx, currentReqID)
+		k.DeleteReports(ctx, currentReqID)
+		if pruneResult {
+			k.DeleteResult(ctx, currentReqID)
+		}
+		k.SetRequestLastPruned(ctx, currentReqID)
+		currentReqID++
+	}
+}
+
 // AddPendingRequest adds the request to the pending list. DO NOT add same request more than once.
 func (k Keeper) AddPendingRequest(ctx sdk.Context, id types.RequestID) {
 	pendingList := k.GetPendingResolveList(ctx)
@@ -104,3 +145,17 @@ func (k Keeper) GetPendingResolveList(ctx sdk.Context) (ids []types.RequestID) {
 	k.cdc.MustUnmarshalBinaryBare(bz, &ids)
 	return ids
 }
+
+// IterateRequests iterates through all requests in the store in ascending ID order.
+// Stops iterating once the callback returns true.
+func (k Keeper) IterateRequests(ctx sdk.Context, cb func(id types.RequestID, req types.Request) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RequestStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var req types.Request
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &req)
+		if cb(types.RequestIDFromStoreKey(iterator.Key()), req) {
+			break
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// IndexRequest adds the given request to the oracle script, client ID, specification, and resolve
+// status indexes. The resolve status is taken from the request's result, or Open if it has none.
+func (k Keeper) IndexRequest(ctx sdk.Context, id types.RequestID, req types.Request) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RequestsByOracleScriptKey(req.OracleScriptID, id), []byte{1})
+	store.Set(requestsBySpecKey(id, req), []byte{1})
+	store.Set(types.RequestsByClientIDKey(req.ClientID, id), []byte{1})
+	status := types.ResolveStatus_Open
+	if result, err := k.GetResult(ctx, id); err == nil {
+		status = result.ResponsePacketData.ResolveStatus
+	}
+	store.Set(types.RequestsByResolveStatusKey(status, id), []byte{1})
+}
+
+// UnindexRequest removes the given request from all request indexes.
+func (k Keeper) UnindexRequest(ctx sdk.Context, id types.RequestID, req types.Request) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RequestsByOracleScriptKey(req.OracleScriptID, id))
+	store.Delete(requestsBySpecKey(id, req))
+	store.Delete(types.RequestsByClientIDKey(req.ClientID, id))
+	for status := range types.ResolveStatus_name {
+		store.Delete(types.RequestsByResolveStatusKey(types.ResolveStatus(status), id))
+	}
+}
+
+// requestsBySpecKey returns the specification index key of the given request.
+func requestsBySpecKey(id types.RequestID, req types.Request) []byte {
+	return types.RequestsBySpecKey(
+		req.OracleScriptID, req.Calldata, uint64(len(req.RequestedValidators)), req.MinCount, id,
+	)
+}
+
+// setRequestResolveStatusIndex moves the given request from the Open status index to the index
+// of the given resolve status.
+func (k Keeper) setRequestResolveStatusIndex(ctx sdk.Context, id types.RequestID, status types.ResolveStatus) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RequestsByResolveStatusKey(types.ResolveStatus_Open, id))
+	store.Set(types.RequestsByResolveStatusKey(status, id), []byte{1})
+}
+
+// iterateRequestIndex iterates through the request IDs under the given index prefix from the
+// latest to the oldest request. Stops iterating once the callback returns true.
+func (k Keeper) iterateRequestIndex(ctx sdk.Context, prefix []byte, cb func(id types.RequestID) (stop bool)) {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.RequestIDFromIndexKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// getRequestIndexPage returns the given page of request IDs under the given index prefix, from
+// the latest to the oldest request. Page numbers start at 1.
+func (k Keeper) getRequestIndexPage(ctx sdk.Context, prefix []byte, page, limit int) []types.RequestID {
+	ids := []types.RequestID{}
+	skip := (page - 1) * limit
+	k.iterateRequestIndex(ctx, prefix, func(id types.RequestID) bool {
+		if skip > 0 {
+			skip--
+			return false
+		}
+		ids = append(ids, id)
+		return len(ids) >= limit
+	})
+	return ids
+}
+
+// IterateRequestIDsByOracleScript iterates through the IDs of requests to the given oracle
+// script from the latest to the oldest. Stops iterating once the callback returns true.
+func (k Keeper) IterateRequestIDsByOracleScript(
+	ctx sdk.Context, oid types.OracleScriptID, cb func(id types.RequestID) (stop bool),
+) {
+	k.iterateRequestIndex(ctx, types.RequestsByOracleScriptPrefixKey(oid), cb)
+}
+
+// IterateRequestIDsBySpec iterates through the IDs of requests to the given oracle script with
+// the given calldata, ask count, and min count, from the latest to the oldest. Stops iterating
+// once the callback returns true.
+func (k Keeper) IterateRequestIDsBySpec(
+	ctx sdk.Context, oid types.OracleScriptID, calldata []byte, askCount, minCount uint64,
+	cb func(id types.RequestID) (stop bool),
+) {
+	k.iterateRequestIndex(ctx, types.RequestsBySpecPrefixKey(oid, calldata, askCount, minCount), cb)
+}
+
+// GetRequestIDsByOracleScript returns the given page of IDs of requests to the given oracle
+// script, from the latest to the oldest.
+func (k Keeper) GetRequestIDsByOracleScript(
+	ctx sdk.Context, oid types.OracleScriptID, page, limit int,
+) []types.RequestID {
+	return k.getRequestIndexPage(ctx, types.RequestsByOracleScriptPrefixKey(oid), page, limit)
+}
+
+// GetRequestIDsByClientID returns the given page of IDs of requests with the given client ID,
+// from the latest to the oldest.
+func (k Keeper) GetRequestIDsByClientID(ctx sdk.Context, clientID string, page, limit int) []types.RequestID {
+	return k.getRequestIndexPage(ctx, types.RequestsByClientIDPrefixKey(clientID), page, limit)
+}
+
+// GetRequestIDsByResolveStatus returns the given page of IDs of requests with the given resolve
+// status, from the latest to the oldest.
+func (k Keeper) GetRequestIDsByResolveStatus(
+	ctx sdk.Context, status types.ResolveStatus, page, limit int,
+) []types.RequestID {
+	return k.getRequestIndexPage(ctx, types.RequestsByResolveStatusPrefixKey(status), page, limit)
+}
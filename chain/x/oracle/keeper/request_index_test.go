@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/testapp"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestRequestIndexes(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// Add five requests, alternating between two oracle scripts and two client IDs.
+	for i := 0; i < 5; i++ {
+		req := defaultRequest()
+		req.OracleScriptID = types.OracleScriptID(i%2 + 1)
+		if i%2 == 1 {
+			req.ClientID = "other"
+		}
+		k.AddRequest(ctx, req)
+	}
+	require.Equal(t, []types.RequestID{5, 3, 1}, k.GetRequestIDsByOracleScript(ctx, 1, 1, 10))
+	require.Equal(t, []types.RequestID{4, 2}, k.GetRequestIDsByOracleScript(ctx, 2, 1, 10))
+	require.Equal(t, []types.RequestID{}, k.GetRequestIDsByOracleScript(ctx, 3, 1, 10))
+	require.Equal(t, []types.RequestID{5, 3, 1}, k.GetRequestIDsByClientID(ctx, BasicClientID, 1, 10))
+	require.Equal(t, []types.RequestID{4, 2}, k.GetRequestIDsByClientID(ctx, "other", 1, 10))
+	require.Equal(t, []types.RequestID{5, 4, 3, 2, 1}, k.GetRequestIDsByResolveStatus(ctx, types.ResolveStatus_Open, 1, 10))
+	// Resolving requests moves them out of the open status index.
+	k.ResolveSuccess(ctx, 2, BasicResult)
+	k.ResolveExpired(ctx, 3)
+	require.Equal(t, []types.RequestID{5, 4, 1}, k.GetRequestIDsByResolveStatus(ctx, types.ResolveStatus_Open, 1, 10))
+	require.Equal(t, []types.RequestID{2}, k.GetRequestIDsByResolveStatus(ctx, types.ResolveStatus_Success, 1, 10))
+	require.Equal(t, []types.RequestID{3}, k.GetRequestIDsByResolveStatus(ctx, types.ResolveStatus_Expired, 1, 10))
+	require.Equal(t, []types.RequestID{}, k.GetRequestIDsByResolveStatus(ctx, types.ResolveStatus_Failure, 1, 10))
+}
+
+func TestRequestIndexPagination(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	for i := 0; i < 5; i++ {
+		k.AddRequest(ctx, defaultRequest())
+	}
+	require.Equal(t, []types.RequestID{5, 4}, k.GetRequestIDsByOracleScript(ctx, 1, 1, 2))
+	require.Equal(t, []types.RequestID{3, 2}, k.GetRequestIDsByOracleScript(ctx, 1, 2, 2))
+	require.Equal(t, []types.RequestID{1}, k.GetRequestIDsByOracleScript(ctx, 1, 3, 2))
+	require.Equal(t, []types.RequestID{}, k.GetRequestIDsByOracleScript(ctx, 1, 4, 2))
+}
+
+func TestIterateRequestIDsByOracleScript(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	for i := 0; i < 3; i++ {
+		k.AddRequest(ctx, defaultRequest())
+	}
+	// Iteration goes from the latest request and stops once the callback returns true.
+	var ids []types.RequestID
+	k.IterateRequestIDsByOracleScript(ctx, 1, func(id types.RequestID) bool {
+		ids = append(ids, id)
+		return id == 2
+	})
+	require.Equal(t, []types.RequestID{3, 2}, ids)
+}
+
+func TestIterateRequestIDsBySpec(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	k.AddRequest(ctx, defaultRequest())
+	// Requests with a different calldata, ask count, or min count are not in the same index.
+	req := defaultRequest()
+	req.Calldata = []byte("other")
+	k.AddRequest(ctx, req)
+	req = defaultRequest()
+	req.RequestedValidators = req.RequestedValidators[:1]
+	k.AddRequest(ctx, req)
+	req = defaultRequest()
+	req.MinCount = 1
+	k.AddRequest(ctx, req)
+	k.AddRequest(ctx, defaultRequest())
+	var ids []types.RequestID
+	k.IterateRequestIDsBySpec(ctx, 1, BasicCalldata, 2, 2, func(id types.RequestID) bool {
+		ids = append(ids, id)
+		return false
+	})
+	require.Equal(t, []types.RequestID{5, 1}, ids)
+	// Removed requests are removed from the index.
+	k.UnindexRequest(ctx, 5, defaultRequest())
+	ids = nil
+	k.IterateRequestIDsBySpec(ctx, 1, BasicCalldata, 2, 2, func(id types.RequestID) bool {
+		ids = append(ids, id)
+		return false
+	})
+	require.Equal(t, []types.RequestID{1}, ids)
+}
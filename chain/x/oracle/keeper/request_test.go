@@ -15,14 +15,14 @@ func TestHasRequest(t *testing.T) {
 	// We should not have a request ID 42 without setting it.
 	require.False(t, k.HasRequest(ctx, 42))
 	// After we set it, we should be able to find it.
-	k.SetRequest(ctx, 42, types.NewRequest(1, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil))
+	k.SetRequest(ctx, 42, types.NewRequest(1, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil, 0))
 	require.True(t, k.HasRequest(ctx, 42))
 }
 
 func TestDeleteRequest(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(true)
 	// After we set it, we should be able to find it.
-	k.SetRequest(ctx, 42, types.NewRequest(1, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil))
+	k.SetRequest(ctx, 42, types.NewRequest(1, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil, 0))
 	require.True(t, k.HasRequest(ctx, 42))
 	// After we delete it, we should not find it anymore.
 	k.DeleteRequest(ctx, 42)
@@ -39,8 +39,8 @@ func TestSetterGetterRequest(t *testing.T) {
 	require.Error(t, err)
 	require.Panics(t, func() { _ = k.MustGetRequest(ctx, 42) })
 	// Creates some basic requests.
-	req1 := types.NewRequest(1, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil)
-	req2 := types.NewRequest(2, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil)
+	req1 := types.NewRequest(1, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil, 0)
+	req2 := types.NewRequest(2, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil, 0)
 	// Sets id 42 with request 1 and id 42 with request 2.
 	k.SetRequest(ctx, 42, req1)
 	k.SetRequest(ctx, 43, req2)
@@ -81,10 +81,10 @@ func TestAddDataSourceBasic(t *testing.T) {
 		testapp.Owner.Address, BasicName, BasicDesc, BasicFilename, BasicSchema, BasicSourceCodeURL,
 	))
 	// Adding the first request should return ID 1.
-	id := k.AddRequest(ctx, types.NewRequest(42, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil))
+	id := k.AddRequest(ctx, types.NewRequest(42, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil, 0))
 	require.Equal(t, id, types.RequestID(1))
 	// Adding another request should return ID 2.
-	id = k.AddRequest(ctx, types.NewRequest(42, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil))
+	id = k.AddRequest(ctx, types.NewRequest(42, BasicCalldata, nil, 1, 1, testapp.ParseTime(0), "", nil, 0))
 	require.Equal(t, id, types.RequestID(2))
 }
 
@@ -127,6 +127,8 @@ func TestProcessExpiredRequests(t *testing.T) {
 	k.AddReport(ctx, 1, types.NewReport(testapp.Validator2.ValAddress, true, rawReports))
 	k.AddReport(ctx, 2, types.NewReport(testapp.Validator2.ValAddress, true, rawReports))
 	k.AddReport(ctx, 4, types.NewReport(testapp.Validator2.ValAddress, true, rawReports))
+	// Validator 2 committed to request#3 but never revealed.
+	k.SetReportCommit(ctx, 3, types.NewReportCommit(testapp.Validator2.ValAddress, make([]byte, 32)))
 	// Request 1, 2 and 4 gets resolved. Request 3 does not.
 	k.ResolveSuccess(ctx, 1, BasicResult)
 	k.ResolveFailure(ctx, 2, "ARBITRARY_REASON")
@@ -161,6 +163,7 @@ func TestProcessExpiredRequests(t *testing.T) {
 	require.Equal(t, types.RequestID(3), k.GetRequestLastExpired(ctx))
 	require.True(t, k.GetValidatorStatus(ctx, testapp.Validator1.ValAddress).IsActive)
 	require.False(t, k.GetValidatorStatus(ctx, testapp.Validator2.ValAddress).IsActive)
+	require.False(t, k.HasReportCommit(ctx, 3, testapp.Validator2.ValAddress))
 	require.Equal(t, types.NewOracleResponsePacketData(
 		BasicClientID, 3, 1, req3.RequestTime.Unix(), testapp.ParseTime(9000).Unix(),
 		types.ResolveStatus_Expired, []byte{},
@@ -176,3 +179,52 @@ func TestProcessExpiredRequests(t *testing.T) {
 	require.Equal(t, sdk.Events{}, ctx.EventManager().Events())
 	require.Equal(t, types.RequestID(4), k.GetRequestLastExpired(ctx))
 }
+
+func TestPruneRequests(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyRequestRetentionBlockCount, 10)
+	k.SetParam(ctx, types.KeyMaxPruneCountPerBlock, 2)
+	// Set some initial requests at block 5, 6, 6, and 10. All of them are resolved and expired.
+	for _, height := range []int64{5, 6, 6, 10} {
+		req := defaultRequest()
+		req.RequestHeight = height
+		id := k.AddRequest(ctx, req)
+		k.SetReport(ctx, id, types.NewReport(testapp.Validator1.ValAddress, true, nil))
+		k.ResolveSuccess(ctx, id, BasicResult)
+	}
+	k.SetRequestLastExpired(ctx, 4)
+	// At block 14, nothing should be pruned as all requests are within the retention window.
+	k.PruneRequests(ctx.WithBlockHeight(14))
+	require.Equal(t, types.RequestID(0), k.GetRequestLastPruned(ctx))
+	require.True(t, k.HasRequest(ctx, 1))
+	// At block 16, all of request#1,2,3 are prunable, but only two get pruned in one call.
+	k.PruneRequests(ctx.WithBlockHeight(16))
+	require.Equal(t, types.RequestID(2), k.GetRequestLastPruned(ctx))
+	require.False(t, k.HasRequest(ctx, 1))
+	require.False(t, k.HasRequest(ctx, 2))
+	require.True(t, k.HasRequest(ctx, 3))
+	require.Equal(t, uint64(0), k.GetReportCount(ctx, 1))
+	// Pruned requests are removed from the request indexes.
+	require.Equal(t, []types.RequestID{4, 3}, k.GetRequestIDsByOracleScript(ctx, 1, 1, 10))
+	require.Equal(t, []types.RequestID{4, 3}, k.GetRequestIDsByResolveStatus(ctx, types.ResolveStatus_Success, 1, 10))
+	// Results are kept by default.
+	require.True(t, k.HasResult(ctx, 1))
+	// Querying pruned requests should return pruned error, while unknown IDs remain not found.
+	_, err := k.GetRequest(ctx, 1)
+	require.True(t, types.ErrRequestPruned.Is(err))
+	_, err = k.GetRequest(ctx, 42)
+	require.True(t, types.ErrRequestNotFound.Is(err))
+	// With result pruning enabled, request#3 gets pruned along with its result.
+	k.SetParamBool(ctx, types.KeyPruneResult, true)
+	k.PruneRequests(ctx.WithBlockHeight(16))
+	require.Equal(t, types.RequestID(3), k.GetRequestLastPruned(ctx))
+	require.False(t, k.HasRequest(ctx, 3))
+	require.False(t, k.HasResult(ctx, 3))
+	_, err = k.GetResult(ctx, 3)
+	require.True(t, types.ErrRequestPruned.Is(err))
+	// Request#4 cannot be pruned even when passing the window as it is not yet expired.
+	k.SetRequestLastExpired(ctx, 3)
+	k.PruneRequests(ctx.WithBlockHeight(100))
+	require.Equal(t, types.RequestID(3), k.GetRequestLastPruned(ctx))
+	require.True(t, k.HasRequest(ctx, 4))
+}
@@ -22,10 +22,18 @@ func (k Keeper) SetResult(ctx sdk.Context, reqID types.RequestID, result types.R
 	store.Set(types.ResultStoreKey(reqID), obi.MustEncode(result))
 }
 
+// DeleteResult removes the result of the given request ID from the store.
+func (k Keeper) DeleteResult(ctx sdk.Context, id types.RequestID) {
+	ctx.KVStore(k.storeKey).Delete(types.ResultStoreKey(id))
+}
+
 // GetResult returns the result for the given request ID or error if not exists.
 func (k Keeper) GetResult(ctx sdk.Context, id types.RequestID) (types.Result, error) {
 	bz := ctx.KVStore(k.storeKey).Get(types.ResultStoreKey(id))
 	if bz == nil {
+		if id > 0 && id <= k.GetRequestLastPruned(ctx) {
+			return types.Result{}, sdkerrors.Wrapf(types.ErrRequestPruned, "id: %d", id)
+		}
 		return types.Result{}, sdkerrors.Wrapf(types.ErrResultNotFound, "id: %d", id)
 	}
 	var result types.Result
@@ -96,4 +104,19 @@ func (k Keeper) SaveResult(
 		result,                    // Result
 	)
 	k.SetResult(ctx, id, types.NewResult(reqPacket, resPacket))
+	k.setRequestResolveStatusIndex(ctx, id, status)
+}
+
+// IterateResults iterates through all results in the store in ascending request ID order.
+// Stops iterating once the callback returns true.
+func (k Keeper) IterateResults(ctx sdk.Context, cb func(id types.RequestID, result types.Result) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ResultStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.Result
+		obi.MustDecode(iterator.Value(), &result)
+		if cb(types.RequestIDFromStoreKey(iterator.Key()), result) {
+			break
+		}
+	}
 }
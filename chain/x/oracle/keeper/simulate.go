@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// simulatedValidator returns the placeholder address of the validator at the given index of a
+// simulated request.
+func simulatedValidator(idx int) sdk.ValAddress {
+	return sdk.ValAddress(sdk.Uint64ToBigEndian(uint64(idx)))
+}
+
+// Simulate runs Owasm prepare function of the given oracle script with the given calldata against
+// the current state, then runs Owasm execute function with the given mock reports if there are
+// any. Nothing is written to the store. Returns error if the simulated request is invalid or the
+// prepare function fails. Errors from the execute function are reported in the result instead.
+func (k Keeper) Simulate(ctx sdk.Context, params types.QuerySimulateParams) (types.QuerySimulateResult, error) {
+	if len(params.Calldata) > types.MaxDataSize {
+		return types.QuerySimulateResult{}, types.WrapMaxError(types.ErrTooLargeCalldata, len(params.Calldata), types.MaxDataSize)
+	}
+	if params.MinCount <= 0 {
+		return types.QuerySimulateResult{}, sdkerrors.Wrapf(types.ErrInvalidMinCount, "got: %d", params.MinCount)
+	}
+	if params.AskCount < params.MinCount {
+		return types.QuerySimulateResult{}, sdkerrors.Wrapf(
+			types.ErrInvalidAskCount, "got: %d, min count: %d", params.AskCount, params.MinCount)
+	}
+	if params.AskCount > k.GetParam(ctx, types.KeyMaxAskCount) {
+		return types.QuerySimulateResult{}, sdkerrors.Wrapf(
+			types.ErrInvalidAskCount, "got: %d, max: %d", params.AskCount, k.GetParam(ctx, types.KeyMaxAskCount))
+	}
+	if uint64(len(params.Reports)) > params.AskCount {
+		return types.QuerySimulateResult{}, sdkerrors.Wrapf(
+			types.ErrInvalidReportSize, "got: %d, ask count: %d", len(params.Reports), params.AskCount)
+	}
+	validators := make([]sdk.ValAddress, params.AskCount)
+	for idx := range validators {
+		validators[idx] = simulatedValidator(idx)
+	}
+	req := types.NewRequest(
+		params.OracleScriptID, params.Calldata, validators, params.MinCount,
+		ctx.BlockHeight(), ctx.BlockTime(), "", nil,
+		k.GetOracleScriptVersionCount(ctx, params.OracleScriptID),
+	)
+	rawRequests, err := k.prepareRawRequests(ctx, req)
+	if err != nil {
+		return types.QuerySimulateResult{}, err
+	}
+	for _, rawReq := range rawRequests {
+		if !k.HasDataSource(ctx, rawReq.DataSourceID) {
+			return types.QuerySimulateResult{}, sdkerrors.Wrapf(types.ErrDataSourceNotFound, "id: %d", rawReq.DataSourceID)
+		}
+	}
+	simulated := types.QuerySimulateResult{RawRequests: rawRequests}
+	if params.Reports == nil {
+		return simulated, nil
+	}
+	req.RawRequests = rawRequests
+	reports := make([]types.Report, len(params.Reports))
+	for idx, rawReports := range params.Reports {
+		reports[idx] = types.NewReport(simulatedValidator(idx), true, rawReports)
+	}
+	simulated.Result, err = k.executeRequest(ctx, req, reports)
+	if err != nil {
+		simulated.Error = err.Error()
+	}
+	return simulated, nil
+}
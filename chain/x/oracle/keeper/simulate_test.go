@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/testapp"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestSimulatePrepareOnly(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// OracleScript#1: Prepare asks for DS#1,2,3 with ExtID#1,2,3 and calldata "beeb"
+	res, err := k.Simulate(ctx, types.QuerySimulateParams{
+		OracleScriptID: 1, Calldata: BasicCalldata, AskCount: 2, MinCount: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.QuerySimulateResult{
+		RawRequests: []types.RawRequest{
+			types.NewRawRequest(1, 1, []byte("beeb"), 1),
+			types.NewRawRequest(2, 2, []byte("beeb"), 1),
+			types.NewRawRequest(3, 3, []byte("beeb"), 1),
+		},
+	}, res)
+	// Nothing should be written to the store.
+	require.Equal(t, int64(0), k.GetRequestCount(ctx))
+}
+
+func TestSimulateExecute(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// OracleScript#1: Execute returns "beeb" as the result.
+	res, err := k.Simulate(ctx, types.QuerySimulateParams{
+		OracleScriptID: 1, Calldata: BasicCalldata, AskCount: 2, MinCount: 1,
+		Reports: [][]types.RawReport{{types.NewRawReport(1, 0, []byte("beeb"))}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("beeb"), res.Result)
+	require.Equal(t, "", res.Error)
+	require.Len(t, res.RawRequests, 3)
+	require.Equal(t, int64(0), k.GetRequestCount(ctx))
+}
+
+func TestSimulatePrepareNoRawRequests(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// OracleScript#3: Does nothing in prepare, so there are no raw requests.
+	_, err := k.Simulate(ctx, types.QuerySimulateParams{
+		OracleScriptID: 3, Calldata: BasicCalldata, AskCount: 1, MinCount: 1,
+	})
+	require.True(t, types.ErrEmptyRawRequests.Is(err))
+}
+
+func TestSimulateInvalidParams(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	_, err := k.Simulate(ctx, types.QuerySimulateParams{
+		OracleScriptID: 1, Calldata: BasicCalldata, AskCount: 1, MinCount: 2,
+	})
+	require.True(t, types.ErrInvalidAskCount.Is(err))
+	_, err = k.Simulate(ctx, types.QuerySimulateParams{
+		OracleScriptID: 1, Calldata: BasicCalldata, AskCount: 1, MinCount: 0,
+	})
+	require.True(t, types.ErrInvalidMinCount.Is(err))
+	_, err = k.Simulate(ctx, types.QuerySimulateParams{
+		OracleScriptID: 1, Calldata: BasicCalldata, AskCount: 1, MinCount: 1,
+		Reports: make([][]types.RawReport, 2),
+	})
+	require.True(t, types.ErrInvalidReportSize.Is(err))
+	_, err = k.Simulate(ctx, types.QuerySimulateParams{
+		OracleScriptID: 42, Calldata: BasicCalldata, AskCount: 1, MinCount: 1,
+	})
+	require.True(t, types.ErrOracleScriptNotFound.Is(err))
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// SetStandingRequestCount sets the number of standing request count to the given value.
+func (k Keeper) SetStandingRequestCount(ctx sdk.Context, count int64) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(count)
+	ctx.KVStore(k.storeKey).Set(types.StandingRequestCountStoreKey, bz)
+}
+
+// GetStandingRequestCount returns the current number of all standing requests ever exist.
+func (k Keeper) GetStandingRequestCount(ctx sdk.Context) int64 {
+	var standingRequestCount int64
+	bz := ctx.KVStore(k.storeKey).Get(types.StandingRequestCountStoreKey)
+	if len(bz) == 0 { // No standing request has ever been created.
+		return 0
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &standingRequestCount)
+	return standingRequestCount
+}
+
+// GetNextStandingRequestID increments and returns the current number of standing requests.
+func (k Keeper) GetNextStandingRequestID(ctx sdk.Context) types.StandingRequestID {
+	standingRequestCount := k.GetStandingRequestCount(ctx)
+	k.SetStandingRequestCount(ctx, standingRequestCount+1)
+	return types.StandingRequestID(standingRequestCount + 1)
+}
+
+// HasStandingRequest checks if the standing request of this ID exists in the storage.
+func (k Keeper) HasStandingRequest(ctx sdk.Context, id types.StandingRequestID) bool {
+	return ctx.KVStore(k.storeKey).Has(types.StandingRequestStoreKey(id))
+}
+
+// GetStandingRequest returns the standing request struct for the given ID or error if not exists.
+func (k Keeper) GetStandingRequest(ctx sdk.Context, id types.StandingRequestID) (types.StandingRequest, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.StandingRequestStoreKey(id))
+	if bz == nil {
+		return types.StandingRequest{}, sdkerrors.Wrapf(types.ErrStandingRequestNotFound, "id: %d", id)
+	}
+	var standingRequest types.StandingRequest
+	k.cdc.MustUnmarshalBinaryBare(bz, &standingRequest)
+	return standingRequest, nil
+}
+
+// MustGetStandingRequest returns the standing request struct for the given ID. Panics if not exists.
+func (k Keeper) MustGetStandingRequest(ctx sdk.Context, id types.StandingRequestID) types.StandingRequest {
+	standingRequest, err := k.GetStandingRequest(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return standingRequest
+}
+
+// SetStandingRequest saves the given standing request to the store and schedules it to be sent
+// at its next height. Any previously scheduled entry of the same ID must be removed beforehand.
+func (k Keeper) SetStandingRequest(ctx sdk.Context, id types.StandingRequestID, sr types.StandingRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.StandingRequestStoreKey(id), k.cdc.MustMarshalBinaryBare(sr))
+	store.Set(types.StandingRequestQueueKey(sr.NextHeight, id), []byte{})
+}
+
+// DeleteStandingRequest removes the given standing request and its schedule from the store.
+func (k Keeper) DeleteStandingRequest(ctx sdk.Context, id types.StandingRequestID) {
+	sr := k.MustGetStandingRequest(ctx, id)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.StandingRequestStoreKey(id))
+	store.Delete(types.StandingRequestQueueKey(sr.NextHeight, id))
+}
+
+// AddStandingRequest saves the given standing request to the store and transfers the budget
+// from the owner to the escrow address of the new standing request.
+func (k Keeper) AddStandingRequest(
+	ctx sdk.Context, sr types.StandingRequest, budget sdk.Coins,
+) (types.StandingRequestID, error) {
+	if !k.HasOracleScript(ctx, sr.OracleScriptID) {
+		return 0, sdkerrors.Wrapf(types.ErrOracleScriptNotFound, "id: %d", sr.OracleScriptID)
+	}
+	id := k.GetNextStandingRequestID(ctx)
+	err := k.bankKeeper.SendCoins(ctx, sr.Owner, types.StandingRequestEscrowAddress(id), budget)
+	if err != nil {
+		return 0, err
+	}
+	k.SetStandingRequest(ctx, id, sr)
+	return id, nil
+}
+
+// CloseStandingRequest removes the given standing request from the store and refunds its
+// remaining budget to the owner. Also emits an event with the given reason.
+func (k Keeper) CloseStandingRequest(ctx sdk.Context, id types.StandingRequestID, reason string) error {
+	sr, err := k.GetStandingRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+	escrow := types.StandingRequestEscrowAddress(id)
+	remaining := k.bankKeeper.GetCoins(ctx, escrow)
+	if !remaining.IsZero() {
+		err = k.bankKeeper.SendCoins(ctx, escrow, sr.Owner, remaining)
+		if err != nil {
+			return err
+		}
+	}
+	k.DeleteStandingRequest(ctx, id)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCloseStandingRequest,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+	return nil
+}
+
+// ProcessStandingRequests sends a new request for every standing request that is due at the
+// current block height, up to MaxStandingRequestsPerBlock. Standing requests beyond the limit stay
+// due and are sent in the next blocks ahead of later ones. Each attempt pays StandingRequestFee
+// from the escrow to the fee collector. A standing request is closed once its budget can no longer
+// cover the fees, or once it fails MaxStandingRequestFailures times in a row. Other failures skip
+// the current round and retry after the next interval.
+func (k Keeper) ProcessStandingRequests(ctx sdk.Context) {
+	limit := k.GetParam(ctx, types.KeyMaxStandingRequestsPerBlock)
+	for _, id := range k.GetDueStandingRequests(ctx, limit) {
+		sr := k.MustGetStandingRequest(ctx, id)
+		escrow := types.StandingRequestEscrowAddress(id)
+		feeLimit, err := sdk.ParseCoins(sr.FeeLimit)
+		if err != nil { // Should never happen as the fee limit is validated on creation.
+			panic(err)
+		}
+		err = k.payStandingRequestFee(ctx, escrow)
+		if err == nil {
+			// Run the request in a cached context so that a failed request does not leave partial
+			// state changes or events behind.
+			cacheCtx, writeCache := ctx.CacheContext()
+			cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+			err = k.PrepareRequest(cacheCtx, &sr, escrow, feeLimit)
+			if err == nil {
+				writeCache()
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			}
+		}
+		if sdkerrors.ErrInsufficientFunds.Is(err) {
+			k.MustCloseStandingRequest(ctx, id, "budget exhausted")
+			continue
+		}
+		if err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("failed to send standing request %d: %s", id, err))
+			sr.FailureCount++
+			if sr.FailureCount >= k.GetParam(ctx, types.KeyMaxStandingRequestFailures) {
+				k.MustCloseStandingRequest(ctx, id, "too many failures")
+				continue
+			}
+		} else {
+			sr.FailureCount = 0
+		}
+		k.DeleteStandingRequest(ctx, id)
+		sr.NextHeight = ctx.BlockHeight() + int64(sr.Interval)
+		k.SetStandingRequest(ctx, id, sr)
+	}
+}
+
+// payStandingRequestFee sends StandingRequestFee in the bond denom from the given escrow to the
+// fee collector, so that validators are paid for sending the standing request.
+func (k Keeper) payStandingRequestFee(ctx sdk.Context, escrow sdk.AccAddress) error {
+	fee := k.GetParam(ctx, types.KeyStandingRequestFee)
+	if fee == 0 {
+		return nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.NewIntFromUint64(fee)))
+	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, escrow, k.feeCollectorName, coins)
+}
+
+// MustCloseStandingRequest closes the given standing request. Panics on error.
+func (k Keeper) MustCloseStandingRequest(ctx sdk.Context, id types.StandingRequestID, reason string) {
+	err := k.CloseStandingRequest(ctx, id, reason)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetDueStandingRequests returns the IDs of up to limit standing requests scheduled to be sent at
+// or before the current block height, ordered by their scheduled height and then by ID.
+func (k Keeper) GetDueStandingRequests(ctx sdk.Context, limit uint64) []types.StandingRequestID {
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		types.StandingRequestQueueKeyPrefix,
+		types.StandingRequestQueueKey(ctx.BlockHeight()+1, 0),
+	)
+	defer iterator.Close()
+	ids := []types.StandingRequestID{}
+	for ; iterator.Valid() && uint64(len(ids)) < limit; iterator.Next() {
+		ids = append(ids, types.StandingRequestIDFromQueueKey(iterator.Key()))
+	}
+	return ids
+}
+
+// IterateStandingRequests iterates through all standing requests in the store in ascending ID
+// order. Stops iterating once the callback returns true.
+func (k Keeper) IterateStandingRequests(
+	ctx sdk.Context, cb func(id types.StandingRequestID, sr types.StandingRequest) (stop bool),
+) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.StandingRequestStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sr types.StandingRequest
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sr)
+		if cb(types.StandingRequestID(binary.BigEndian.Uint64(iterator.Key()[1:])), sr) {
+			break
+		}
+	}
+}
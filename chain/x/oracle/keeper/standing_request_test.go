@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/testapp"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestGetSetStandingRequest(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	sr := types.NewStandingRequest(
+		testapp.Alice.Address, 1, BasicCalldata, 2, 1, BasicClientID, 10, "", 42, 0,
+	)
+	_, err := k.GetStandingRequest(ctx, 1)
+	require.Error(t, err)
+	require.False(t, k.HasStandingRequest(ctx, 1))
+	k.SetStandingRequest(ctx, 1, sr)
+	require.True(t, k.HasStandingRequest(ctx, 1))
+	require.Equal(t, sr, k.MustGetStandingRequest(ctx, 1))
+	// The standing request is due at height 42 and not before.
+	require.Equal(t, []types.StandingRequestID{}, k.GetDueStandingRequests(ctx.WithBlockHeight(41), 10))
+	require.Equal(t, []types.StandingRequestID{1}, k.GetDueStandingRequests(ctx.WithBlockHeight(42), 10))
+	k.DeleteStandingRequest(ctx, 1)
+	require.False(t, k.HasStandingRequest(ctx, 1))
+	require.Equal(t, []types.StandingRequestID{}, k.GetDueStandingRequests(ctx.WithBlockHeight(42), 10))
+}
+
+func TestAddStandingRequest(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	budget := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+	sr := types.NewStandingRequest(
+		testapp.Alice.Address, 1, BasicCalldata, 2, 1, BasicClientID, 10, "", 42, 0,
+	)
+	id, err := k.AddStandingRequest(ctx, sr, budget)
+	require.NoError(t, err)
+	require.Equal(t, types.StandingRequestID(1), id)
+	require.Equal(t, int64(1), k.GetStandingRequestCount(ctx))
+	require.Equal(t, budget, app.BankKeeper.GetCoins(ctx, types.StandingRequestEscrowAddress(1)))
+	require.Equal(t, testapp.Coins1000000uband.Sub(budget), app.BankKeeper.GetCoins(ctx, testapp.Alice.Address))
+	// Oracle script must exist.
+	sr.OracleScriptID = 42
+	_, err = k.AddStandingRequest(ctx, sr, budget)
+	require.Error(t, err)
+}
+
+func TestProcessStandingRequests(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyStandingRequestFee, 0)
+	// Data source #1 charges 10uband per request. OracleScript#1 asks for DS#1,2,3.
+	ds1 := k.MustGetDataSource(ctx, 1)
+	ds1.Fee = "10uband"
+	k.SetDataSource(ctx, 1, ds1)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(testapp.ParseTime(1581589790))
+	sr := types.NewStandingRequest(
+		testapp.Alice.Address, 1, BasicCalldata, 2, 1, BasicClientID, 5, "10uband", 11, 0,
+	)
+	_, err := k.AddStandingRequest(ctx, sr, sdk.NewCoins(sdk.NewInt64Coin("uband", 25)))
+	require.NoError(t, err)
+	// Nothing is due at height 10.
+	k.ProcessStandingRequests(ctx)
+	require.Equal(t, int64(0), k.GetRequestCount(ctx))
+	// The first request is sent at height 11 and the next one is scheduled at 16.
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	k.ProcessStandingRequests(ctx)
+	require.Equal(t, int64(1), k.GetRequestCount(ctx))
+	req := k.MustGetRequest(ctx, 1)
+	require.Equal(t, types.OracleScriptID(1), req.OracleScriptID)
+	require.Equal(t, BasicClientID, req.ClientID)
+	require.Equal(t, int64(16), k.MustGetStandingRequest(ctx, 1).NextHeight)
+	// Events of the new request are emitted to the block context.
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeRawRequest, events[len(events)-1].Type)
+	escrow := types.StandingRequestEscrowAddress(1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 15)), app.BankKeeper.GetCoins(ctx, escrow))
+	// The second request is sent at height 16.
+	ctx = ctx.WithBlockHeight(16)
+	k.ProcessStandingRequests(ctx)
+	require.Equal(t, int64(2), k.GetRequestCount(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 5)), app.BankKeeper.GetCoins(ctx, escrow))
+	// The budget cannot cover the third request, so the standing request is closed and refunded.
+	ctx = ctx.WithBlockHeight(21)
+	k.ProcessStandingRequests(ctx)
+	require.Equal(t, int64(2), k.GetRequestCount(ctx))
+	require.False(t, k.HasStandingRequest(ctx, 1))
+	require.True(t, app.BankKeeper.GetCoins(ctx, escrow).IsZero())
+	require.Equal(t,
+		testapp.Coins1000000uband.Sub(sdk.NewCoins(sdk.NewInt64Coin("uband", 20))),
+		app.BankKeeper.GetCoins(ctx, testapp.Alice.Address),
+	)
+}
+
+func TestProcessStandingRequestsSkipOnFailure(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyStandingRequestFee, 0)
+	ctx = ctx.WithBlockHeight(10)
+	// Asking for more validators than available fails, but the standing request stays.
+	sr := types.NewStandingRequest(
+		testapp.Alice.Address, 1, BasicCalldata, 10, 1, BasicClientID, 5, "", 10, 0,
+	)
+	_, err := k.AddStandingRequest(ctx, sr, sdk.NewCoins())
+	require.NoError(t, err)
+	k.ProcessStandingRequests(ctx)
+	require.Equal(t, int64(0), k.GetRequestCount(ctx))
+	require.Equal(t, int64(15), k.MustGetStandingRequest(ctx, 1).NextHeight)
+	require.Equal(t, uint64(1), k.MustGetStandingRequest(ctx, 1).FailureCount)
+}
+
+func TestProcessStandingRequestsCloseOnFailures(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyStandingRequestFee, 0)
+	k.SetParam(ctx, types.KeyMaxStandingRequestFailures, 2)
+	budget := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+	sr := types.NewStandingRequest(
+		testapp.Alice.Address, 1, BasicCalldata, 10, 1, BasicClientID, 5, "", 10, 0,
+	)
+	_, err := k.AddStandingRequest(ctx, sr, budget)
+	require.NoError(t, err)
+	k.ProcessStandingRequests(ctx.WithBlockHeight(10))
+	require.Equal(t, uint64(1), k.MustGetStandingRequest(ctx, 1).FailureCount)
+	// The second failure in a row closes the standing request and refunds the budget.
+	ctx = ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	k.ProcessStandingRequests(ctx)
+	require.False(t, k.HasStandingRequest(ctx, 1))
+	require.Equal(t, testapp.Coins1000000uband, app.BankKeeper.GetCoins(ctx, testapp.Alice.Address))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCloseStandingRequest,
+		sdk.NewAttribute(types.AttributeKeyID, "1"),
+		sdk.NewAttribute(types.AttributeKeyReason, "too many failures"),
+	))
+}
+
+func TestProcessStandingRequestsFee(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyStandingRequestFee, 30)
+	ctx = ctx.WithBlockHeight(10)
+	feeCollector := app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+	fees := app.BankKeeper.GetCoins(ctx, feeCollector)
+	// The fee is paid on every attempt, whether or not the request is sent.
+	sr := types.NewStandingRequest(
+		testapp.Alice.Address, 1, BasicCalldata, 10, 1, BasicClientID, 5, "", 10, 0,
+	)
+	_, err := k.AddStandingRequest(ctx, sr, sdk.NewCoins(sdk.NewInt64Coin("uband", 50)))
+	require.NoError(t, err)
+	k.ProcessStandingRequests(ctx)
+	escrow := types.StandingRequestEscrowAddress(1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 20)), app.BankKeeper.GetCoins(ctx, escrow))
+	require.Equal(t, fees.Add(sdk.NewInt64Coin("uband", 30)), app.BankKeeper.GetCoins(ctx, feeCollector))
+	// The budget cannot cover the next fee, so the standing request is closed and refunded.
+	k.ProcessStandingRequests(ctx.WithBlockHeight(15))
+	require.False(t, k.HasStandingRequest(ctx, 1))
+	require.Equal(t,
+		testapp.Coins1000000uband.Sub(sdk.NewCoins(sdk.NewInt64Coin("uband", 30))),
+		app.BankKeeper.GetCoins(ctx, testapp.Alice.Address),
+	)
+}
+
+func TestProcessStandingRequestsLimit(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyStandingRequestFee, 0)
+	k.SetParam(ctx, types.KeyMaxStandingRequestsPerBlock, 2)
+	ctx = ctx.WithBlockHeight(10)
+	for i := 0; i < 3; i++ {
+		sr := types.NewStandingRequest(
+			testapp.Alice.Address, 1, BasicCalldata, 10, 1, BasicClientID, 5, "", 10, 0,
+		)
+		_, err := k.AddStandingRequest(ctx, sr, sdk.NewCoins())
+		require.NoError(t, err)
+	}
+	// Only the first two are processed. The third stays due and goes first in the next block.
+	k.ProcessStandingRequests(ctx)
+	require.Equal(t, int64(15), k.MustGetStandingRequest(ctx, 1).NextHeight)
+	require.Equal(t, int64(15), k.MustGetStandingRequest(ctx, 2).NextHeight)
+	require.Equal(t, int64(10), k.MustGetStandingRequest(ctx, 3).NextHeight)
+	ctx = ctx.WithBlockHeight(11)
+	require.Equal(t, []types.StandingRequestID{3}, k.GetDueStandingRequests(ctx, 2))
+	k.ProcessStandingRequests(ctx)
+	require.Equal(t, int64(16), k.MustGetStandingRequest(ctx, 3).NextHeight)
+}
+
+func TestCloseStandingRequest(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	budget := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+	sr := types.NewStandingRequest(
+		testapp.Alice.Address, 1, BasicCalldata, 2, 1, BasicClientID, 10, "", 42, 0,
+	)
+	_, err := k.AddStandingRequest(ctx, sr, budget)
+	require.NoError(t, err)
+	require.NoError(t, k.CloseStandingRequest(ctx, 1, "reason"))
+	require.False(t, k.HasStandingRequest(ctx, 1))
+	require.Equal(t, testapp.Coins1000000uband, app.BankKeeper.GetCoins(ctx, testapp.Alice.Address))
+	require.Error(t, k.CloseStandingRequest(ctx, 1, "reason"))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,35 +12,89 @@ import (
 	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
 )
 
-// valWithPower is an internal type to track validator with voting power inside of AllocateTokens.
-type valWithPower struct {
-	val   stakingexported.ValidatorI
-	power int64
+// valWithWeight is an internal type to track validator with reward weight inside of AllocateTokens.
+type valWithWeight struct {
+	val    stakingexported.ValidatorI
+	weight int64
 }
 
-// AllocateTokens allocates a portion of fee collected in the previous blocks to validators that
-// that are actively performing oracle tasks. Note that this reward is also subjected to comm tax.
+// getRewardWeight returns the weight of the given validator's share in the oracle reward,
+// according to the RewardWeightingMode parameter. Falls back to voting power on an unknown mode,
+// which parameter validation should never let through, rather than halting the chain.
+func (k Keeper) getRewardWeight(ctx sdk.Context, val sdk.ValAddress, power int64) int64 {
+	switch mode := k.GetParam(ctx, types.KeyRewardWeightingMode); mode {
+	case types.RewardWeightingVotingPower:
+		return power
+	case types.RewardWeightingReports:
+		return int64(k.GetValidatorReportStat(ctx, val).ReportCount)
+	case types.RewardWeightingReportsWithBonus:
+		stat := k.GetValidatorReportStat(ctx, val)
+		bonus := k.GetParam(ctx, types.KeyInBeforeResolveBonusPercentage)
+		return int64(stat.ReportCount*100 + stat.InBeforeResolveCount*bonus)
+	default:
+		k.Logger(ctx).Error(fmt.Sprintf("unknown reward weighting mode %d, weighting by voting power", mode))
+		return power
+	}
+}
+
+// AllocateTokens moves a portion of fee collected in the previous block to the oracle module
+// account if there are validators actively performing oracle tasks. The reward accumulates until
+// the end of the reward period, which lasts RewardPeriod blocks. It is then allocated to the active
+// validators, weighted by either voting power or the reports delivered in the period. Note that
+// this reward is also subjected to comm tax. If active validators deliver no reports in the period,
+// the reward goes to the community pool. If no validators are active, it is carried forward.
 func (k Keeper) AllocateTokens(ctx sdk.Context, previousVotes []abci.VoteInfo) {
-	toReward := []valWithPower{}
-	totalPower := int64(0)
+	activeVals := []valWithWeight{}
 	for _, vote := range previousVotes {
 		val := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
 		if k.GetValidatorStatus(ctx, val.GetOperator()).IsActive {
-			toReward = append(toReward, valWithPower{val: val, power: vote.Validator.Power})
-			totalPower += vote.Validator.Power
+			activeVals = append(activeVals, valWithWeight{val: val, weight: vote.Validator.Power})
 		}
 	}
-	if totalPower == 0 {
-		// No active validators performing oracle tasks, nothing needs to be done here.
+	if len(activeVals) != 0 {
+		k.collectOracleReward(ctx)
+	}
+	if ctx.BlockHeight()%int64(k.GetParam(ctx, types.KeyRewardPeriod)) != 0 {
+		// The reward period has not ended yet, keep accumulating.
 		return
 	}
+	if len(activeVals) != 0 {
+		k.distributeOracleReward(ctx, activeVals)
+	}
+	// Reports delivered so far have been accounted for. Start a new period.
+	k.clearValidatorReportStats(ctx)
+}
+
+// collectOracleReward transfers the oracle reward portion of the collected fee from fee collector
+// to oracle module account, where it stays until the end of the reward period.
+func (k Keeper) collectOracleReward(ctx sdk.Context) {
 	feeCollector := k.supplyKeeper.GetModuleAccount(ctx, k.feeCollectorName)
 	totalFee := sdk.NewDecCoinsFromCoins(feeCollector.GetCoins()...)
 	// Compute the fee allocated for oracle module to distribute to active validators.
 	oracleRewardRatio := sdk.NewDecWithPrec(int64(k.GetParam(ctx, types.KeyOracleRewardPercentage)), 2)
 	oracleRewardInt, _ := totalFee.MulDecTruncate(oracleRewardRatio).TruncateDecimal()
-	// Transfer the oracle reward portion from fee collector to distr module.
-	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, distr.ModuleName, oracleRewardInt)
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, oracleRewardInt)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// distributeOracleReward allocates the oracle reward accumulated in the reward period to the given
+// active validators by their reward weight.
+func (k Keeper) distributeOracleReward(ctx sdk.Context, activeVals []valWithWeight) {
+	toReward := []valWithWeight{}
+	totalWeight := int64(0)
+	for _, each := range activeVals {
+		weight := k.getRewardWeight(ctx, each.val.GetOperator(), each.weight)
+		if weight == 0 {
+			continue
+		}
+		toReward = append(toReward, valWithWeight{val: each.val, weight: weight})
+		totalWeight += weight
+	}
+	// Transfer the accumulated oracle reward from oracle module to distr module.
+	oracleRewardInt := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distr.ModuleName, oracleRewardInt)
 	if err != nil {
 		panic(err)
 	}
@@ -47,14 +102,14 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, previousVotes []abci.VoteInfo) {
 	oracleReward := sdk.NewDecCoinsFromCoins(oracleRewardInt...)
 	remaining := oracleReward
 	rewardMultiplier := sdk.OneDec().Sub(k.distrKeeper.GetCommunityTax(ctx))
-	// Allocate non-community pool tokens to active validators weighted by voting power.
+	// Allocate non-community pool tokens to active validators by their reward weight.
 	for _, each := range toReward {
-		powerFraction := sdk.NewDec(each.power).QuoTruncate(sdk.NewDec(totalPower))
-		reward := oracleReward.MulDecTruncate(rewardMultiplier).MulDecTruncate(powerFraction)
+		weightFraction := sdk.NewDec(each.weight).QuoTruncate(sdk.NewDec(totalWeight))
+		reward := oracleReward.MulDecTruncate(rewardMultiplier).MulDecTruncate(weightFraction)
 		k.distrKeeper.AllocateTokensToValidator(ctx, each.val, reward)
 		remaining = remaining.Sub(reward)
 	}
-	// Allocate the remaining coins to the community pool.
+	// Allocate the remaining coins, or all if no validators earn a weight, to the community pool.
 	feePool := k.distrKeeper.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
 	k.distrKeeper.SetFeePool(ctx, feePool)
@@ -104,3 +159,19 @@ func (k Keeper) MissReport(ctx sdk.Context, val sdk.ValAddress, requestTime time
 		))
 	}
 }
+
+// IterateValidatorStatuses iterates through all validator statuses in the store. Stops
+// iterating once the callback returns true.
+func (k Keeper) IterateValidatorStatuses(
+	ctx sdk.Context, cb func(val sdk.ValAddress, status types.ValidatorStatus) (stop bool),
+) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorStatusKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.ValidatorStatus
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &status)
+		if cb(sdk.ValAddress(iterator.Key()[1:]), status) {
+			break
+		}
+	}
+}
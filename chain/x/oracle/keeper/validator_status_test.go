@@ -87,6 +87,109 @@ func TestAllocateTokensAllActive(t *testing.T) {
 	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(68600)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator3.ValAddress))
 }
 
+func TestAllocateTokensByReports(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	// Set collected fee to 1000000uband + 70% oracle reward proportion.
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	feeCollector.SetCoins(Coins1000000uband)
+	app.AccountKeeper.SetAccount(ctx, feeCollector)
+	k.SetParam(ctx, types.KeyOracleRewardPercentage, 70)
+	k.SetParam(ctx, types.KeyRewardWeightingMode, types.RewardWeightingReports)
+	// Validator1 delivers 1 report, Validator2 delivers 3, and Validator3 delivers none.
+	k.RecordReport(ctx, types.NewReport(testapp.Validator1.ValAddress, true, nil))
+	for i := 0; i < 3; i++ {
+		k.RecordReport(ctx, types.NewReport(testapp.Validator2.ValAddress, false, nil))
+	}
+	// From 70% of fee, 2% should go to community pool, the rest get split by reports delivered.
+	k.AllocateTokens(ctx, defaultVotes())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 300000)), app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 700000)), app.SupplyKeeper.GetModuleAccount(ctx, distribution.ModuleName).GetCoins())
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(14000)}}, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(171500)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator1.ValAddress))
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(514500)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator2.ValAddress))
+	require.Equal(t, sdk.DecCoins(nil), app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator3.ValAddress))
+	// Report stats are cleared for the next period.
+	require.Equal(t, types.NewValidatorReportStat(0, 0), k.GetValidatorReportStat(ctx, testapp.Validator2.ValAddress))
+	// Without any reports in the new period, the oracle reward goes to the community pool.
+	k.AllocateTokens(ctx, defaultVotes())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 90000)), app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 910000)), app.SupplyKeeper.GetModuleAccount(ctx, distribution.ModuleName).GetCoins())
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(224000)}}, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(514500)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator2.ValAddress))
+}
+
+func TestAllocateTokensAcrossRewardPeriod(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	k.SetParam(ctx, types.KeyOracleRewardPercentage, 70)
+	k.SetParam(ctx, types.KeyRewardWeightingMode, types.RewardWeightingReports)
+	k.SetParam(ctx, types.KeyRewardPeriod, 3)
+	// Validator1 delivers 1 report and Validator2 delivers 3 reports in block 1.
+	ctx = ctx.WithBlockHeight(1)
+	k.RecordReport(ctx, types.NewReport(testapp.Validator1.ValAddress, true, nil))
+	for i := 0; i < 3; i++ {
+		k.RecordReport(ctx, types.NewReport(testapp.Validator2.ValAddress, false, nil))
+	}
+	// Block 2 is in the middle of the period. 70% of fee accumulates in the oracle module.
+	ctx = ctx.WithBlockHeight(2)
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	feeCollector.SetCoins(Coins1000000uband)
+	app.AccountKeeper.SetAccount(ctx, feeCollector)
+	k.AllocateTokens(ctx, defaultVotes())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 300000)), app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 700000)), app.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins())
+	require.Equal(t, sdk.Coins(nil), app.SupplyKeeper.GetModuleAccount(ctx, distribution.ModuleName).GetCoins())
+	require.Equal(t, types.NewValidatorReportStat(3, 0), k.GetValidatorReportStat(ctx, testapp.Validator2.ValAddress))
+	// Block 3 ends the period. The reward of both blocks gets split by the reports in block 1.
+	ctx = ctx.WithBlockHeight(3)
+	feeCollector = app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	feeCollector.SetCoins(Coins1000000uband)
+	app.AccountKeeper.SetAccount(ctx, feeCollector)
+	k.AllocateTokens(ctx, defaultVotes())
+	require.True(t, app.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 1400000)), app.SupplyKeeper.GetModuleAccount(ctx, distribution.ModuleName).GetCoins())
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(28000)}}, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(343000)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator1.ValAddress))
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(1029000)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator2.ValAddress))
+	require.Equal(t, sdk.DecCoins(nil), app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator3.ValAddress))
+	require.Equal(t, types.NewValidatorReportStat(0, 0), k.GetValidatorReportStat(ctx, testapp.Validator2.ValAddress))
+}
+
+func TestAllocateTokensByReportsWithBonus(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	// Set collected fee to 1000000uband + 70% oracle reward proportion.
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	feeCollector.SetCoins(Coins1000000uband)
+	app.AccountKeeper.SetAccount(ctx, feeCollector)
+	k.SetParam(ctx, types.KeyOracleRewardPercentage, 70)
+	k.SetParam(ctx, types.KeyRewardWeightingMode, types.RewardWeightingReportsWithBonus)
+	k.SetParam(ctx, types.KeyInBeforeResolveBonusPercentage, 50)
+	// Weights are 2 * 150 = 300 for Validator1, 3 * 100 = 300 for Validator2, 150 for Validator3.
+	for i := 0; i < 2; i++ {
+		k.RecordReport(ctx, types.NewReport(testapp.Validator1.ValAddress, true, nil))
+	}
+	for i := 0; i < 3; i++ {
+		k.RecordReport(ctx, types.NewReport(testapp.Validator2.ValAddress, false, nil))
+	}
+	k.RecordReport(ctx, types.NewReport(testapp.Validator3.ValAddress, true, nil))
+	k.AllocateTokens(ctx, defaultVotes())
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(14000)}}, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(274400)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator1.ValAddress))
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(274400)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator2.ValAddress))
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(137200)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator3.ValAddress))
+}
+
+func TestAllocateTokensUnknownWeightingMode(t *testing.T) {
+	app, ctx, k := testapp.CreateTestInput(true)
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	feeCollector.SetCoins(Coins1000000uband)
+	app.AccountKeeper.SetAccount(ctx, feeCollector)
+	k.SetParam(ctx, types.KeyOracleRewardPercentage, 70)
+	k.SetParam(ctx, types.KeyRewardWeightingMode, 42)
+	// An unknown mode falls back to voting power instead of panicking.
+	require.NotPanics(t, func() { k.AllocateTokens(ctx, defaultVotes()) })
+	require.Equal(t, sdk.DecCoins{{Denom: "uband", Amount: sdk.NewDec(480200)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, testapp.Validator1.ValAddress))
+}
+
 func TestGetDefaultValidatorStatus(t *testing.T) {
 	_, ctx, k := testapp.CreateTestInput(false)
 	vs := k.GetValidatorStatus(ctx, testapp.Validator1.ValAddress)
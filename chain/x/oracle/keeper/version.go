@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+// GetDataSourceVersionCount returns the number of versions of the given data source, which is
+// also the latest version number. Returns 0 if the data source has no recorded version.
+func (k Keeper) GetDataSourceVersionCount(ctx sdk.Context, id types.DataSourceID) int64 {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.DataSourceVersionsPrefixKey(id))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0
+	}
+	return types.VersionFromStoreKey(iterator.Key())
+}
+
+// GetDataSourceVersion returns the given version of a data source or error if not exists.
+func (k Keeper) GetDataSourceVersion(
+	ctx sdk.Context, id types.DataSourceID, version int64,
+) (types.DataSourceVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DataSourceVersionStoreKey(id, version))
+	if bz == nil {
+		return types.DataSourceVersion{}, sdkerrors.Wrapf(
+			types.ErrVersionNotFound, "data source: %d, version: %d", id, version)
+	}
+	var dataSourceVersion types.DataSourceVersion
+	k.cdc.MustUnmarshalBinaryBare(bz, &dataSourceVersion)
+	return dataSourceVersion, nil
+}
+
+// SetDataSourceVersion saves the given version of a data source without performing validation.
+func (k Keeper) SetDataSourceVersion(
+	ctx sdk.Context, id types.DataSourceID, version int64, dataSourceVersion types.DataSourceVersion,
+) {
+	bz := k.cdc.MustMarshalBinaryBare(dataSourceVersion)
+	ctx.KVStore(k.storeKey).Set(types.DataSourceVersionStoreKey(id, version), bz)
+}
+
+// AddDataSourceVersion appends the current state of the given data source to its version
+// history with the given editor and the current block height. Returns the new version number.
+func (k Keeper) AddDataSourceVersion(ctx sdk.Context, id types.DataSourceID, editor sdk.AccAddress) int64 {
+	dataSource := k.MustGetDataSource(ctx, id)
+	version := k.GetDataSourceVersionCount(ctx, id) + 1
+	k.SetDataSourceVersion(ctx, id, version, types.NewDataSourceVersion(
+		dataSource.Filename, editor, ctx.BlockHeight(),
+	))
+	return version
+}
+
+// GetDataSourceVersions returns all versions of the given data source in ascending order.
+func (k Keeper) GetDataSourceVersions(ctx sdk.Context, id types.DataSourceID) []types.DataSourceVersion {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DataSourceVersionsPrefixKey(id))
+	defer iterator.Close()
+	versions := []types.DataSourceVersion{}
+	for ; iterator.Valid(); iterator.Next() {
+		var dataSourceVersion types.DataSourceVersion
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &dataSourceVersion)
+		versions = append(versions, dataSourceVersion)
+	}
+	return versions
+}
+
+// GetOracleScriptVersionCount returns the number of versions of the given oracle script, which
+// is also the latest version number. Returns 0 if the oracle script has no recorded version.
+func (k Keeper) GetOracleScriptVersionCount(ctx sdk.Context, id types.OracleScriptID) int64 {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.OracleScriptVersionsPrefixKey(id))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0
+	}
+	return types.VersionFromStoreKey(iterator.Key())
+}
+
+// GetOracleScriptVersion returns the given version of an oracle script or error if not exists.
+func (k Keeper) GetOracleScriptVersion(
+	ctx sdk.Context, id types.OracleScriptID, version int64,
+) (types.OracleScriptVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.OracleScriptVersionStoreKey(id, version))
+	if bz == nil {
+		return types.OracleScriptVersion{}, sdkerrors.Wrapf(
+			types.ErrVersionNotFound, "oracle script: %d, version: %d", id, version)
+	}
+	var oracleScriptVersion types.OracleScriptVersion
+	k.cdc.MustUnmarshalBinaryBare(bz, &oracleScriptVersion)
+	return oracleScriptVersion, nil
+}
+
+// SetOracleScriptVersion saves the given version of an oracle script without performing validation.
+func (k Keeper) SetOracleScriptVersion(
+	ctx sdk.Context, id types.OracleScriptID, version int64, oracleScriptVersion types.OracleScriptVersion,
+) {
+	bz := k.cdc.MustMarshalBinaryBare(oracleScriptVersion)
+	ctx.KVStore(k.storeKey).Set(types.OracleScriptVersionStoreKey(id, version), bz)
+}
+
+// AddOracleScriptVersion appends the current state of the given oracle script to its version
+// history with the given editor and the current block height. Returns the new version number.
+func (k Keeper) AddOracleScriptVersion(ctx sdk.Context, id types.OracleScriptID, editor sdk.AccAddress) int64 {
+	oracleScript := k.MustGetOracleScript(ctx, id)
+	version := k.GetOracleScriptVersionCount(ctx, id) + 1
+	k.SetOracleScriptVersion(ctx, id, version, types.NewOracleScriptVersion(
+		oracleScript.Filename, oracleScript.Schema, editor, ctx.BlockHeight(),
+	))
+	return version
+}
+
+// GetOracleScriptVersions returns all versions of the given oracle script in ascending order.
+func (k Keeper) GetOracleScriptVersions(ctx sdk.Context, id types.OracleScriptID) []types.OracleScriptVersion {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.OracleScriptVersionsPrefixKey(id))
+	defer iterator.Close()
+	versions := []types.OracleScriptVersion{}
+	for ; iterator.Valid(); iterator.Next() {
+		var oracleScriptVersion types.OracleScriptVersion
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &oracleScriptVersion)
+		versions = append(versions, oracleScriptVersion)
+	}
+	return versions
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/bandchain/chain/x/oracle/testapp"
+	"github.com/bandprotocol/bandchain/chain/x/oracle/types"
+)
+
+func TestDataSourceVersions(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// Genesis data sources start with a single version.
+	require.Equal(t, int64(1), k.GetDataSourceVersionCount(ctx, 1))
+	require.Equal(t, int64(0), k.GetDataSourceVersionCount(ctx, 42))
+	_, err := k.GetDataSourceVersion(ctx, 1, 2)
+	require.Error(t, err)
+	// Editing the data source and adding a version keeps the old version intact.
+	ctx = ctx.WithBlockHeight(10)
+	ds := k.MustGetDataSource(ctx, 1)
+	oldFilename := ds.Filename
+	ds.Filename = BasicFilename
+	k.SetDataSource(ctx, 1, ds)
+	require.Equal(t, int64(2), k.AddDataSourceVersion(ctx, 1, testapp.Alice.Address))
+	require.Equal(t, int64(2), k.GetDataSourceVersionCount(ctx, 1))
+	versions := k.GetDataSourceVersions(ctx, 1)
+	require.Len(t, versions, 2)
+	require.Equal(t, oldFilename, versions[0].Filename)
+	require.Equal(t, types.NewDataSourceVersion(BasicFilename, testapp.Alice.Address, 10), versions[1])
+	version, err := k.GetDataSourceVersion(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, versions[1], version)
+}
+
+func TestOracleScriptVersions(t *testing.T) {
+	_, ctx, k := testapp.CreateTestInput(true)
+	// Genesis oracle scripts start with a single version.
+	require.Equal(t, int64(1), k.GetOracleScriptVersionCount(ctx, 1))
+	require.Equal(t, int64(0), k.GetOracleScriptVersionCount(ctx, 42))
+	_, err := k.GetOracleScriptVersion(ctx, 1, 2)
+	require.Error(t, err)
+	// Editing the oracle script and adding a version keeps the old version intact.
+	ctx = ctx.WithBlockHeight(10)
+	os := k.MustGetOracleScript(ctx, 1)
+	oldFilename := os.Filename
+	os.Filename = BasicFilename
+	os.Schema = BasicSchema
+	k.SetOracleScript(ctx, 1, os)
+	require.Equal(t, int64(2), k.AddOracleScriptVersion(ctx, 1, testapp.Alice.Address))
+	versions := k.GetOracleScriptVersions(ctx, 1)
+	require.Len(t, versions, 2)
+	require.Equal(t, oldFilename, versions[0].Filename)
+	require.Equal(t, types.NewOracleScriptVersion(BasicFilename, BasicSchema, testapp.Alice.Address, 10), versions[1])
+}
@@ -89,7 +89,7 @@ func getGenesisDataSources() []types.DataSource {
 		idxStr := fmt.Sprintf("%d", idx+1)
 		hash := fc.AddFile([]byte("code" + idxStr))
 		DataSources = append(DataSources, types.NewDataSource(
-			Owner.Address, "name"+idxStr, "desc"+idxStr, hash,
+			Owner.Address, "name"+idxStr, "desc"+idxStr, hash, "",
 		))
 	}
 	return DataSources[1:]
@@ -15,6 +15,8 @@ func init() {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgRequestData{}, "oracle/Request", nil)
 	cdc.RegisterConcrete(MsgReportData{}, "oracle/Report", nil)
+	cdc.RegisterConcrete(MsgCommitReport{}, "oracle/CommitReport", nil)
+	cdc.RegisterConcrete(MsgRevealReport{}, "oracle/RevealReport", nil)
 	cdc.RegisterConcrete(MsgCreateDataSource{}, "oracle/CreateDataSource", nil)
 	cdc.RegisterConcrete(MsgEditDataSource{}, "oracle/EditDataSource", nil)
 	cdc.RegisterConcrete(MsgCreateOracleScript{}, "oracle/CreateOracleScript", nil)
@@ -22,6 +24,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgActivate{}, "oracle/Activate", nil)
 	cdc.RegisterConcrete(MsgAddReporter{}, "oracle/AddReporter", nil)
 	cdc.RegisterConcrete(MsgRemoveReporter{}, "oracle/RemoveReporter", nil)
+	cdc.RegisterConcrete(MsgCreateStandingRequest{}, "oracle/CreateStandingRequest", nil)
+	cdc.RegisterConcrete(MsgCancelStandingRequest{}, "oracle/CancelStandingRequest", nil)
 	cdc.RegisterConcrete(OracleRequestPacketData{}, "oracle/OracleRequestPacketData", nil)
 	cdc.RegisterConcrete(OracleResponsePacketData{}, "oracle/OracleResponsePacketData", nil)
 }
@@ -11,6 +11,7 @@ func NewMsgRequestData(
 	MinCount uint64,
 	ClientID string,
 	Sender github_com_cosmos_cosmos_sdk_types.AccAddress,
+	FeeLimit string,
 ) MsgRequestData {
 	return MsgRequestData{
 		OracleScriptID: OracleScriptID,
@@ -19,6 +20,7 @@ func NewMsgRequestData(
 		MinCount:       MinCount,
 		ClientID:       ClientID,
 		Sender:         Sender,
+		FeeLimit:       FeeLimit,
 	}
 }
 
@@ -36,12 +38,43 @@ func NewMsgReportData(
 	}
 }
 
+func NewMsgCommitReport(
+	RequestID RequestID,
+	CommitHash []byte,
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress,
+	Reporter github_com_cosmos_cosmos_sdk_types.AccAddress,
+) MsgCommitReport {
+	return MsgCommitReport{
+		RequestID:  RequestID,
+		CommitHash: CommitHash,
+		Validator:  Validator,
+		Reporter:   Reporter,
+	}
+}
+
+func NewMsgRevealReport(
+	RequestID RequestID,
+	RawReports []RawReport,
+	Salt []byte,
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress,
+	Reporter github_com_cosmos_cosmos_sdk_types.AccAddress,
+) MsgRevealReport {
+	return MsgRevealReport{
+		RequestID:  RequestID,
+		RawReports: RawReports,
+		Salt:       Salt,
+		Validator:  Validator,
+		Reporter:   Reporter,
+	}
+}
+
 func NewMsgCreateDataSource(
 	Owner github_com_cosmos_cosmos_sdk_types.AccAddress,
 	Name string,
 	Description string,
 	Executable []byte,
 	Sender github_com_cosmos_cosmos_sdk_types.AccAddress,
+	Fee string,
 ) MsgCreateDataSource {
 	return MsgCreateDataSource{
 		Owner:       Owner,
@@ -49,6 +82,7 @@ func NewMsgCreateDataSource(
 		Description: Description,
 		Executable:  Executable,
 		Sender:      Sender,
+		Fee:         Fee,
 	}
 }
 
@@ -59,6 +93,7 @@ func NewMsgEditDataSource(
 	Description string,
 	Executable []byte,
 	Sender github_com_cosmos_cosmos_sdk_types.AccAddress,
+	Fee string,
 ) MsgEditDataSource {
 	return MsgEditDataSource{
 		DataSourceID: DataSourceID,
@@ -67,6 +102,7 @@ func NewMsgEditDataSource(
 		Description:  Description,
 		Executable:   Executable,
 		Sender:       Sender,
+		Fee:          Fee,
 	}
 }
 
@@ -140,17 +176,65 @@ func NewMsgRemoveReporter(
 	}
 }
 
+func NewMsgCreateStandingRequest(
+	OracleScriptID OracleScriptID,
+	Calldata []byte,
+	AskCount uint64,
+	MinCount uint64,
+	ClientID string,
+	Interval uint64,
+	Budget string,
+	FeeLimit string,
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress,
+) MsgCreateStandingRequest {
+	return MsgCreateStandingRequest{
+		OracleScriptID: OracleScriptID,
+		Calldata:       Calldata,
+		AskCount:       AskCount,
+		MinCount:       MinCount,
+		ClientID:       ClientID,
+		Interval:       Interval,
+		Budget:         Budget,
+		FeeLimit:       FeeLimit,
+		Sender:         Sender,
+	}
+}
+
+func NewMsgCancelStandingRequest(
+	StandingRequestID StandingRequestID,
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress,
+) MsgCancelStandingRequest {
+	return MsgCancelStandingRequest{
+		StandingRequestID: StandingRequestID,
+		Sender:            Sender,
+	}
+}
+
 func NewDataSource(
 	Owner github_com_cosmos_cosmos_sdk_types.AccAddress,
 	Name string,
 	Description string,
 	Filename string,
+	Fee string,
 ) DataSource {
 	return DataSource{
 		Owner:       Owner,
 		Name:        Name,
 		Description: Description,
 		Filename:    Filename,
+		Fee:         Fee,
+	}
+}
+
+func NewDataSourceVersion(
+	Filename string,
+	Editor github_com_cosmos_cosmos_sdk_types.AccAddress,
+	Height int64,
+) DataSourceVersion {
+	return DataSourceVersion{
+		Filename: Filename,
+		Editor:   Editor,
+		Height:   Height,
 	}
 }
 
@@ -172,15 +256,31 @@ func NewOracleScript(
 	}
 }
 
+func NewOracleScriptVersion(
+	Filename string,
+	Schema string,
+	Editor github_com_cosmos_cosmos_sdk_types.AccAddress,
+	Height int64,
+) OracleScriptVersion {
+	return OracleScriptVersion{
+		Filename: Filename,
+		Schema:   Schema,
+		Editor:   Editor,
+		Height:   Height,
+	}
+}
+
 func NewRawRequest(
 	ExternalID ExternalID,
 	DataSourceID DataSourceID,
 	Calldata []byte,
+	DataSourceVersion int64,
 ) RawRequest {
 	return RawRequest{
-		ExternalID:   ExternalID,
-		DataSourceID: DataSourceID,
-		Calldata:     Calldata,
+		ExternalID:        ExternalID,
+		DataSourceID:      DataSourceID,
+		Calldata:          Calldata,
+		DataSourceVersion: DataSourceVersion,
 	}
 }
 
@@ -205,6 +305,7 @@ func NewRequest(
 	RequestTime time.Time,
 	ClientID string,
 	RawRequests []RawRequest,
+	OracleScriptVersion int64,
 ) Request {
 	return Request{
 		OracleScriptID:      OracleScriptID,
@@ -215,6 +316,33 @@ func NewRequest(
 		RequestTime:         RequestTime,
 		ClientID:            ClientID,
 		RawRequests:         RawRequests,
+		OracleScriptVersion: OracleScriptVersion,
+	}
+}
+
+func NewStandingRequest(
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress,
+	OracleScriptID OracleScriptID,
+	Calldata []byte,
+	AskCount uint64,
+	MinCount uint64,
+	ClientID string,
+	Interval uint64,
+	FeeLimit string,
+	NextHeight int64,
+	FailureCount uint64,
+) StandingRequest {
+	return StandingRequest{
+		Owner:          Owner,
+		OracleScriptID: OracleScriptID,
+		Calldata:       Calldata,
+		AskCount:       AskCount,
+		MinCount:       MinCount,
+		ClientID:       ClientID,
+		Interval:       Interval,
+		FeeLimit:       FeeLimit,
+		NextHeight:     NextHeight,
+		FailureCount:   FailureCount,
 	}
 }
 
@@ -230,6 +358,16 @@ func NewReport(
 	}
 }
 
+func NewReportCommit(
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress,
+	CommitHash []byte,
+) ReportCommit {
+	return ReportCommit{
+		Validator:  Validator,
+		CommitHash: CommitHash,
+	}
+}
+
 func NewOracleRequestPacketData(
 	ClientID string,
 	OracleScriptID OracleScriptID,
@@ -276,6 +414,26 @@ func NewValidatorStatus(
 	}
 }
 
+func NewReportInfo(
+	IndexOffset int64,
+	MissedReportsCounter int64,
+) ReportInfo {
+	return ReportInfo{
+		IndexOffset:          IndexOffset,
+		MissedReportsCounter: MissedReportsCounter,
+	}
+}
+
+func NewValidatorReportStat(
+	ReportCount uint64,
+	InBeforeResolveCount uint64,
+) ValidatorReportStat {
+	return ValidatorReportStat{
+		ReportCount:          ReportCount,
+		InBeforeResolveCount: InBeforeResolveCount,
+	}
+}
+
 func NewParams(
 	MaxRawRequestCount uint64,
 	MaxAskCount uint64,
@@ -285,15 +443,45 @@ func NewParams(
 	SamplingTryCount uint64,
 	OracleRewardPercentage uint64,
 	InactivePenaltyDuration uint64,
+	RequestRetentionBlockCount uint64,
+	MaxPruneCountPerBlock uint64,
+	PruneResult bool,
+	CommitBlockCount uint64,
+	ReportWindow uint64,
+	MinReportPercentage uint64,
+	MissedReportSlashPercentage uint64,
+	RewardWeightingMode uint64,
+	InBeforeResolveBonusPercentage uint64,
+	StandingRequestFee uint64,
+	MinStandingRequestInterval uint64,
+	MaxStandingRequestsPerBlock uint64,
+	MaxStandingRequestFailures uint64,
+	MissedReportJailDuration uint64,
+	RewardPeriod uint64,
 ) Params {
 	return Params{
-		MaxRawRequestCount:      MaxRawRequestCount,
-		MaxAskCount:             MaxAskCount,
-		ExpirationBlockCount:    ExpirationBlockCount,
-		BaseRequestGas:          BaseRequestGas,
-		PerValidatorRequestGas:  PerValidatorRequestGas,
-		SamplingTryCount:        SamplingTryCount,
-		OracleRewardPercentage:  OracleRewardPercentage,
-		InactivePenaltyDuration: InactivePenaltyDuration,
+		MaxRawRequestCount:             MaxRawRequestCount,
+		MaxAskCount:                    MaxAskCount,
+		ExpirationBlockCount:           ExpirationBlockCount,
+		BaseRequestGas:                 BaseRequestGas,
+		PerValidatorRequestGas:         PerValidatorRequestGas,
+		SamplingTryCount:               SamplingTryCount,
+		OracleRewardPercentage:         OracleRewardPercentage,
+		InactivePenaltyDuration:        InactivePenaltyDuration,
+		RequestRetentionBlockCount:     RequestRetentionBlockCount,
+		MaxPruneCountPerBlock:          MaxPruneCountPerBlock,
+		PruneResult:                    PruneResult,
+		CommitBlockCount:               CommitBlockCount,
+		ReportWindow:                   ReportWindow,
+		MinReportPercentage:            MinReportPercentage,
+		MissedReportSlashPercentage:    MissedReportSlashPercentage,
+		RewardWeightingMode:            RewardWeightingMode,
+		InBeforeResolveBonusPercentage: InBeforeResolveBonusPercentage,
+		StandingRequestFee:             StandingRequestFee,
+		MinStandingRequestInterval:     MinStandingRequestInterval,
+		MaxStandingRequestsPerBlock:    MaxStandingRequestsPerBlock,
+		MaxStandingRequestFailures:     MaxStandingRequestFailures,
+		MissedReportJailDuration:       MissedReportJailDuration,
+		RewardPeriod:                   RewardPeriod,
 	}
 }
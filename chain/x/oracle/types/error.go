@@ -43,6 +43,21 @@ var (
 	ErrOBIDecode                = sdkerrors.Register(ModuleName, 37, "obi decode failed")
 	ErrUncompressionFailed      = sdkerrors.Register(ModuleName, 38, "uncompression failed")
 	ErrRequestAlreadyExpired    = sdkerrors.Register(ModuleName, 39, "request already expired")
+	ErrNotEnoughFee             = sdkerrors.Register(ModuleName, 40, "not enough fee")
+	ErrRequestPruned            = sdkerrors.Register(ModuleName, 41, "request pruned")
+	ErrStandingRequestNotFound  = sdkerrors.Register(ModuleName, 42, "standing request not found")
+	ErrInvalidInterval          = sdkerrors.Register(ModuleName, 43, "invalid interval")
+	ErrNotStandingRequestOwner  = sdkerrors.Register(ModuleName, 44, "not standing request owner")
+	ErrVersionNotFound          = sdkerrors.Register(ModuleName, 45, "version not found")
+	ErrCommitRevealRequired     = sdkerrors.Register(ModuleName, 46, "commit-reveal required")
+	ErrCommitWindowClosed       = sdkerrors.Register(ModuleName, 47, "commit window closed")
+	ErrCommitWindowOpen         = sdkerrors.Register(ModuleName, 48, "commit window still open")
+	ErrReportCommitNotFound     = sdkerrors.Register(ModuleName, 49, "report commit not found")
+	ErrAlreadyCommitted         = sdkerrors.Register(ModuleName, 50, "validator already committed")
+	ErrInvalidCommitHash        = sdkerrors.Register(ModuleName, 51, "invalid commit hash")
+	ErrReportCommitMismatch     = sdkerrors.Register(ModuleName, 52, "report does not match commit")
+	ErrInvalidSchema            = sdkerrors.Register(ModuleName, 53, "invalid schema")
+	ErrInvalidParams            = sdkerrors.Register(ModuleName, 54, "invalid params")
 )
 
 // WrapMaxError wraps an error message with additional info of the current and max values.
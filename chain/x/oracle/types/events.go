@@ -2,18 +2,23 @@ package types
 
 // nolint
 const (
-	EventTypeCreateDataSource   = "create_data_source"
-	EventTypeEditDataSource     = "edit_data_source"
-	EventTypeCreateOracleScript = "create_oracle_script"
-	EventTypeEditOracleScript   = "edit_oracle_script"
-	EventTypeRequest            = "request"
-	EventTypeRawRequest         = "raw_request"
-	EventTypeReport             = "report"
-	EventTypeActivate           = "activate"
-	EventTypeDeactivate         = "deactivate"
-	EventTypeAddReporter        = "add_reporter"
-	EventTypeRemoveReporter     = "remove_reporter"
-	EventTypeResolve            = "resolve"
+	EventTypeCreateDataSource      = "create_data_source"
+	EventTypeEditDataSource        = "edit_data_source"
+	EventTypeCreateOracleScript    = "create_oracle_script"
+	EventTypeEditOracleScript      = "edit_oracle_script"
+	EventTypeRequest               = "request"
+	EventTypeRawRequest            = "raw_request"
+	EventTypeReport                = "report"
+	EventTypeCommitReport          = "commit_report"
+	EventTypeActivate              = "activate"
+	EventTypeDeactivate            = "deactivate"
+	EventTypeSlash                 = "oracle_slash"
+	EventTypeAddReporter           = "add_reporter"
+	EventTypeRemoveReporter        = "remove_reporter"
+	EventTypeResolve               = "resolve"
+	EventTypeCreateStandingRequest = "create_standing_request"
+	EventTypeCancelStandingRequest = "cancel_standing_request"
+	EventTypeCloseStandingRequest  = "close_standing_request"
 
 	AttributeKeyID             = "id"
 	AttributeKeyDataSourceID   = "data_source_id"
@@ -29,4 +34,7 @@ const (
 	AttributeKeyResolveStatus  = "resolve_status"
 	AttributeKeyResult         = "result"
 	AttributeKeyReason         = "reason"
+	AttributeKeyPower          = "power"
+	AttributeKeyMissedReports  = "missed_reports"
+	AttributeKeyJailed         = "jailed"
 )
@@ -79,8 +79,9 @@ func (env *PrepareEnv) AskExternalData(eid int64, did int64, data []byte) error
 			return api.ErrDuplicateExternalID
 		}
 	}
+	// Data source version is filled in by the keeper once preparation is done.
 	env.rawRequests = append(env.rawRequests, NewRawRequest(
-		ExternalID(eid), DataSourceID(did), data,
+		ExternalID(eid), DataSourceID(did), data, 0,
 	))
 	return nil
 }
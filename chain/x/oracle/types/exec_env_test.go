@@ -31,7 +31,7 @@ func mockExecEnv() *ExecuteEnv {
 	requestHeight := int64(999)
 	requestTime := time.Unix(1581589700, 0)
 	clientID := "beeb"
-	request := NewRequest(oracleScriptID, calldata, valAddresses, minCount, requestHeight, requestTime, clientID, nil)
+	request := NewRequest(oracleScriptID, calldata, valAddresses, minCount, requestHeight, requestTime, clientID, nil, 0)
 	rawReport1 := NewRawReport(1, 0, []byte("DATA1"))
 	rawReport2 := NewRawReport(2, 1, []byte("DATA2"))
 	rawReport3 := NewRawReport(3, 0, []byte("DATA3"))
@@ -49,7 +49,7 @@ func mockFreshPrepareEnv() *PrepareEnv {
 	requestHeight := int64(999)
 	requestTime := time.Unix(1581589700, 0)
 	clientID := "beeb"
-	request := NewRequest(oracleScriptID, calldata, valAddresses, minCount, requestHeight, requestTime, clientID, nil)
+	request := NewRequest(oracleScriptID, calldata, valAddresses, minCount, requestHeight, requestTime, clientID, nil, 0)
 	env := NewPrepareEnv(request, 3)
 	return env
 }
@@ -164,9 +164,9 @@ func TestAskExternalData(t *testing.T) {
 
 	rawReq := env.GetRawRequests()
 	expectRawReq := []RawRequest{
-		NewRawRequest(1, 1, []byte("CALLDATA1")),
-		NewRawRequest(42, 2, []byte("CALLDATA2")),
-		NewRawRequest(3, 4, []byte("CALLDATA3")),
+		NewRawRequest(1, 1, []byte("CALLDATA1"), 0),
+		NewRawRequest(42, 2, []byte("CALLDATA2"), 0),
+		NewRawRequest(3, 4, []byte("CALLDATA3"), 0),
 	}
 	require.Equal(t, expectRawReq, rawReq)
 }
@@ -189,9 +189,9 @@ func TestAskTooManyExternalData(t *testing.T) {
 	require.NoError(t, err)
 
 	expectRawReq := []RawRequest{
-		NewRawRequest(1, 1, []byte("CALLDATA1")),
-		NewRawRequest(2, 2, []byte("CALLDATA2")),
-		NewRawRequest(3, 3, []byte("CALLDATA3")),
+		NewRawRequest(1, 1, []byte("CALLDATA1"), 0),
+		NewRawRequest(2, 2, []byte("CALLDATA2"), 0),
+		NewRawRequest(3, 3, []byte("CALLDATA3"), 0),
 	}
 	require.Equal(t, expectRawReq, penv.GetRawRequests())
 
@@ -222,9 +222,9 @@ func TestAskExternalDataOnExecEnv(t *testing.T) {
 func TestGetRawRequests(t *testing.T) {
 	env := mockAlreadyPreparedEnv()
 	expect := []RawRequest{
-		NewRawRequest(1, 1, []byte("CALLDATA1")),
-		NewRawRequest(2, 2, []byte("CALLDATA2")),
-		NewRawRequest(3, 3, []byte("CALLDATA3")),
+		NewRawRequest(1, 1, []byte("CALLDATA1"), 0),
+		NewRawRequest(2, 2, []byte("CALLDATA2"), 0),
+		NewRawRequest(3, 3, []byte("CALLDATA3"), 0),
 	}
 	require.Equal(t, expect, env.GetRawRequests())
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -13,10 +15,17 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
 }
 
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
 // SupplyKeeper defines the expected supply Keeper.
 type SupplyKeeper interface {
 	GetModuleAccount(ctx sdk.Context, name string) supplyexported.ModuleAccountI
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
 // StakingKeeper defines the expected staking keeper.
@@ -24,6 +33,9 @@ type StakingKeeper interface {
 	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingexported.ValidatorI
 	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingexported.ValidatorI) (stop bool))
 	Validator(ctx sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec)
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	BondDenom(ctx sdk.Context) string
 }
 
 // DistrKeeper defines the expected distribution keeper.
@@ -33,3 +45,8 @@ type DistrKeeper interface {
 	SetFeePool(ctx sdk.Context, feePool distr.FeePool)
 	AllocateTokensToValidator(ctx sdk.Context, val stakingexported.ValidatorI, tokens sdk.DecCoins)
 }
+
+// SlashingKeeper defines the expected slashing keeper.
+type SlashingKeeper interface {
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+}
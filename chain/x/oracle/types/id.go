@@ -11,3 +11,6 @@ type RequestID int64
 
 // ExternalID is the type-safe unique identifier type for raw data requests.
 type ExternalID int64
+
+// StandingRequestID is the type-safe unique identifier type for standing requests.
+type StandingRequestID int64
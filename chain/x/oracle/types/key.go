@@ -1,7 +1,11 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 const (
@@ -24,12 +28,18 @@ var (
 	RequestCountStoreKey = append(GlobalStoreKeyPrefix, []byte("RequestCount")...)
 	// RequestLastExpiredStoreKey is the key that keeps the ID of the last expired request, or 0 if none.
 	RequestLastExpiredStoreKey = append(GlobalStoreKeyPrefix, []byte("RequestLastExpired")...)
+	// RequestLastPrunedStoreKey is the key that keeps the ID of the last pruned request, or 0 if none.
+	RequestLastPrunedStoreKey = append(GlobalStoreKeyPrefix, []byte("RequestLastPruned")...)
 	// PendingResolveListStoreKey is the key that keeps the list of pending-resolve requests.
 	PendingResolveListStoreKey = append(GlobalStoreKeyPrefix, []byte("PendingList")...)
 	// DataSourceCountStoreKey is the key that keeps the total data source count.
 	DataSourceCountStoreKey = append(GlobalStoreKeyPrefix, []byte("DataSourceCount")...)
 	// OracleScriptCountStoreKey is the key that keeps the total oracle sciprt count.
 	OracleScriptCountStoreKey = append(GlobalStoreKeyPrefix, []byte("OracleScriptCount")...)
+	// StandingRequestCountStoreKey is the key that keeps the total standing request count.
+	StandingRequestCountStoreKey = append(GlobalStoreKeyPrefix, []byte("StandingRequestCount")...)
+	// ReportWindowStoreKey is the key that keeps the report window that the report infos are tracked with.
+	ReportWindowStoreKey = append(GlobalStoreKeyPrefix, []byte("ReportWindow")...)
 
 	// RequestStoreKeyPrefix is the prefix for request store.
 	RequestStoreKeyPrefix = []byte{0x01}
@@ -43,6 +53,31 @@ var (
 	ReporterStoreKeyPrefix = []byte{0x05}
 	// ValidatorStatusKeyPrefix is the prefix for validator status store.
 	ValidatorStatusKeyPrefix = []byte{0x06}
+	// StandingRequestStoreKeyPrefix is the prefix for standing request store.
+	StandingRequestStoreKeyPrefix = []byte{0x07}
+	// StandingRequestQueueKeyPrefix is the prefix for the queue of standing requests by next height.
+	StandingRequestQueueKeyPrefix = []byte{0x08}
+	// DataSourceVersionStoreKeyPrefix is the prefix for data source version history store.
+	DataSourceVersionStoreKeyPrefix = []byte{0x09}
+	// OracleScriptVersionStoreKeyPrefix is the prefix for oracle script version history store.
+	OracleScriptVersionStoreKeyPrefix = []byte{0x0a}
+	// ReportCommitStoreKeyPrefix is the prefix for report commit store.
+	ReportCommitStoreKeyPrefix = []byte{0x0b}
+	// ReportInfoStoreKeyPrefix is the prefix for validator report info store.
+	ReportInfoStoreKeyPrefix = []byte{0x0c}
+	// MissedReportBitArrayKeyPrefix is the prefix for the missed report bit array of validators.
+	MissedReportBitArrayKeyPrefix = []byte{0x0d}
+	// ValidatorReportStatStoreKeyPrefix is the prefix for validator report stat store.
+	ValidatorReportStatStoreKeyPrefix = []byte{0x0e}
+	// RequestsByOracleScriptKeyPrefix is the prefix for the index of requests by oracle script.
+	RequestsByOracleScriptKeyPrefix = []byte{0x0f}
+	// RequestsByClientIDKeyPrefix is the prefix for the index of requests by client ID.
+	RequestsByClientIDKeyPrefix = []byte{0x10}
+	// RequestsByResolveStatusKeyPrefix is the prefix for the index of requests by resolve status.
+	RequestsByResolveStatusKeyPrefix = []byte{0x11}
+	// RequestsBySpecKeyPrefix is the prefix for the index of requests by oracle script, calldata,
+	// ask count, and min count.
+	RequestsBySpecKeyPrefix = []byte{0x12}
 	// ResultStoreKeyPrefix is the prefix for request result store.
 	ResultStoreKeyPrefix = []byte{0xff}
 )
@@ -67,6 +102,32 @@ func OracleScriptStoreKey(oracleScriptID OracleScriptID) []byte {
 	return append(OracleScriptStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(oracleScriptID))...)
 }
 
+// DataSourceVersionStoreKey returns the key to retrieve a specific version of a data source.
+func DataSourceVersionStoreKey(id DataSourceID, version int64) []byte {
+	return append(DataSourceVersionsPrefixKey(id), sdk.Uint64ToBigEndian(uint64(version))...)
+}
+
+// DataSourceVersionsPrefixKey returns the prefix key to get all versions of a data source.
+func DataSourceVersionsPrefixKey(id DataSourceID) []byte {
+	return append(DataSourceVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// OracleScriptVersionStoreKey returns the key to retrieve a specific version of an oracle script.
+func OracleScriptVersionStoreKey(id OracleScriptID, version int64) []byte {
+	return append(OracleScriptVersionsPrefixKey(id), sdk.Uint64ToBigEndian(uint64(version))...)
+}
+
+// OracleScriptVersionsPrefixKey returns the prefix key to get all versions of an oracle script.
+func OracleScriptVersionsPrefixKey(id OracleScriptID) []byte {
+	return append(OracleScriptVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// VersionFromStoreKey returns the version number encoded in the given data source or oracle
+// script version store key.
+func VersionFromStoreKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[9:17]))
+}
+
 // ReporterStoreKey returns the key to check whether an address is a reporter of a validator.
 func ReporterStoreKey(validatorAddress sdk.ValAddress, reporterAddress sdk.AccAddress) []byte {
 	buf := append(ReporterStoreKeyPrefix, []byte(validatorAddress)...)
@@ -79,11 +140,117 @@ func ValidatorStatusStoreKey(v sdk.ValAddress) []byte {
 	return append(ValidatorStatusKeyPrefix, v.Bytes()...)
 }
 
+// ReportInfoStoreKey returns the key to a validator's report info.
+func ReportInfoStoreKey(v sdk.ValAddress) []byte {
+	return append(ReportInfoStoreKeyPrefix, v.Bytes()...)
+}
+
+// MissedReportBitArrayPrefixKey returns the prefix key to get the missed report bit array of a validator.
+func MissedReportBitArrayPrefixKey(v sdk.ValAddress) []byte {
+	return append(MissedReportBitArrayKeyPrefix, v.Bytes()...)
+}
+
+// MissedReportBitArrayKey returns the key to an entry of a validator's missed report bit array.
+func MissedReportBitArrayKey(v sdk.ValAddress, index int64) []byte {
+	return append(MissedReportBitArrayPrefixKey(v), sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// ValidatorReportStatStoreKey returns the key to a validator's report stat.
+func ValidatorReportStatStoreKey(v sdk.ValAddress) []byte {
+	return append(ValidatorReportStatStoreKeyPrefix, v.Bytes()...)
+}
+
+// RequestsByOracleScriptPrefixKey returns the prefix key to get the IDs of all requests to the
+// given oracle script.
+func RequestsByOracleScriptPrefixKey(id OracleScriptID) []byte {
+	return append(RequestsByOracleScriptKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// RequestsByOracleScriptKey returns the index key of the given request to the given oracle script.
+func RequestsByOracleScriptKey(id OracleScriptID, reqID RequestID) []byte {
+	return append(RequestsByOracleScriptPrefixKey(id), sdk.Uint64ToBigEndian(uint64(reqID))...)
+}
+
+// RequestsByClientIDPrefixKey returns the prefix key to get the IDs of all requests with the
+// given client ID. The client ID is length-prefixed so that no client ID is a prefix of another.
+func RequestsByClientIDPrefixKey(clientID string) []byte {
+	buf := append(RequestsByClientIDKeyPrefix, byte(len(clientID)))
+	return append(buf, []byte(clientID)...)
+}
+
+// RequestsByClientIDKey returns the index key of the given request with the given client ID.
+func RequestsByClientIDKey(clientID string, reqID RequestID) []byte {
+	return append(RequestsByClientIDPrefixKey(clientID), sdk.Uint64ToBigEndian(uint64(reqID))...)
+}
+
+// RequestsByResolveStatusPrefixKey returns the prefix key to get the IDs of all requests with
+// the given resolve status.
+func RequestsByResolveStatusPrefixKey(status ResolveStatus) []byte {
+	return append(RequestsByResolveStatusKeyPrefix, byte(status))
+}
+
+// RequestsByResolveStatusKey returns the index key of the given request with the given resolve status.
+func RequestsByResolveStatusKey(status ResolveStatus, reqID RequestID) []byte {
+	return append(RequestsByResolveStatusPrefixKey(status), sdk.Uint64ToBigEndian(uint64(reqID))...)
+}
+
+// RequestsBySpecPrefixKey returns the prefix key to get the IDs of all requests to the given
+// oracle script with the given calldata, ask count, and min count. The calldata is hashed so
+// that the key has a fixed size.
+func RequestsBySpecPrefixKey(id OracleScriptID, calldata []byte, askCount, minCount uint64) []byte {
+	buf := append(RequestsBySpecKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
+	buf = append(buf, crypto.Sha256(calldata)...)
+	buf = append(buf, sdk.Uint64ToBigEndian(askCount)...)
+	return append(buf, sdk.Uint64ToBigEndian(minCount)...)
+}
+
+// RequestsBySpecKey returns the index key of the given request with the given specification.
+func RequestsBySpecKey(
+	id OracleScriptID, calldata []byte, askCount, minCount uint64, reqID RequestID,
+) []byte {
+	return append(RequestsBySpecPrefixKey(id, calldata, askCount, minCount), sdk.Uint64ToBigEndian(uint64(reqID))...)
+}
+
+// RequestIDFromIndexKey returns the request ID encoded in the last 8 bytes of the given request
+// index key.
+func RequestIDFromIndexKey(key []byte) RequestID {
+	return RequestID(binary.BigEndian.Uint64(key[len(key)-8:]))
+}
+
 // ResultStoreKey returns the key to a request result in the store.
 func ResultStoreKey(requestID RequestID) []byte {
 	return append(ResultStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
 }
 
+// StandingRequestStoreKey returns the key to retrieve a specific standing request from the store.
+func StandingRequestStoreKey(id StandingRequestID) []byte {
+	return append(StandingRequestStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// StandingRequestQueueKey returns the key of a standing request in the queue that is due at
+// the given height. Queue keys sort by height first, then by standing request ID.
+func StandingRequestQueueKey(height int64, id StandingRequestID) []byte {
+	buf := append(StandingRequestQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(buf, sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// StandingRequestIDFromQueueKey returns the standing request ID encoded in the given queue key.
+func StandingRequestIDFromQueueKey(key []byte) StandingRequestID {
+	return StandingRequestID(binary.BigEndian.Uint64(key[9:17]))
+}
+
+// StandingRequestEscrowAddress returns the address that holds the remaining budget of the
+// given standing request. Nobody has the private key to this address.
+func StandingRequestEscrowAddress(id StandingRequestID) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/standing/%d", ModuleName, id))))
+}
+
+// RequestIDFromStoreKey returns the request ID encoded in the given request, report, or result
+// store key. The key must start with a 1-byte prefix followed by the big endian request ID.
+func RequestIDFromStoreKey(key []byte) RequestID {
+	return RequestID(binary.BigEndian.Uint64(key[1:9]))
+}
+
 // ReportsOfValidatorPrefixKey returns the prefix key to get all reports for a request from a validator.
 func ReportsOfValidatorPrefixKey(reqID RequestID, val sdk.ValAddress) []byte {
 	buf := append(ReportStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(reqID))...)
@@ -91,6 +258,16 @@ func ReportsOfValidatorPrefixKey(reqID RequestID, val sdk.ValAddress) []byte {
 	return buf
 }
 
+// ReportCommitStoreKey returns the key to retrieve all report commits for a request.
+func ReportCommitStoreKey(requestID RequestID) []byte {
+	return append(ReportCommitStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
+
+// ReportCommitOfValidatorKey returns the key to retrieve the report commit of a validator for a request.
+func ReportCommitOfValidatorKey(reqID RequestID, val sdk.ValAddress) []byte {
+	return append(ReportCommitStoreKey(reqID), val.Bytes()...)
+}
+
 // ReportersOfValidatorPrefixKey returns the prefix key to get all reporters of a validator.
 func ReportersOfValidatorPrefixKey(val sdk.ValAddress) []byte {
 	return append(ReporterStoreKeyPrefix, val.Bytes()...)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"testing"
 
@@ -57,3 +58,48 @@ func TestReportersOfValidatorPrefixKey(t *testing.T) {
 	expect, _ := hex.DecodeString("05b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
 	require.Equal(t, expect, ReportersOfValidatorPrefixKey(val))
 }
+
+func TestStandingRequestQueueKey(t *testing.T) {
+	key := StandingRequestQueueKey(42, 7)
+	require.Equal(t, StandingRequestID(7), StandingRequestIDFromQueueKey(key))
+	// Queue keys must sort by height before standing request ID.
+	require.True(t, bytes.Compare(StandingRequestQueueKey(1, 100), StandingRequestQueueKey(2, 1)) < 0)
+}
+
+func TestDataSourceVersionStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0900000000000000140000000000000003")
+	require.Equal(t, expect, DataSourceVersionStoreKey(20, 3))
+	require.Equal(t, int64(3), VersionFromStoreKey(expect))
+}
+
+func TestOracleScriptVersionStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0a00000000000000140000000000000003")
+	require.Equal(t, expect, OracleScriptVersionStoreKey(20, 3))
+	require.Equal(t, int64(3), VersionFromStoreKey(expect))
+}
+
+func TestMissedReportBitArrayKey(t *testing.T) {
+	val, _ := sdk.ValAddressFromHex("b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
+	expect, _ := hex.DecodeString("0db80f2a5df7d5710b15622d1a9f1e3830ded5bda80000000000000007")
+	require.Equal(t, expect, MissedReportBitArrayKey(val, 7))
+}
+
+func TestRequestsByOracleScriptKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0f00000000000000140000000000000003")
+	require.Equal(t, expect, RequestsByOracleScriptKey(20, 3))
+	require.Equal(t, RequestID(3), RequestIDFromIndexKey(expect))
+}
+
+func TestRequestsByClientIDKey(t *testing.T) {
+	expect, _ := hex.DecodeString("1004626565620000000000000003")
+	require.Equal(t, expect, RequestsByClientIDKey("beeb", 3))
+	require.Equal(t, RequestID(3), RequestIDFromIndexKey(expect))
+	// Client IDs that are prefixes of each other must not share index prefixes.
+	require.False(t, bytes.HasPrefix(RequestsByClientIDKey("beebbeeb", 3), RequestsByClientIDPrefixKey("beeb")))
+}
+
+func TestRequestsByResolveStatusKey(t *testing.T) {
+	expect, _ := hex.DecodeString("11010000000000000003")
+	require.Equal(t, expect, RequestsByResolveStatusKey(ResolveStatus_Success, 3))
+	require.Equal(t, RequestID(3), RequestIDFromIndexKey(expect))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -33,6 +34,9 @@ func (msg MsgRequestData) ValidateBasic() error {
 	if len(msg.ClientID) > MaxClientIDLength {
 		return WrapMaxError(ErrTooLongClientID, len(msg.ClientID), MaxClientIDLength)
 	}
+	if _, err := sdk.ParseCoins(msg.FeeLimit); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee limit: %s", msg.FeeLimit)
+	}
 	return nil
 }
 
@@ -86,6 +90,58 @@ func (msg MsgReportData) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
+// Route implements the sdk.Msg interface for MsgCommitReport.
+func (msg MsgCommitReport) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface for MsgCommitReport.
+func (msg MsgCommitReport) Type() string { return "commit_report" }
+
+// ValidateBasic implements the sdk.Msg interface for MsgCommitReport.
+func (msg MsgCommitReport) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Validator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "validator: %s", msg.Validator)
+	}
+	if err := sdk.VerifyAddressFormat(msg.Reporter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "reporter: %s", msg.Reporter)
+	}
+	if len(msg.CommitHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidCommitHash, "length: %d", len(msg.CommitHash))
+	}
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface for MsgCommitReport.
+func (msg MsgCommitReport) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Reporter}
+}
+
+// GetSignBytes implements the sdk.Msg interface for MsgCommitReport.
+func (msg MsgCommitReport) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the sdk.Msg interface for MsgRevealReport.
+func (msg MsgRevealReport) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface for MsgRevealReport.
+func (msg MsgRevealReport) Type() string { return "reveal_report" }
+
+// ValidateBasic implements the sdk.Msg interface for MsgRevealReport.
+func (msg MsgRevealReport) ValidateBasic() error {
+	// A revealed report must pass the same checks as a plain report.
+	return NewMsgReportData(msg.RequestID, msg.RawReports, msg.Validator, msg.Reporter).ValidateBasic()
+}
+
+// GetSigners implements the sdk.Msg interface for MsgRevealReport.
+func (msg MsgRevealReport) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Reporter}
+}
+
+// GetSignBytes implements the sdk.Msg interface for MsgRevealReport.
+func (msg MsgRevealReport) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
 // Route implements the sdk.Msg interface for MsgCreateDataSource.
 func (msg MsgCreateDataSource) Route() string { return RouterKey }
 
@@ -115,6 +171,9 @@ func (msg MsgCreateDataSource) ValidateBasic() error {
 	if bytes.Equal(msg.Executable, DoNotModifyBytes) {
 		return ErrCreateWithDoNotModify
 	}
+	if _, err := sdk.ParseCoins(msg.Fee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee: %s", msg.Fee)
+	}
 	return nil
 }
 
@@ -154,6 +213,11 @@ func (msg MsgEditDataSource) ValidateBasic() error {
 	if len(msg.Executable) > MaxExecutableSize {
 		return WrapMaxError(ErrTooLargeExecutable, len(msg.Executable), MaxExecutableSize)
 	}
+	if msg.Fee != DoNotModify {
+		if _, err := sdk.ParseCoins(msg.Fee); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee: %s", msg.Fee)
+		}
+	}
 	return nil
 }
 
@@ -343,3 +407,72 @@ func (msg MsgRemoveReporter) GetSigners() []sdk.AccAddress {
 func (msg MsgRemoveReporter) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
+
+// Route implements the sdk.Msg interface for MsgCreateStandingRequest.
+func (msg MsgCreateStandingRequest) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface for MsgCreateStandingRequest.
+func (msg MsgCreateStandingRequest) Type() string { return "create_standing_request" }
+
+// ValidateBasic implements the sdk.Msg interface for MsgCreateStandingRequest.
+func (msg MsgCreateStandingRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "sender: %s", msg.Sender)
+	}
+	if len(msg.Calldata) > MaxDataSize {
+		return WrapMaxError(ErrTooLargeCalldata, len(msg.Calldata), MaxDataSize)
+	}
+	if msg.MinCount <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidMinCount, "got: %d", msg.MinCount)
+	}
+	if msg.AskCount < msg.MinCount {
+		return sdkerrors.Wrapf(ErrInvalidAskCount, "got: %d, min count: %d", msg.AskCount, msg.MinCount)
+	}
+	if len(msg.ClientID) > MaxClientIDLength {
+		return WrapMaxError(ErrTooLongClientID, len(msg.ClientID), MaxClientIDLength)
+	}
+	if msg.Interval <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidInterval, "got: %d", msg.Interval)
+	}
+	if _, err := sdk.ParseCoins(msg.Budget); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "budget: %s", msg.Budget)
+	}
+	if _, err := sdk.ParseCoins(msg.FeeLimit); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee limit: %s", msg.FeeLimit)
+	}
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface for MsgCreateStandingRequest.
+func (msg MsgCreateStandingRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface for MsgCreateStandingRequest.
+func (msg MsgCreateStandingRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the sdk.Msg interface for MsgCancelStandingRequest.
+func (msg MsgCancelStandingRequest) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface for MsgCancelStandingRequest.
+func (msg MsgCancelStandingRequest) Type() string { return "cancel_standing_request" }
+
+// ValidateBasic implements the sdk.Msg interface for MsgCancelStandingRequest.
+func (msg MsgCancelStandingRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "sender: %s", msg.Sender)
+	}
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface for MsgCancelStandingRequest.
+func (msg MsgCancelStandingRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface for MsgCancelStandingRequest.
+func (msg MsgCancelStandingRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
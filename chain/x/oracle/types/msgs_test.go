@@ -43,9 +43,13 @@ func TestMsgRoute(t *testing.T) {
 	require.Equal(t, "oracle", MsgEditOracleScript{}.Route())
 	require.Equal(t, "oracle", MsgRequestData{}.Route())
 	require.Equal(t, "oracle", MsgReportData{}.Route())
+	require.Equal(t, "oracle", MsgCommitReport{}.Route())
+	require.Equal(t, "oracle", MsgRevealReport{}.Route())
 	require.Equal(t, "oracle", MsgActivate{}.Route())
 	require.Equal(t, "oracle", MsgAddReporter{}.Route())
 	require.Equal(t, "oracle", MsgRemoveReporter{}.Route())
+	require.Equal(t, "oracle", MsgCreateStandingRequest{}.Route())
+	require.Equal(t, "oracle", MsgCancelStandingRequest{}.Route())
 }
 
 func TestMsgType(t *testing.T) {
@@ -55,9 +59,13 @@ func TestMsgType(t *testing.T) {
 	require.Equal(t, "edit_oracle_script", MsgEditOracleScript{}.Type())
 	require.Equal(t, "request", MsgRequestData{}.Type())
 	require.Equal(t, "report", MsgReportData{}.Type())
+	require.Equal(t, "commit_report", MsgCommitReport{}.Type())
+	require.Equal(t, "reveal_report", MsgRevealReport{}.Type())
 	require.Equal(t, "activate", MsgActivate{}.Type())
 	require.Equal(t, "add_reporter", MsgAddReporter{}.Type())
 	require.Equal(t, "remove_reporter", MsgRemoveReporter{}.Type())
+	require.Equal(t, "create_standing_request", MsgCreateStandingRequest{}.Type())
+	require.Equal(t, "cancel_standing_request", MsgCancelStandingRequest{}.Type())
 }
 
 func TestMsgGetSigners(t *testing.T) {
@@ -66,15 +74,17 @@ func TestMsgGetSigners(t *testing.T) {
 	anotherAcc := sdk.AccAddress([]byte("98765432109876543210"))
 	anotherVal := sdk.ValAddress([]byte("98765432109876543210"))
 	signers := []sdk.AccAddress{signerAcc}
-	require.Equal(t, signers, NewMsgCreateDataSource(anotherAcc, "name", "desc", []byte("exec"), signerAcc).GetSigners())
-	require.Equal(t, signers, NewMsgEditDataSource(1, anotherAcc, "name", "desc", []byte("exec"), signerAcc).GetSigners())
+	require.Equal(t, signers, NewMsgCreateDataSource(anotherAcc, "name", "desc", []byte("exec"), signerAcc, "").GetSigners())
+	require.Equal(t, signers, NewMsgEditDataSource(1, anotherAcc, "name", "desc", []byte("exec"), signerAcc, "").GetSigners())
 	require.Equal(t, signers, NewMsgCreateOracleScript(anotherAcc, "name", "desc", []byte("code"), "schema", "url", signerAcc).GetSigners())
 	require.Equal(t, signers, NewMsgEditOracleScript(1, anotherAcc, "name", "desc", []byte("code"), "schema", "url", signerAcc).GetSigners())
-	require.Equal(t, signers, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", signerAcc).GetSigners())
+	require.Equal(t, signers, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", signerAcc, "").GetSigners())
 	require.Equal(t, signers, NewMsgReportData(1, []RawReport{{1, 1, []byte("data1")}, {2, 2, []byte("data2")}}, anotherVal, signerAcc).GetSigners())
 	require.Equal(t, signers, NewMsgActivate(signerVal).GetSigners())
 	require.Equal(t, signers, NewMsgAddReporter(signerVal, anotherAcc).GetSigners())
 	require.Equal(t, signers, NewMsgRemoveReporter(signerVal, anotherAcc).GetSigners())
+	require.Equal(t, signers, NewMsgCreateStandingRequest(1, []byte("calldata"), 10, 5, "client-id", 10, "", "", signerAcc).GetSigners())
+	require.Equal(t, signers, NewMsgCancelStandingRequest(1, signerAcc).GetSigners())
 }
 
 func TestMsgGetSignBytes(t *testing.T) {
@@ -83,11 +93,11 @@ func TestMsgGetSignBytes(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode("band"+sdk.PrefixValidator+sdk.PrefixConsensus, "band"+sdk.PrefixValidator+sdk.PrefixConsensus+sdk.PrefixPublic)
 	require.Equal(t,
 		`{"type":"oracle/CreateDataSource","value":{"description":"desc","executable":"ZXhlYw==","name":"name","owner":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4","sender":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4"}}`,
-		string(NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr).GetSignBytes()),
+		string(NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "").GetSignBytes()),
 	)
 	require.Equal(t,
 		`{"type":"oracle/EditDataSource","value":{"data_source_id":"1","description":"desc","executable":"ZXhlYw==","name":"name","owner":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4","sender":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4"}}`,
-		string(NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr).GetSignBytes()),
+		string(NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "").GetSignBytes()),
 	)
 	require.Equal(t,
 		`{"type":"oracle/CreateOracleScript","value":{"code":"Y29kZQ==","description":"desc","name":"name","owner":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4","schema":"schema","sender":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4","source_code_url":"url"}}`,
@@ -99,7 +109,7 @@ func TestMsgGetSignBytes(t *testing.T) {
 	)
 	require.Equal(t,
 		`{"type":"oracle/Request","value":{"ask_count":"10","calldata":"Y2FsbGRhdGE=","client_id":"client-id","min_count":"5","oracle_script_id":"1","sender":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4"}}`,
-		string(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodTestAddr).GetSignBytes()),
+		string(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodTestAddr, "").GetSignBytes()),
 	)
 	require.Equal(t,
 		`{"type":"oracle/Report","value":{"raw_reports":[{"data":"ZGF0YTE=","exit_code":1,"external_id":"1"},{"data":"ZGF0YTI=","exit_code":2,"external_id":"2"}],"reporter":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4","request_id":"1","validator":"bandvaloper1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqx6y767"}}`,
@@ -117,30 +127,44 @@ func TestMsgGetSignBytes(t *testing.T) {
 		`{"type":"oracle/RemoveReporter","value":{"reporter":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4","validator":"bandvaloper1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqx6y767"}}`,
 		string(NewMsgRemoveReporter(GoodTestValAddr, GoodTestAddr).GetSignBytes()),
 	)
+	require.Equal(t,
+		`{"type":"oracle/CreateStandingRequest","value":{"ask_count":"10","budget":"100uband","calldata":"Y2FsbGRhdGE=","client_id":"client-id","interval":"10","min_count":"5","oracle_script_id":"1","sender":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4"}}`,
+		string(NewMsgCreateStandingRequest(1, []byte("calldata"), 10, 5, "client-id", 10, "100uband", "", GoodTestAddr).GetSignBytes()),
+	)
+	require.Equal(t,
+		`{"type":"oracle/CancelStandingRequest","value":{"sender":"band1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq2vqal4","standing_request_id":"1"}}`,
+		string(NewMsgCancelStandingRequest(1, GoodTestAddr).GetSignBytes()),
+	)
 }
 
 func TestMsgCreateDataSourceValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
-		{true, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr)},
-		{false, NewMsgCreateDataSource(BadTestAddr, "name", "desc", []byte("exec"), GoodTestAddr)},
-		{false, NewMsgCreateDataSource(GoodTestAddr, strings.Repeat("x", 200), "desc", []byte("exec"), GoodTestAddr)},
-		{false, NewMsgCreateDataSource(GoodTestAddr, "name", strings.Repeat("x", 5000), []byte("exec"), GoodTestAddr)},
-		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte{}, GoodTestAddr)},
-		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte(strings.Repeat("x", 20000)), GoodTestAddr)},
-		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", DoNotModifyBytes, GoodTestAddr)},
-		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte("exec"), BadTestAddr)},
+		{true, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "")},
+		{false, NewMsgCreateDataSource(BadTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "")},
+		{false, NewMsgCreateDataSource(GoodTestAddr, strings.Repeat("x", 200), "desc", []byte("exec"), GoodTestAddr, "")},
+		{false, NewMsgCreateDataSource(GoodTestAddr, "name", strings.Repeat("x", 5000), []byte("exec"), GoodTestAddr, "")},
+		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte{}, GoodTestAddr, "")},
+		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte(strings.Repeat("x", 20000)), GoodTestAddr, "")},
+		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", DoNotModifyBytes, GoodTestAddr, "")},
+		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte("exec"), BadTestAddr, "")},
+		{true, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "10uband")},
+		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "10")},
+		{false, NewMsgCreateDataSource(GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, DoNotModify)},
 	})
 }
 
 func TestMsgEditDataSourceValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
-		{true, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr)},
-		{false, NewMsgEditDataSource(1, BadTestAddr, "name", "desc", []byte("exec"), GoodTestAddr)},
-		{false, NewMsgEditDataSource(1, GoodTestAddr, strings.Repeat("x", 200), "desc", []byte("exec"), GoodTestAddr)},
-		{false, NewMsgEditDataSource(1, GoodTestAddr, "name", strings.Repeat("x", 5000), []byte("exec"), GoodTestAddr)},
-		{false, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte{}, GoodTestAddr)},
-		{false, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte(strings.Repeat("x", 20000)), GoodTestAddr)},
-		{false, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte("exec"), BadTestAddr)},
+		{true, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "")},
+		{false, NewMsgEditDataSource(1, BadTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "")},
+		{false, NewMsgEditDataSource(1, GoodTestAddr, strings.Repeat("x", 200), "desc", []byte("exec"), GoodTestAddr, "")},
+		{false, NewMsgEditDataSource(1, GoodTestAddr, "name", strings.Repeat("x", 5000), []byte("exec"), GoodTestAddr, "")},
+		{false, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte{}, GoodTestAddr, "")},
+		{false, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte(strings.Repeat("x", 20000)), GoodTestAddr, "")},
+		{false, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte("exec"), BadTestAddr, "")},
+		{true, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "10uband")},
+		{true, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, DoNotModify)},
+		{false, NewMsgEditDataSource(1, GoodTestAddr, "name", "desc", []byte("exec"), GoodTestAddr, "-10uband")},
 	})
 }
 
@@ -175,12 +199,14 @@ func TestMsgEditOracleScriptValidation(t *testing.T) {
 
 func TestMsgRequestDataValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
-		{true, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodTestAddr)},
-		{false, NewMsgRequestData(1, []byte(strings.Repeat("x", 2000)), 10, 5, "client-id", GoodTestAddr)},
-		{false, NewMsgRequestData(1, []byte("calldata"), 2, 5, "client-id", GoodTestAddr)},
-		{false, NewMsgRequestData(1, []byte("calldata"), 0, 0, "client-id", GoodTestAddr)},
-		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, strings.Repeat("x", 300), GoodTestAddr)},
-		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", BadTestAddr)},
+		{true, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodTestAddr, "")},
+		{false, NewMsgRequestData(1, []byte(strings.Repeat("x", 2000)), 10, 5, "client-id", GoodTestAddr, "")},
+		{false, NewMsgRequestData(1, []byte("calldata"), 2, 5, "client-id", GoodTestAddr, "")},
+		{false, NewMsgRequestData(1, []byte("calldata"), 0, 0, "client-id", GoodTestAddr, "")},
+		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, strings.Repeat("x", 300), GoodTestAddr, "")},
+		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", BadTestAddr, "")},
+		{true, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodTestAddr, "100uband")},
+		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodTestAddr, "uband")},
 	})
 }
 
@@ -195,6 +221,28 @@ func TestMsgReportDataValidation(t *testing.T) {
 	})
 }
 
+func TestMsgCommitReportValidation(t *testing.T) {
+	hash := make([]byte, 32)
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCommitReport(1, hash, GoodTestValAddr, GoodTestAddr)},
+		{false, NewMsgCommitReport(1, hash[:31], GoodTestValAddr, GoodTestAddr)},
+		{false, NewMsgCommitReport(1, nil, GoodTestValAddr, GoodTestAddr)},
+		{false, NewMsgCommitReport(1, hash, BadTestValAddr, GoodTestAddr)},
+		{false, NewMsgCommitReport(1, hash, GoodTestValAddr, BadTestAddr)},
+	})
+}
+
+func TestMsgRevealReportValidation(t *testing.T) {
+	salt := []byte("salt")
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgRevealReport(1, []RawReport{{1, 1, []byte("data1")}, {2, 2, []byte("data2")}}, salt, GoodTestValAddr, GoodTestAddr)},
+		{false, NewMsgRevealReport(1, []RawReport{}, salt, GoodTestValAddr, GoodTestAddr)},
+		{false, NewMsgRevealReport(1, []RawReport{{1, 1, []byte("data1")}, {1, 1, []byte("data2")}}, salt, GoodTestValAddr, GoodTestAddr)},
+		{false, NewMsgRevealReport(1, []RawReport{{1, 1, []byte("data1")}}, salt, BadTestValAddr, GoodTestAddr)},
+		{false, NewMsgRevealReport(1, []RawReport{{1, 1, []byte("data1")}}, salt, GoodTestValAddr, BadTestAddr)},
+	})
+}
+
 func TestMsgActivateValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
 		{true, NewMsgActivate(GoodTestValAddr)},
@@ -219,3 +267,24 @@ func TestMsgRemoveReporterValidation(t *testing.T) {
 		{false, NewMsgRemoveReporter(GoodTestValAddr, GoodTestAddr)},
 	})
 }
+
+func TestMsgCreateStandingRequestValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCreateStandingRequest(1, []byte("calldata"), 10, 5, "client-id", 10, "100uband", "10uband", GoodTestAddr)},
+		{false, NewMsgCreateStandingRequest(1, []byte(strings.Repeat("x", 2000)), 10, 5, "client-id", 10, "", "", GoodTestAddr)},
+		{false, NewMsgCreateStandingRequest(1, []byte("calldata"), 2, 5, "client-id", 10, "", "", GoodTestAddr)},
+		{false, NewMsgCreateStandingRequest(1, []byte("calldata"), 0, 0, "client-id", 10, "", "", GoodTestAddr)},
+		{false, NewMsgCreateStandingRequest(1, []byte("calldata"), 10, 5, strings.Repeat("x", 300), 10, "", "", GoodTestAddr)},
+		{false, NewMsgCreateStandingRequest(1, []byte("calldata"), 10, 5, "client-id", 0, "", "", GoodTestAddr)},
+		{false, NewMsgCreateStandingRequest(1, []byte("calldata"), 10, 5, "client-id", 10, "uband", "", GoodTestAddr)},
+		{false, NewMsgCreateStandingRequest(1, []byte("calldata"), 10, 5, "client-id", 10, "", "uband", GoodTestAddr)},
+		{false, NewMsgCreateStandingRequest(1, []byte("calldata"), 10, 5, "client-id", 10, "", "", BadTestAddr)},
+	})
+}
+
+func TestMsgCancelStandingRequestValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCancelStandingRequest(1, GoodTestAddr)},
+		{false, NewMsgCancelStandingRequest(1, BadTestAddr)},
+	})
+}
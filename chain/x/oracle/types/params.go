@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -9,40 +10,97 @@ import (
 
 // nolint
 const (
-	DefaultParamspace              = ModuleName
-	DefaultMaxRawRequestCount      = uint64(16)
-	DefaultMaxAskCount             = uint64(16)
-	DefaultExpirationBlockCount    = uint64(100)
-	DefaultBaseRequestGas          = uint64(150000)
-	DefaultPerValidatorRequestGas  = uint64(30000)
-	DefaultSamplingTryCount        = uint64(3)
-	DefaultOracleRewardPercentage  = uint64(70)
-	DefaultInactivePenaltyDuration = uint64(10 * time.Minute)
+	DefaultParamspace                     = ModuleName
+	DefaultMaxRawRequestCount             = uint64(16)
+	DefaultMaxAskCount                    = uint64(16)
+	DefaultExpirationBlockCount           = uint64(100)
+	DefaultBaseRequestGas                 = uint64(150000)
+	DefaultPerValidatorRequestGas         = uint64(30000)
+	DefaultSamplingTryCount               = uint64(3)
+	DefaultOracleRewardPercentage         = uint64(70)
+	DefaultInactivePenaltyDuration        = uint64(10 * time.Minute)
+	DefaultRequestRetentionBlockCount     = uint64(0)
+	DefaultMaxPruneCountPerBlock          = uint64(100)
+	DefaultPruneResult                    = false
+	DefaultCommitBlockCount               = uint64(0)
+	DefaultReportWindow                   = uint64(100)
+	DefaultMinReportPercentage            = uint64(50)
+	DefaultMissedReportSlashPercentage    = uint64(1)
+	DefaultRewardWeightingMode            = RewardWeightingVotingPower
+	DefaultInBeforeResolveBonusPercentage = uint64(20)
+	DefaultStandingRequestFee             = uint64(1000)
+	DefaultMinStandingRequestInterval     = uint64(10)
+	DefaultMaxStandingRequestsPerBlock    = uint64(10)
+	DefaultMaxStandingRequestFailures     = uint64(10)
+	DefaultMissedReportJailDuration       = uint64(10 * time.Minute)
+	DefaultRewardPeriod                   = uint64(100)
+)
+
+// Reward weighting modes that determine how oracle rewards are split among active validators.
+const (
+	// RewardWeightingVotingPower weights the reward by the validators' voting power.
+	RewardWeightingVotingPower = uint64(0)
+	// RewardWeightingReports weights the reward by the number of reports delivered in the
+	// reward period, which lasts RewardPeriod blocks.
+	RewardWeightingReports = uint64(1)
+	// RewardWeightingReportsWithBonus is similar to RewardWeightingReports, but each report that
+	// arrives before its request is resolved earns an additional InBeforeResolveBonusPercentage.
+	RewardWeightingReportsWithBonus = uint64(2)
 )
 
 // nolint
 var (
-	KeyMaxRawRequestCount      = []byte("MaxRawRequestCount")
-	KeyMaxAskCount             = []byte("MaxAskCount")
-	KeyExpirationBlockCount    = []byte("ExpirationBlockCount")
-	KeyBaseRequestGas          = []byte("BaseRequestGas")
-	KeyPerValidatorRequestGas  = []byte("PerValidatorRequestGas")
-	KeySamplingTryCount        = []byte("SamplingTryCount")
-	KeyOracleRewardPercentage  = []byte("OracleRewardPercentage")
-	KeyInactivePenaltyDuration = []byte("InactivePenaltyDuration")
+	KeyMaxRawRequestCount             = []byte("MaxRawRequestCount")
+	KeyMaxAskCount                    = []byte("MaxAskCount")
+	KeyExpirationBlockCount           = []byte("ExpirationBlockCount")
+	KeyBaseRequestGas                 = []byte("BaseRequestGas")
+	KeyPerValidatorRequestGas         = []byte("PerValidatorRequestGas")
+	KeySamplingTryCount               = []byte("SamplingTryCount")
+	KeyOracleRewardPercentage         = []byte("OracleRewardPercentage")
+	KeyInactivePenaltyDuration        = []byte("InactivePenaltyDuration")
+	KeyRequestRetentionBlockCount     = []byte("RequestRetentionBlockCount")
+	KeyMaxPruneCountPerBlock          = []byte("MaxPruneCountPerBlock")
+	KeyPruneResult                    = []byte("PruneResult")
+	KeyCommitBlockCount               = []byte("CommitBlockCount")
+	KeyReportWindow                   = []byte("ReportWindow")
+	KeyMinReportPercentage            = []byte("MinReportPercentage")
+	KeyMissedReportSlashPercentage    = []byte("MissedReportSlashPercentage")
+	KeyRewardWeightingMode            = []byte("RewardWeightingMode")
+	KeyInBeforeResolveBonusPercentage = []byte("InBeforeResolveBonusPercentage")
+	KeyStandingRequestFee             = []byte("StandingRequestFee")
+	KeyMinStandingRequestInterval     = []byte("MinStandingRequestInterval")
+	KeyMaxStandingRequestsPerBlock    = []byte("MaxStandingRequestsPerBlock")
+	KeyMaxStandingRequestFailures     = []byte("MaxStandingRequestFailures")
+	KeyMissedReportJailDuration       = []byte("MissedReportJailDuration")
+	KeyRewardPeriod                   = []byte("RewardPeriod")
 )
 
 // String implements the stringer interface for Params.
 func (p Params) String() string {
 	return fmt.Sprintf(`oracle Params:
-  MaxRawRequestCount:      %d
-  MaxAskCount:             %d
-  ExpirationBlockCount:    %d
-  BaseRequestGas           %d
-  PerValidatorRequestGas:  %d
-  SamplingTryCount:        %d
-  OracleRewardPercentage:  %d
-  InactivePenaltyDuration: %d
+  MaxRawRequestCount:             %d
+  MaxAskCount:                    %d
+  ExpirationBlockCount:           %d
+  BaseRequestGas                  %d
+  PerValidatorRequestGas:         %d
+  SamplingTryCount:               %d
+  OracleRewardPercentage:         %d
+  InactivePenaltyDuration:        %d
+  RequestRetentionBlockCount:     %d
+  MaxPruneCountPerBlock:          %d
+  PruneResult:                    %t
+  CommitBlockCount:               %d
+  ReportWindow:                   %d
+  MinReportPercentage:            %d
+  MissedReportSlashPercentage:    %d
+  RewardWeightingMode:            %d
+  InBeforeResolveBonusPercentage: %d
+  StandingRequestFee:             %d
+  MinStandingRequestInterval:     %d
+  MaxStandingRequestsPerBlock:    %d
+  MaxStandingRequestFailures:     %d
+  MissedReportJailDuration:       %d
+  RewardPeriod:                   %d
 `,
 		p.MaxRawRequestCount,
 		p.MaxAskCount,
@@ -52,6 +110,21 @@ func (p Params) String() string {
 		p.SamplingTryCount,
 		p.OracleRewardPercentage,
 		p.InactivePenaltyDuration,
+		p.RequestRetentionBlockCount,
+		p.MaxPruneCountPerBlock,
+		p.PruneResult,
+		p.CommitBlockCount,
+		p.ReportWindow,
+		p.MinReportPercentage,
+		p.MissedReportSlashPercentage,
+		p.RewardWeightingMode,
+		p.InBeforeResolveBonusPercentage,
+		p.StandingRequestFee,
+		p.MinStandingRequestInterval,
+		p.MaxStandingRequestsPerBlock,
+		p.MaxStandingRequestFailures,
+		p.MissedReportJailDuration,
+		p.RewardPeriod,
 	)
 }
 
@@ -66,6 +139,21 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeySamplingTryCount, &p.SamplingTryCount, validateUint64("sampling try count", true)),
 		params.NewParamSetPair(KeyOracleRewardPercentage, &p.OracleRewardPercentage, validateUint64("oracle reward percentage", false)),
 		params.NewParamSetPair(KeyInactivePenaltyDuration, &p.InactivePenaltyDuration, validateUint64("inactive penalty duration", false)),
+		params.NewParamSetPair(KeyRequestRetentionBlockCount, &p.RequestRetentionBlockCount, validateUint64("request retention block count", false)),
+		params.NewParamSetPair(KeyMaxPruneCountPerBlock, &p.MaxPruneCountPerBlock, validateUint64("max prune count per block", true)),
+		params.NewParamSetPair(KeyPruneResult, &p.PruneResult, validateBool),
+		params.NewParamSetPair(KeyCommitBlockCount, &p.CommitBlockCount, validateUint64("commit block count", false)),
+		params.NewParamSetPair(KeyReportWindow, &p.ReportWindow, validateUint64("report window", false)),
+		params.NewParamSetPair(KeyMinReportPercentage, &p.MinReportPercentage, validatePercentage("min report percentage")),
+		params.NewParamSetPair(KeyMissedReportSlashPercentage, &p.MissedReportSlashPercentage, validatePercentage("missed report slash percentage")),
+		params.NewParamSetPair(KeyRewardWeightingMode, &p.RewardWeightingMode, validateRewardWeightingMode),
+		params.NewParamSetPair(KeyInBeforeResolveBonusPercentage, &p.InBeforeResolveBonusPercentage, validateUint64("in before resolve bonus percentage", false)),
+		params.NewParamSetPair(KeyStandingRequestFee, &p.StandingRequestFee, validateUint64("standing request fee", false)),
+		params.NewParamSetPair(KeyMinStandingRequestInterval, &p.MinStandingRequestInterval, validateUint64("min standing request interval", true)),
+		params.NewParamSetPair(KeyMaxStandingRequestsPerBlock, &p.MaxStandingRequestsPerBlock, validateUint64("max standing requests per block", true)),
+		params.NewParamSetPair(KeyMaxStandingRequestFailures, &p.MaxStandingRequestFailures, validateUint64("max standing request failures", true)),
+		params.NewParamSetPair(KeyMissedReportJailDuration, &p.MissedReportJailDuration, validateUint64("missed report jail duration", false)),
+		params.NewParamSetPair(KeyRewardPeriod, &p.RewardPeriod, validateUint64("reward period", true)),
 	}
 }
 
@@ -80,9 +168,40 @@ func DefaultParams() Params {
 		DefaultSamplingTryCount,
 		DefaultOracleRewardPercentage,
 		DefaultInactivePenaltyDuration,
+		DefaultRequestRetentionBlockCount,
+		DefaultMaxPruneCountPerBlock,
+		DefaultPruneResult,
+		DefaultCommitBlockCount,
+		DefaultReportWindow,
+		DefaultMinReportPercentage,
+		DefaultMissedReportSlashPercentage,
+		DefaultRewardWeightingMode,
+		DefaultInBeforeResolveBonusPercentage,
+		DefaultStandingRequestFee,
+		DefaultMinStandingRequestInterval,
+		DefaultMaxStandingRequestsPerBlock,
+		DefaultMaxStandingRequestFailures,
+		DefaultMissedReportJailDuration,
+		DefaultRewardPeriod,
 	)
 }
 
+// Validate returns an error if any of the params is invalid on its own, or if the params are
+// inconsistent with each other.
+func (p Params) Validate() error {
+	for _, pair := range p.ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()); err != nil {
+			return fmt.Errorf("invalid param %s: %w", pair.Key, err)
+		}
+	}
+	// Reports are revealed once the commit window closes, which must happen before expiration.
+	if p.CommitBlockCount >= p.ExpirationBlockCount {
+		return fmt.Errorf("commit block count %d must be less than expiration block count %d",
+			p.CommitBlockCount, p.ExpirationBlockCount)
+	}
+	return nil
+}
+
 func validateUint64(name string, positiveOnly bool) func(interface{}) error {
 	return func(i interface{}) error {
 		v, ok := i.(uint64)
@@ -95,3 +214,34 @@ func validateUint64(name string, positiveOnly bool) func(interface{}) error {
 		return nil
 	}
 }
+
+func validatePercentage(name string) func(interface{}) error {
+	return func(i interface{}) error {
+		v, ok := i.(uint64)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		if v > 100 {
+			return fmt.Errorf("%s must not exceed 100: %d", name, v)
+		}
+		return nil
+	}
+}
+
+func validateRewardWeightingMode(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > RewardWeightingReportsWithBonus {
+		return fmt.Errorf("unknown reward weighting mode: %d", v)
+	}
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
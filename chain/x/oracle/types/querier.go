@@ -5,20 +5,42 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Query endpoints supported by the oracle Querier.
 const (
-	QueryParams          = "params"
-	QueryCounts          = "counts"
-	QueryData            = "data"
-	QueryDataSources     = "data_sources"
-	QueryOracleScripts   = "oracle_scripts"
-	QueryRequests        = "requests"
-	QueryValidatorStatus = "validators"
-	QueryReporters       = "reporters"
+	QueryParams                  = "params"
+	QueryCounts                  = "counts"
+	QueryData                    = "data"
+	QueryDataSources             = "data_sources"
+	QueryOracleScripts           = "oracle_scripts"
+	QueryRequests                = "requests"
+	QueryValidatorStatus         = "validators"
+	QueryReporters               = "reporters"
+	QueryStandingRequests        = "standing_requests"
+	QueryDataSourceVersions      = "data_source_versions"
+	QueryDataSourceVersionCode   = "data_source_version_code"
+	QueryOracleScriptVersions    = "oracle_script_versions"
+	QueryOracleScriptVersionCode = "oracle_script_version_code"
+	QueryRequestsByOracleScript  = "requests_by_oracle_script"
+	QueryRequestsByClientID      = "requests_by_client_id"
+	QueryRequestsByResolveStatus = "requests_by_resolve_status"
+	QueryRequestSearch           = "request_search"
+	QuerySimulate                = "simulate"
 )
 
+// QueryDecodeOption is the optional last path segment of the request query that asks for the
+// calldata and result to be decoded with the oracle script's OBI schema.
+const QueryDecodeOption = "decode"
+
+// MaxDecodeDataSize is the max size of the calldata or result that the request query decodes. The
+// decoding work is linear in the data size, so this bounds the work of the unmetered query.
+const MaxDecodeDataSize = 64 * 1024
+
+// MaxQueryLimit is the maximum number of items returned by a paginated query.
+const MaxQueryLimit = 100
+
 // QueryResult wraps querier result with HTTP status to return to application.
 type QueryResult struct {
 	Status int             `json:"status"`
@@ -58,7 +80,52 @@ type QueryCountsResult struct {
 
 // QueryRequestResult is the struct for the result of request query.
 type QueryRequestResult struct {
-	Request Request  `json:"request"`
-	Reports []Report `json:"reports"`
-	Result  *Result  `json:"result"`
+	Request Request              `json:"request"`
+	Reports []Report             `json:"reports"`
+	Result  *Result              `json:"result"`
+	Decoded *QueryDecodedRequest `json:"decoded,omitempty"`
+}
+
+// QueryDecodedRequest is the calldata and the result of a request decoded to JSON with the OBI
+// schema of the oracle script version that the request was made to. Error is set instead if the
+// schema is missing or does not match the data.
+type QueryDecodedRequest struct {
+	Calldata json.RawMessage `json:"calldata,omitempty"`
+	Result   json.RawMessage `json:"result,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// MarshalYAML implements yaml.Marshaler to print the decoded values as JSON strings instead of
+// lists of bytes in the CLI text output.
+func (d QueryDecodedRequest) MarshalYAML() (interface{}, error) {
+	return struct {
+		Calldata string `yaml:"calldata,omitempty"`
+		Result   string `yaml:"result,omitempty"`
+		Error    string `yaml:"error,omitempty"`
+	}{string(d.Calldata), string(d.Result), d.Error}, nil
+}
+
+// QueryStandingRequestResult is the struct for the result of standing request query.
+type QueryStandingRequestResult struct {
+	StandingRequest StandingRequest `json:"standing_request"`
+	EscrowAddress   sdk.AccAddress  `json:"escrow_address"`
+	Budget          sdk.Coins       `json:"budget"`
+}
+
+// QuerySimulateParams is the struct for the parameters of simulate query. Reports holds the mock
+// raw reports of the first len(Reports) requested validators. If Reports is nil, only the
+// prepare function is simulated.
+type QuerySimulateParams struct {
+	OracleScriptID OracleScriptID `json:"oracle_script_id"`
+	Calldata       []byte         `json:"calldata"`
+	AskCount       uint64         `json:"ask_count"`
+	MinCount       uint64         `json:"min_count"`
+	Reports        [][]RawReport  `json:"reports"`
+}
+
+// QuerySimulateResult is the struct for the result of simulate query.
+type QuerySimulateResult struct {
+	RawRequests []RawRequest `json:"raw_requests"`
+	Result      []byte       `json:"result"`
+	Error       string       `json:"error"`
 }
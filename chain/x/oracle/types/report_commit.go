@@ -0,0 +1,21 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReportCommitHash returns the hash that a validator commits to before revealing its raw reports
+// to the given request. The request ID and validator address are part of the hash, so a commit
+// cannot be copied by other validators or reused for other requests.
+func ReportCommitHash(rid RequestID, val sdk.ValAddress, rawReports []RawReport, salt []byte) []byte {
+	hasher := sha256.New()
+	hasher.Write(sdk.Uint64ToBigEndian(uint64(rid)))
+	hasher.Write(val)
+	for _, rawReport := range rawReports {
+		hasher.Write(ModuleCdc.MustMarshalBinaryLengthPrefixed(rawReport))
+	}
+	hasher.Write(salt)
+	return hasher.Sum(nil)
+}
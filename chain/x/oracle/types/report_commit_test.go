@@ -0,0 +1,19 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportCommitHash(t *testing.T) {
+	reports := []RawReport{NewRawReport(1, 0, []byte("data1")), NewRawReport(2, 0, []byte("data2"))}
+	hash := ReportCommitHash(1, GoodTestValAddr, reports, []byte("salt"))
+	require.Len(t, hash, 32)
+	require.Equal(t, hash, ReportCommitHash(1, GoodTestValAddr, reports, []byte("salt")))
+	// Any change in the request, validator, reports, or salt changes the hash.
+	require.NotEqual(t, hash, ReportCommitHash(2, GoodTestValAddr, reports, []byte("salt")))
+	require.NotEqual(t, hash, ReportCommitHash(1, GoodTestValAddr2, reports, []byte("salt")))
+	require.NotEqual(t, hash, ReportCommitHash(1, GoodTestValAddr, reports[:1], []byte("salt")))
+	require.NotEqual(t, hash, ReportCommitHash(1, GoodTestValAddr, reports, []byte("pepper")))
+}
@@ -3,6 +3,7 @@ package types
 var (
 	_ RequestSpec = &MsgRequestData{}
 	_ RequestSpec = &OracleRequestPacketData{}
+	_ RequestSpec = &StandingRequest{}
 )
 
 // RequestSpec captures the essence of what it means to be a request-making object.
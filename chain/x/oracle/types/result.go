@@ -1,5 +1,10 @@
 package types
 
+import (
+	"fmt"
+	"strconv"
+)
+
 // Result is a convenience struct that keeps both request and response packets of a request.
 type Result struct {
 	RequestPacketData  OracleRequestPacketData
@@ -13,3 +18,18 @@ func NewResult(req OracleRequestPacketData, res OracleResponsePacketData) Result
 		ResponsePacketData: res,
 	}
 }
+
+// ParseResolveStatus parses the given resolve status name (e.g. "Success") or number.
+func ParseResolveStatus(s string) (ResolveStatus, error) {
+	if status, ok := ResolveStatus_value[s]; ok {
+		return ResolveStatus(status), nil
+	}
+	status, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid resolve status: %s", s)
+	}
+	if _, ok := ResolveStatus_name[int32(status)]; !ok {
+		return 0, fmt.Errorf("unknown resolve status: %d", status)
+	}
+	return ResolveStatus(status), nil
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResolveStatus(t *testing.T) {
+	status, err := ParseResolveStatus("Expired")
+	require.NoError(t, err)
+	require.Equal(t, ResolveStatus_Expired, status)
+	status, err = ParseResolveStatus("1")
+	require.NoError(t, err)
+	require.Equal(t, ResolveStatus_Success, status)
+	_, err = ParseResolveStatus("4")
+	require.Error(t, err)
+	_, err = ParseResolveStatus("expired")
+	require.Error(t, err)
+}
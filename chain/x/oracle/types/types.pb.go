@@ -79,6 +79,8 @@ type MsgRequestData struct {
 	ClientID string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	// Sender is the sender of this message.
 	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	// FeeLimit is the maximum amount of coins the sender is willing to pay to data source owners.
+	FeeLimit string `protobuf:"bytes,7,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
 }
 
 func (m *MsgRequestData) Reset()         { *m = MsgRequestData{} }
@@ -156,6 +158,13 @@ func (m *MsgRequestData) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddre
 	return nil
 }
 
+func (m *MsgRequestData) GetFeeLimit() string {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return ""
+}
+
 // MsgReportData is a message for reporting to a data request by a validator.
 type MsgReportData struct {
 	// RequestID is the identifier of the request to report to.
@@ -229,6 +238,162 @@ func (m *MsgReportData) GetReporter() github_com_cosmos_cosmos_sdk_types.AccAddr
 	return nil
 }
 
+// MsgCommitReport is a message for committing to a report of a data request by a validator
+// without revealing the raw data. The data is revealed later with MsgRevealReport.
+type MsgCommitReport struct {
+	// RequestID is the identifier of the request to commit a report to.
+	RequestID RequestID `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3,casttype=RequestID" json:"request_id,omitempty"`
+	// CommitHash is the hash of the raw reports and a secret salt. See ReportCommitHash.
+	CommitHash []byte `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Validator is the address of the validator that owns this commit.
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	// Reporter is the message signer who submits this commit transaction for the validator.
+	Reporter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=reporter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"reporter,omitempty"`
+}
+
+func (m *MsgCommitReport) Reset()         { *m = MsgCommitReport{} }
+func (m *MsgCommitReport) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitReport) ProtoMessage()    {}
+func (*MsgCommitReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{2}
+}
+func (m *MsgCommitReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitReport.Merge(m, src)
+}
+func (m *MsgCommitReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitReport proto.InternalMessageInfo
+
+func (m *MsgCommitReport) GetRequestID() RequestID {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
+
+func (m *MsgCommitReport) GetCommitHash() []byte {
+	if m != nil {
+		return m.CommitHash
+	}
+	return nil
+}
+
+func (m *MsgCommitReport) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *MsgCommitReport) GetReporter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Reporter
+	}
+	return nil
+}
+
+// MsgRevealReport is a message for revealing a previously committed report of a data request.
+type MsgRevealReport struct {
+	// RequestID is the identifier of the request to report to.
+	RequestID RequestID `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3,casttype=RequestID" json:"request_id,omitempty"`
+	// RawReports is the list of report information for each of the request's external ID.
+	RawReports []RawReport `protobuf:"bytes,2,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports"`
+	// Salt is the secret salt used to compute the commit hash.
+	Salt []byte `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	// Validator is the address of the validator that owns this report.
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,4,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	// Reporter is the message signer who submits this report transaction for the validator.
+	Reporter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=reporter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"reporter,omitempty"`
+}
+
+func (m *MsgRevealReport) Reset()         { *m = MsgRevealReport{} }
+func (m *MsgRevealReport) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealReport) ProtoMessage()    {}
+func (*MsgRevealReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{3}
+}
+func (m *MsgRevealReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealReport.Merge(m, src)
+}
+func (m *MsgRevealReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealReport proto.InternalMessageInfo
+
+func (m *MsgRevealReport) GetRequestID() RequestID {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
+
+func (m *MsgRevealReport) GetRawReports() []RawReport {
+	if m != nil {
+		return m.RawReports
+	}
+	return nil
+}
+
+func (m *MsgRevealReport) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *MsgRevealReport) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *MsgRevealReport) GetReporter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Reporter
+	}
+	return nil
+}
+
 // MsgCreateDataSource is a message for creating a new data source.
 type MsgCreateDataSource struct {
 	// Owner is the address who is allowed to make further changes to the data source.
@@ -241,13 +406,15 @@ type MsgCreateDataSource struct {
 	Executable []byte `protobuf:"bytes,4,opt,name=executable,proto3" json:"executable,omitempty"`
 	// Sender is the signer of this message.
 	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	// Fee is the amount of coins paid to the owner for every raw request to this data source (optional).
+	Fee string `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
 func (m *MsgCreateDataSource) Reset()         { *m = MsgCreateDataSource{} }
 func (m *MsgCreateDataSource) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateDataSource) ProtoMessage()    {}
 func (*MsgCreateDataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{2}
+	return fileDescriptor_53e65fd95a58412c, []int{4}
 }
 func (m *MsgCreateDataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,6 +478,13 @@ func (m *MsgCreateDataSource) GetSender() github_com_cosmos_cosmos_sdk_types.Acc
 	return nil
 }
 
+func (m *MsgCreateDataSource) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
 // MsgEditDataSource is a message for editing an existing data source.
 type MsgEditDataSource struct {
 	// DataSourceID is the unique identifier of the data source to be edited.
@@ -325,13 +499,15 @@ type MsgEditDataSource struct {
 	Executable []byte `protobuf:"bytes,5,opt,name=executable,proto3" json:"executable,omitempty"`
 	// Sender is the signer of this message. Must be the current data source's owner.
 	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	// Fee is the amount of coins paid to the owner for every raw request to this data source (optional).
+	Fee string `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
 func (m *MsgEditDataSource) Reset()         { *m = MsgEditDataSource{} }
 func (m *MsgEditDataSource) String() string { return proto.CompactTextString(m) }
 func (*MsgEditDataSource) ProtoMessage()    {}
 func (*MsgEditDataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{3}
+	return fileDescriptor_53e65fd95a58412c, []int{5}
 }
 func (m *MsgEditDataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,6 +578,13 @@ func (m *MsgEditDataSource) GetSender() github_com_cosmos_cosmos_sdk_types.AccAd
 	return nil
 }
 
+func (m *MsgEditDataSource) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
 // MsgCreateOracleScript is a message for creating an oracle script.
 type MsgCreateOracleScript struct {
 	// Owner is the address who is allowed to make further changes to the oracle script.
@@ -424,7 +607,7 @@ func (m *MsgCreateOracleScript) Reset()         { *m = MsgCreateOracleScript{} }
 func (m *MsgCreateOracleScript) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateOracleScript) ProtoMessage()    {}
 func (*MsgCreateOracleScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{4}
+	return fileDescriptor_53e65fd95a58412c, []int{6}
 }
 func (m *MsgCreateOracleScript) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +709,7 @@ func (m *MsgEditOracleScript) Reset()         { *m = MsgEditOracleScript{} }
 func (m *MsgEditOracleScript) String() string { return proto.CompactTextString(m) }
 func (*MsgEditOracleScript) ProtoMessage()    {}
 func (*MsgEditOracleScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{5}
+	return fileDescriptor_53e65fd95a58412c, []int{7}
 }
 func (m *MsgEditOracleScript) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +804,7 @@ func (m *MsgActivate) Reset()         { *m = MsgActivate{} }
 func (m *MsgActivate) String() string { return proto.CompactTextString(m) }
 func (*MsgActivate) ProtoMessage()    {}
 func (*MsgActivate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{6}
+	return fileDescriptor_53e65fd95a58412c, []int{8}
 }
 func (m *MsgActivate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +852,7 @@ func (m *MsgAddReporter) Reset()         { *m = MsgAddReporter{} }
 func (m *MsgAddReporter) String() string { return proto.CompactTextString(m) }
 func (*MsgAddReporter) ProtoMessage()    {}
 func (*MsgAddReporter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{7}
+	return fileDescriptor_53e65fd95a58412c, []int{9}
 }
 func (m *MsgAddReporter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +907,7 @@ func (m *MsgRemoveReporter) Reset()         { *m = MsgRemoveReporter{} }
 func (m *MsgRemoveReporter) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveReporter) ProtoMessage()    {}
 func (*MsgRemoveReporter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{8}
+	return fileDescriptor_53e65fd95a58412c, []int{10}
 }
 func (m *MsgRemoveReporter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,26 +950,41 @@ func (m *MsgRemoveReporter) GetReporter() github_com_cosmos_cosmos_sdk_types.Acc
 	return nil
 }
 
-// DataSource is the data structure for storing data sources in the storage.
-type DataSource struct {
-	Owner       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
-	Name        string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string                                        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Filename    string                                        `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-}
-
-func (m *DataSource) Reset()         { *m = DataSource{} }
-func (m *DataSource) String() string { return proto.CompactTextString(m) }
-func (*DataSource) ProtoMessage()    {}
-func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{9}
+// MsgCreateStandingRequest is a message for creating a request that is automatically sent
+// every fixed number of blocks, paid for with a prepaid budget.
+type MsgCreateStandingRequest struct {
+	// OracleScriptID is the identifier of the oracle script to call.
+	OracleScriptID OracleScriptID `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI encoded call parameters to the oracle script.
+	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the tasks.
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to tracking the requests.
+	ClientID string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Interval is the number of blocks between two consecutive requests.
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Budget is the amount of coins prepaid by the sender to cover data source fees.
+	Budget string `protobuf:"bytes,7,opt,name=budget,proto3" json:"budget,omitempty"`
+	// FeeLimit is the maximum amount of coins to pay to data source owners per request.
+	FeeLimit string `protobuf:"bytes,8,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// Sender is the sender of this message. The sender becomes the standing request owner.
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,9,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+}
+
+func (m *MsgCreateStandingRequest) Reset()         { *m = MsgCreateStandingRequest{} }
+func (m *MsgCreateStandingRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStandingRequest) ProtoMessage()    {}
+func (*MsgCreateStandingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{11}
 }
-func (m *DataSource) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateStandingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateStandingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataSource.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateStandingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -796,68 +994,101 @@ func (m *DataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DataSource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataSource.Merge(m, src)
+func (m *MsgCreateStandingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStandingRequest.Merge(m, src)
 }
-func (m *DataSource) XXX_Size() int {
+func (m *MsgCreateStandingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataSource) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataSource.DiscardUnknown(m)
+func (m *MsgCreateStandingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStandingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataSource proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateStandingRequest proto.InternalMessageInfo
 
-func (m *DataSource) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgCreateStandingRequest) GetOracleScriptID() OracleScriptID {
 	if m != nil {
-		return m.Owner
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *MsgCreateStandingRequest) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
 	}
 	return nil
 }
 
-func (m *DataSource) GetName() string {
+func (m *MsgCreateStandingRequest) GetAskCount() uint64 {
 	if m != nil {
-		return m.Name
+		return m.AskCount
+	}
+	return 0
+}
+
+func (m *MsgCreateStandingRequest) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *MsgCreateStandingRequest) GetClientID() string {
+	if m != nil {
+		return m.ClientID
 	}
 	return ""
 }
 
-func (m *DataSource) GetDescription() string {
+func (m *MsgCreateStandingRequest) GetInterval() uint64 {
 	if m != nil {
-		return m.Description
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgCreateStandingRequest) GetBudget() string {
+	if m != nil {
+		return m.Budget
 	}
 	return ""
 }
 
-func (m *DataSource) GetFilename() string {
+func (m *MsgCreateStandingRequest) GetFeeLimit() string {
 	if m != nil {
-		return m.Filename
+		return m.FeeLimit
 	}
 	return ""
 }
 
-// OracleScript is the data structure for storing oracle scripts in the storage.
-type OracleScript struct {
-	Owner         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
-	Name          string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                                        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Filename      string                                        `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	Schema        string                                        `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
-	SourceCodeURL string                                        `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+func (m *MsgCreateStandingRequest) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
 }
 
-func (m *OracleScript) Reset()         { *m = OracleScript{} }
-func (m *OracleScript) String() string { return proto.CompactTextString(m) }
-func (*OracleScript) ProtoMessage()    {}
-func (*OracleScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{10}
+// MsgCancelStandingRequest is a message for cancelling an existing standing request.
+type MsgCancelStandingRequest struct {
+	// StandingRequestID is the identifier of the standing request to cancel.
+	StandingRequestID StandingRequestID `protobuf:"varint,1,opt,name=standing_request_id,json=standingRequestId,proto3,casttype=StandingRequestID" json:"standing_request_id,omitempty"`
+	// Sender is the sender of this message. Must be the owner of the standing request.
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 }
-func (m *OracleScript) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCancelStandingRequest) Reset()         { *m = MsgCancelStandingRequest{} }
+func (m *MsgCancelStandingRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStandingRequest) ProtoMessage()    {}
+func (*MsgCancelStandingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{12}
+}
+func (m *MsgCancelStandingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OracleScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelStandingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OracleScript.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelStandingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -867,47 +1098,245 @@ func (m *OracleScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *OracleScript) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OracleScript.Merge(m, src)
+func (m *MsgCancelStandingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStandingRequest.Merge(m, src)
 }
-func (m *OracleScript) XXX_Size() int {
+func (m *MsgCancelStandingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OracleScript) XXX_DiscardUnknown() {
-	xxx_messageInfo_OracleScript.DiscardUnknown(m)
+func (m *MsgCancelStandingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStandingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OracleScript proto.InternalMessageInfo
-
-func (m *OracleScript) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.Owner
-	}
-	return nil
-}
+var xxx_messageInfo_MsgCancelStandingRequest proto.InternalMessageInfo
 
-func (m *OracleScript) GetName() string {
+func (m *MsgCancelStandingRequest) GetStandingRequestID() StandingRequestID {
 	if m != nil {
-		return m.Name
+		return m.StandingRequestID
 	}
-	return ""
+	return 0
 }
 
-func (m *OracleScript) GetDescription() string {
+func (m *MsgCancelStandingRequest) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Description
+		return m.Sender
 	}
-	return ""
+	return nil
 }
 
-func (m *OracleScript) GetFilename() string {
-	if m != nil {
-		return m.Filename
-	}
-	return ""
+// DataSource is the data structure for storing data sources in the storage.
+type DataSource struct {
+	Owner       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Name        string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                                        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Filename    string                                        `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	Fee         string                                        `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (m *OracleScript) GetSchema() string {
+func (m *DataSource) Reset()         { *m = DataSource{} }
+func (m *DataSource) String() string { return proto.CompactTextString(m) }
+func (*DataSource) ProtoMessage()    {}
+func (*DataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{13}
+}
+func (m *DataSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSource.Merge(m, src)
+}
+func (m *DataSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSource proto.InternalMessageInfo
+
+func (m *DataSource) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *DataSource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DataSource) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DataSource) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *DataSource) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+// DataSourceVersion is the data structure for storing a historical version of a data source.
+type DataSourceVersion struct {
+	// Filename is the name of the executable file of this version.
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Editor is the address that created or edited the data source into this version.
+	Editor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=editor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"editor,omitempty"`
+	// Height is the block height at which this version was created.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DataSourceVersion) Reset()         { *m = DataSourceVersion{} }
+func (m *DataSourceVersion) String() string { return proto.CompactTextString(m) }
+func (*DataSourceVersion) ProtoMessage()    {}
+func (*DataSourceVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{14}
+}
+func (m *DataSourceVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSourceVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSourceVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSourceVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSourceVersion.Merge(m, src)
+}
+func (m *DataSourceVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSourceVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSourceVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSourceVersion proto.InternalMessageInfo
+
+func (m *DataSourceVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *DataSourceVersion) GetEditor() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Editor
+	}
+	return nil
+}
+
+func (m *DataSourceVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// OracleScript is the data structure for storing oracle scripts in the storage.
+type OracleScript struct {
+	Owner         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Name          string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                                        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Filename      string                                        `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	Schema        string                                        `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	SourceCodeURL string                                        `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+}
+
+func (m *OracleScript) Reset()         { *m = OracleScript{} }
+func (m *OracleScript) String() string { return proto.CompactTextString(m) }
+func (*OracleScript) ProtoMessage()    {}
+func (*OracleScript) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{15}
+}
+func (m *OracleScript) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleScript.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleScript) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleScript.Merge(m, src)
+}
+func (m *OracleScript) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleScript) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleScript.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleScript proto.InternalMessageInfo
+
+func (m *OracleScript) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *OracleScript) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OracleScript) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *OracleScript) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *OracleScript) GetSchema() string {
 	if m != nil {
 		return m.Schema
 	}
@@ -921,18 +1350,92 @@ func (m *OracleScript) GetSourceCodeURL() string {
 	return ""
 }
 
+// OracleScriptVersion is the data structure for storing a historical version of an oracle script.
+type OracleScriptVersion struct {
+	// Filename is the name of the compiled wasm file of this version.
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the OBI schema of this version.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Editor is the address that created or edited the oracle script into this version.
+	Editor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=editor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"editor,omitempty"`
+	// Height is the block height at which this version was created.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *OracleScriptVersion) Reset()         { *m = OracleScriptVersion{} }
+func (m *OracleScriptVersion) String() string { return proto.CompactTextString(m) }
+func (*OracleScriptVersion) ProtoMessage()    {}
+func (*OracleScriptVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{16}
+}
+func (m *OracleScriptVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleScriptVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleScriptVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleScriptVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleScriptVersion.Merge(m, src)
+}
+func (m *OracleScriptVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleScriptVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleScriptVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleScriptVersion proto.InternalMessageInfo
+
+func (m *OracleScriptVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *OracleScriptVersion) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func (m *OracleScriptVersion) GetEditor() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Editor
+	}
+	return nil
+}
+
+func (m *OracleScriptVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // RawRequest is the data structure for storing raw requests in the storage.
 type RawRequest struct {
-	ExternalID   ExternalID   `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3,casttype=ExternalID" json:"external_id,omitempty"`
-	DataSourceID DataSourceID `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
-	Calldata     []byte       `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	ExternalID        ExternalID   `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3,casttype=ExternalID" json:"external_id,omitempty"`
+	DataSourceID      DataSourceID `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	Calldata          []byte       `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	DataSourceVersion int64        `protobuf:"varint,4,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
 }
 
 func (m *RawRequest) Reset()         { *m = RawRequest{} }
 func (m *RawRequest) String() string { return proto.CompactTextString(m) }
 func (*RawRequest) ProtoMessage()    {}
 func (*RawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{11}
+	return fileDescriptor_53e65fd95a58412c, []int{17}
 }
 func (m *RawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,6 +1485,13 @@ func (m *RawRequest) GetCalldata() []byte {
 	return nil
 }
 
+func (m *RawRequest) GetDataSourceVersion() int64 {
+	if m != nil {
+		return m.DataSourceVersion
+	}
+	return 0
+}
+
 // RawRequest is the data structure for storing raw reporter in the storage.
 type RawReport struct {
 	ExternalID ExternalID `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3,casttype=ExternalID" json:"external_id,omitempty"`
@@ -993,7 +1503,7 @@ func (m *RawReport) Reset()         { *m = RawReport{} }
 func (m *RawReport) String() string { return proto.CompactTextString(m) }
 func (*RawReport) ProtoMessage()    {}
 func (*RawReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{12}
+	return fileDescriptor_53e65fd95a58412c, []int{18}
 }
 func (m *RawReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1053,13 +1563,14 @@ type Request struct {
 	RequestTime         time.Time                                       `protobuf:"bytes,6,opt,name=request_time,json=requestTime,proto3,stdtime" json:"request_time"`
 	ClientID            string                                          `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	RawRequests         []RawRequest                                    `protobuf:"bytes,8,rep,name=raw_requests,json=rawRequests,proto3" json:"raw_requests"`
+	OracleScriptVersion int64                                           `protobuf:"varint,9,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{13}
+	return fileDescriptor_53e65fd95a58412c, []int{19}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1144,25 +1655,40 @@ func (m *Request) GetRawRequests() []RawRequest {
 	return nil
 }
 
-// Report is the data structure for storing reports in the storage.
-type Report struct {
-	Validator       github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
-	InBeforeResolve bool                                          `protobuf:"varint,2,opt,name=in_before_resolve,json=inBeforeResolve,proto3" json:"in_before_resolve,omitempty"`
-	RawReports      []RawReport                                   `protobuf:"bytes,3,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports"`
+func (m *Request) GetOracleScriptVersion() int64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
 }
 
-func (m *Report) Reset()         { *m = Report{} }
-func (m *Report) String() string { return proto.CompactTextString(m) }
-func (*Report) ProtoMessage()    {}
-func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{14}
-}
-func (m *Report) XXX_Unmarshal(b []byte) error {
+// StandingRequest is the data structure for storing standing requests in the storage.
+type StandingRequest struct {
+	Owner          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	OracleScriptID OracleScriptID                                `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	Calldata       []byte                                        `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	AskCount       uint64                                        `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	MinCount       uint64                                        `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	ClientID       string                                        `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Interval       uint64                                        `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	FeeLimit       string                                        `protobuf:"bytes,8,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	NextHeight     int64                                         `protobuf:"varint,9,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// FailureCount is the number of times in a row that the standing request failed to be sent.
+	FailureCount uint64 `protobuf:"varint,10,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+}
+
+func (m *StandingRequest) Reset()         { *m = StandingRequest{} }
+func (m *StandingRequest) String() string { return proto.CompactTextString(m) }
+func (*StandingRequest) ProtoMessage()    {}
+func (*StandingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{20}
+}
+func (m *StandingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StandingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Report.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StandingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1172,21 +1698,131 @@ func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Report) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Report.Merge(m, src)
+func (m *StandingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StandingRequest.Merge(m, src)
 }
-func (m *Report) XXX_Size() int {
+func (m *StandingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Report) XXX_DiscardUnknown() {
-	xxx_messageInfo_Report.DiscardUnknown(m)
+func (m *StandingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StandingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Report proto.InternalMessageInfo
+var xxx_messageInfo_StandingRequest proto.InternalMessageInfo
 
-func (m *Report) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (m *StandingRequest) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Validator
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *StandingRequest) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *StandingRequest) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *StandingRequest) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
+	}
+	return 0
+}
+
+func (m *StandingRequest) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *StandingRequest) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *StandingRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *StandingRequest) GetFeeLimit() string {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return ""
+}
+
+func (m *StandingRequest) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *StandingRequest) GetFailureCount() uint64 {
+	if m != nil {
+		return m.FailureCount
+	}
+	return 0
+}
+
+// Report is the data structure for storing reports in the storage.
+type Report struct {
+	Validator       github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	InBeforeResolve bool                                          `protobuf:"varint,2,opt,name=in_before_resolve,json=inBeforeResolve,proto3" json:"in_before_resolve,omitempty"`
+	RawReports      []RawReport                                   `protobuf:"bytes,3,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports"`
+}
+
+func (m *Report) Reset()         { *m = Report{} }
+func (m *Report) String() string { return proto.CompactTextString(m) }
+func (*Report) ProtoMessage()    {}
+func (*Report) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{21}
+}
+func (m *Report) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Report.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Report) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Report.Merge(m, src)
+}
+func (m *Report) XXX_Size() int {
+	return m.Size()
+}
+func (m *Report) XXX_DiscardUnknown() {
+	xxx_messageInfo_Report.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Report proto.InternalMessageInfo
+
+func (m *Report) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
 	}
 	return nil
 }
@@ -1205,6 +1841,60 @@ func (m *Report) GetRawReports() []RawReport {
 	return nil
 }
 
+// ReportCommit is the data structure for storing a validator's commitment to a report that
+// is not yet revealed.
+type ReportCommit struct {
+	Validator  github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	CommitHash []byte                                        `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+}
+
+func (m *ReportCommit) Reset()         { *m = ReportCommit{} }
+func (m *ReportCommit) String() string { return proto.CompactTextString(m) }
+func (*ReportCommit) ProtoMessage()    {}
+func (*ReportCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{22}
+}
+func (m *ReportCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportCommit.Merge(m, src)
+}
+func (m *ReportCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportCommit proto.InternalMessageInfo
+
+func (m *ReportCommit) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *ReportCommit) GetCommitHash() []byte {
+	if m != nil {
+		return m.CommitHash
+	}
+	return nil
+}
+
 // OracleRequestPacketData encodes an oracle request sent from other blockchains to BandChain.
 type OracleRequestPacketData struct {
 	// ClientID is the unique identifier of this oracle request, as specified by the client.
@@ -1226,7 +1916,7 @@ func (m *OracleRequestPacketData) Reset()         { *m = OracleRequestPacketData
 func (m *OracleRequestPacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleRequestPacketData) ProtoMessage()    {}
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{15}
+	return fileDescriptor_53e65fd95a58412c, []int{23}
 }
 func (m *OracleRequestPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1313,7 +2003,7 @@ func (m *OracleResponsePacketData) Reset()         { *m = OracleResponsePacketDa
 func (m *OracleResponsePacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleResponsePacketData) ProtoMessage()    {}
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{16}
+	return fileDescriptor_53e65fd95a58412c, []int{24}
 }
 func (m *OracleResponsePacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1401,7 +2091,7 @@ func (m *ValidatorStatus) Reset()         { *m = ValidatorStatus{} }
 func (m *ValidatorStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatus) ProtoMessage()    {}
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{17}
+	return fileDescriptor_53e65fd95a58412c, []int{25}
 }
 func (m *ValidatorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,22 +2134,149 @@ func (m *ValidatorStatus) GetSince() time.Time {
 	return time.Time{}
 }
 
+// ReportInfo is the data structure for tracking reports missed by a validator over a sliding
+// window of the requests assigned to it.
+type ReportInfo struct {
+	// IndexOffset is the number of requests assigned to the validator since the window started.
+	IndexOffset int64 `protobuf:"varint,1,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// MissedReportsCounter is the number of requests missed by the validator within the window.
+	MissedReportsCounter int64 `protobuf:"varint,2,opt,name=missed_reports_counter,json=missedReportsCounter,proto3" json:"missed_reports_counter,omitempty"`
+}
+
+func (m *ReportInfo) Reset()         { *m = ReportInfo{} }
+func (m *ReportInfo) String() string { return proto.CompactTextString(m) }
+func (*ReportInfo) ProtoMessage()    {}
+func (*ReportInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{26}
+}
+func (m *ReportInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportInfo.Merge(m, src)
+}
+func (m *ReportInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportInfo proto.InternalMessageInfo
+
+func (m *ReportInfo) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ReportInfo) GetMissedReportsCounter() int64 {
+	if m != nil {
+		return m.MissedReportsCounter
+	}
+	return 0
+}
+
+// ValidatorReportStat is the data structure for tracking reports delivered by a validator since
+// the last oracle reward allocation.
+type ValidatorReportStat struct {
+	// ReportCount is the number of reports delivered by the validator.
+	ReportCount uint64 `protobuf:"varint,1,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// InBeforeResolveCount is the number of reports delivered before their requests got resolved.
+	InBeforeResolveCount uint64 `protobuf:"varint,2,opt,name=in_before_resolve_count,json=inBeforeResolveCount,proto3" json:"in_before_resolve_count,omitempty"`
+}
+
+func (m *ValidatorReportStat) Reset()         { *m = ValidatorReportStat{} }
+func (m *ValidatorReportStat) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReportStat) ProtoMessage()    {}
+func (*ValidatorReportStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e65fd95a58412c, []int{27}
+}
+func (m *ValidatorReportStat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReportStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReportStat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReportStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReportStat.Merge(m, src)
+}
+func (m *ValidatorReportStat) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReportStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReportStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReportStat proto.InternalMessageInfo
+
+func (m *ValidatorReportStat) GetReportCount() uint64 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
+}
+
+func (m *ValidatorReportStat) GetInBeforeResolveCount() uint64 {
+	if m != nil {
+		return m.InBeforeResolveCount
+	}
+	return 0
+}
+
 // Params is the data structure that keeps the parameters of the oracle module.
 type Params struct {
-	MaxRawRequestCount      uint64 `protobuf:"varint,1,opt,name=max_raw_request_count,json=maxRawRequestCount,proto3" json:"max_raw_request_count,omitempty"`
-	MaxAskCount             uint64 `protobuf:"varint,2,opt,name=max_ask_count,json=maxAskCount,proto3" json:"max_ask_count,omitempty"`
-	ExpirationBlockCount    uint64 `protobuf:"varint,3,opt,name=expiration_block_count,json=expirationBlockCount,proto3" json:"expiration_block_count,omitempty"`
-	BaseRequestGas          uint64 `protobuf:"varint,4,opt,name=base_request_gas,json=baseRequestGas,proto3" json:"base_request_gas,omitempty"`
-	PerValidatorRequestGas  uint64 `protobuf:"varint,5,opt,name=per_validator_request_gas,json=perValidatorRequestGas,proto3" json:"per_validator_request_gas,omitempty"`
-	SamplingTryCount        uint64 `protobuf:"varint,6,opt,name=sampling_try_count,json=samplingTryCount,proto3" json:"sampling_try_count,omitempty"`
-	OracleRewardPercentage  uint64 `protobuf:"varint,7,opt,name=oracle_reward_percentage,json=oracleRewardPercentage,proto3" json:"oracle_reward_percentage,omitempty"`
-	InactivePenaltyDuration uint64 `protobuf:"varint,8,opt,name=inactive_penalty_duration,json=inactivePenaltyDuration,proto3" json:"inactive_penalty_duration,omitempty"`
+	MaxRawRequestCount             uint64 `protobuf:"varint,1,opt,name=max_raw_request_count,json=maxRawRequestCount,proto3" json:"max_raw_request_count,omitempty"`
+	MaxAskCount                    uint64 `protobuf:"varint,2,opt,name=max_ask_count,json=maxAskCount,proto3" json:"max_ask_count,omitempty"`
+	ExpirationBlockCount           uint64 `protobuf:"varint,3,opt,name=expiration_block_count,json=expirationBlockCount,proto3" json:"expiration_block_count,omitempty"`
+	BaseRequestGas                 uint64 `protobuf:"varint,4,opt,name=base_request_gas,json=baseRequestGas,proto3" json:"base_request_gas,omitempty"`
+	PerValidatorRequestGas         uint64 `protobuf:"varint,5,opt,name=per_validator_request_gas,json=perValidatorRequestGas,proto3" json:"per_validator_request_gas,omitempty"`
+	SamplingTryCount               uint64 `protobuf:"varint,6,opt,name=sampling_try_count,json=samplingTryCount,proto3" json:"sampling_try_count,omitempty"`
+	OracleRewardPercentage         uint64 `protobuf:"varint,7,opt,name=oracle_reward_percentage,json=oracleRewardPercentage,proto3" json:"oracle_reward_percentage,omitempty"`
+	InactivePenaltyDuration        uint64 `protobuf:"varint,8,opt,name=inactive_penalty_duration,json=inactivePenaltyDuration,proto3" json:"inactive_penalty_duration,omitempty"`
+	RequestRetentionBlockCount     uint64 `protobuf:"varint,9,opt,name=request_retention_block_count,json=requestRetentionBlockCount,proto3" json:"request_retention_block_count,omitempty"`
+	MaxPruneCountPerBlock          uint64 `protobuf:"varint,10,opt,name=max_prune_count_per_block,json=maxPruneCountPerBlock,proto3" json:"max_prune_count_per_block,omitempty"`
+	PruneResult                    bool   `protobuf:"varint,11,opt,name=prune_result,json=pruneResult,proto3" json:"prune_result,omitempty"`
+	CommitBlockCount               uint64 `protobuf:"varint,12,opt,name=commit_block_count,json=commitBlockCount,proto3" json:"commit_block_count,omitempty"`
+	ReportWindow                   uint64 `protobuf:"varint,13,opt,name=report_window,json=reportWindow,proto3" json:"report_window,omitempty"`
+	MinReportPercentage            uint64 `protobuf:"varint,14,opt,name=min_report_percentage,json=minReportPercentage,proto3" json:"min_report_percentage,omitempty"`
+	MissedReportSlashPercentage    uint64 `protobuf:"varint,15,opt,name=missed_report_slash_percentage,json=missedReportSlashPercentage,proto3" json:"missed_report_slash_percentage,omitempty"`
+	RewardWeightingMode            uint64 `protobuf:"varint,16,opt,name=reward_weighting_mode,json=rewardWeightingMode,proto3" json:"reward_weighting_mode,omitempty"`
+	InBeforeResolveBonusPercentage uint64 `protobuf:"varint,17,opt,name=in_before_resolve_bonus_percentage,json=inBeforeResolveBonusPercentage,proto3" json:"in_before_resolve_bonus_percentage,omitempty"`
+	StandingRequestFee             uint64 `protobuf:"varint,18,opt,name=standing_request_fee,json=standingRequestFee,proto3" json:"standing_request_fee,omitempty"`
+	MinStandingRequestInterval     uint64 `protobuf:"varint,19,opt,name=min_standing_request_interval,json=minStandingRequestInterval,proto3" json:"min_standing_request_interval,omitempty"`
+	MaxStandingRequestsPerBlock    uint64 `protobuf:"varint,20,opt,name=max_standing_requests_per_block,json=maxStandingRequestsPerBlock,proto3" json:"max_standing_requests_per_block,omitempty"`
+	MaxStandingRequestFailures     uint64 `protobuf:"varint,21,opt,name=max_standing_request_failures,json=maxStandingRequestFailures,proto3" json:"max_standing_request_failures,omitempty"`
+	MissedReportJailDuration       uint64 `protobuf:"varint,22,opt,name=missed_report_jail_duration,json=missedReportJailDuration,proto3" json:"missed_report_jail_duration,omitempty"`
+	RewardPeriod                   uint64 `protobuf:"varint,23,opt,name=reward_period,json=rewardPeriod,proto3" json:"reward_period,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e65fd95a58412c, []int{18}
+	return fileDescriptor_53e65fd95a58412c, []int{28}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1544,150 +2361,311 @@ func (m *Params) GetInactivePenaltyDuration() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("bandchain.chain.x.oracle.v1.ResolveStatus", ResolveStatus_name, ResolveStatus_value)
-	proto.RegisterType((*MsgRequestData)(nil), "bandchain.chain.x.oracle.v1.MsgRequestData")
-	proto.RegisterType((*MsgReportData)(nil), "bandchain.chain.x.oracle.v1.MsgReportData")
-	proto.RegisterType((*MsgCreateDataSource)(nil), "bandchain.chain.x.oracle.v1.MsgCreateDataSource")
-	proto.RegisterType((*MsgEditDataSource)(nil), "bandchain.chain.x.oracle.v1.MsgEditDataSource")
-	proto.RegisterType((*MsgCreateOracleScript)(nil), "bandchain.chain.x.oracle.v1.MsgCreateOracleScript")
-	proto.RegisterType((*MsgEditOracleScript)(nil), "bandchain.chain.x.oracle.v1.MsgEditOracleScript")
-	proto.RegisterType((*MsgActivate)(nil), "bandchain.chain.x.oracle.v1.MsgActivate")
-	proto.RegisterType((*MsgAddReporter)(nil), "bandchain.chain.x.oracle.v1.MsgAddReporter")
-	proto.RegisterType((*MsgRemoveReporter)(nil), "bandchain.chain.x.oracle.v1.MsgRemoveReporter")
-	proto.RegisterType((*DataSource)(nil), "bandchain.chain.x.oracle.v1.DataSource")
-	proto.RegisterType((*OracleScript)(nil), "bandchain.chain.x.oracle.v1.OracleScript")
-	proto.RegisterType((*RawRequest)(nil), "bandchain.chain.x.oracle.v1.RawRequest")
-	proto.RegisterType((*RawReport)(nil), "bandchain.chain.x.oracle.v1.RawReport")
-	proto.RegisterType((*Request)(nil), "bandchain.chain.x.oracle.v1.Request")
-	proto.RegisterType((*Report)(nil), "bandchain.chain.x.oracle.v1.Report")
-	proto.RegisterType((*OracleRequestPacketData)(nil), "bandchain.chain.x.oracle.v1.OracleRequestPacketData")
-	proto.RegisterType((*OracleResponsePacketData)(nil), "bandchain.chain.x.oracle.v1.OracleResponsePacketData")
-	proto.RegisterType((*ValidatorStatus)(nil), "bandchain.chain.x.oracle.v1.ValidatorStatus")
-	proto.RegisterType((*Params)(nil), "bandchain.chain.x.oracle.v1.Params")
+func (m *Params) GetRequestRetentionBlockCount() uint64 {
+	if m != nil {
+		return m.RequestRetentionBlockCount
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("x/oracle/types/types.proto", fileDescriptor_53e65fd95a58412c) }
+func (m *Params) GetMaxPruneCountPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPruneCountPerBlock
+	}
+	return 0
+}
 
-var fileDescriptor_53e65fd95a58412c = []byte{
-	// 1500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x3b, 0x6c, 0x1c, 0x55,
-	0x17, 0xf6, 0xcc, 0xec, 0xae, 0x67, 0xcf, 0xda, 0x8e, 0x33, 0x49, 0x9c, 0x8d, 0xfd, 0xcb, 0xbb,
-	0x7f, 0xf4, 0xff, 0xc1, 0x44, 0x64, 0x57, 0x09, 0x08, 0x11, 0x4b, 0x48, 0x78, 0xed, 0x24, 0x58,
-	0xc2, 0xc4, 0x8c, 0x43, 0x0a, 0x9a, 0xd1, 0xdd, 0x99, 0x9b, 0xf5, 0xc8, 0xf3, 0xe2, 0xde, 0x59,
-	0x7b, 0x5d, 0x82, 0x44, 0x9f, 0x92, 0x82, 0x22, 0x25, 0x0d, 0x0d, 0x12, 0x48, 0x54, 0xb4, 0x91,
-	0x90, 0x50, 0x0a, 0x0a, 0x44, 0xb1, 0xa0, 0x4d, 0x83, 0x44, 0x43, 0x9d, 0x0a, 0xdd, 0xc7, 0xbc,
-	0x42, 0xd8, 0x24, 0xce, 0x42, 0x42, 0x63, 0xcf, 0x79, 0xdc, 0x99, 0x73, 0xbf, 0xf3, 0x9d, 0x73,
-	0xef, 0x59, 0x58, 0x1c, 0xb4, 0x43, 0x82, 0x6c, 0x0f, 0xb7, 0xe3, 0xc3, 0x08, 0x53, 0xf1, 0xb7,
-	0x15, 0x91, 0x30, 0x0e, 0x8d, 0xa5, 0x2e, 0x0a, 0x1c, 0x7b, 0x17, 0xb9, 0x41, 0x4b, 0xfc, 0x1d,
-	0xb4, 0x84, 0x6f, 0x6b, 0xff, 0xe2, 0xe2, 0xb9, 0x78, 0xd7, 0x25, 0x8e, 0x15, 0x21, 0x12, 0x1f,
-	0xb6, 0xb9, 0x7f, 0xbb, 0x17, 0xf6, 0xc2, 0xec, 0x49, 0xbc, 0x64, 0xb1, 0xd1, 0x0b, 0xc3, 0x9e,
-	0x87, 0x85, 0x4b, 0xb7, 0x7f, 0xab, 0x1d, 0xbb, 0x3e, 0xa6, 0x31, 0xf2, 0x23, 0xe1, 0x70, 0xf6,
-	0x4b, 0x15, 0xe6, 0xb6, 0x68, 0xcf, 0xc4, 0x1f, 0xf6, 0x31, 0x8d, 0x37, 0x50, 0x8c, 0x8c, 0x77,
-	0x61, 0x5e, 0x7c, 0xc8, 0xa2, 0x36, 0x71, 0xa3, 0xd8, 0x72, 0x9d, 0xba, 0xd2, 0x54, 0x56, 0xb4,
-	0xce, 0xff, 0x46, 0xc3, 0xc6, 0xdc, 0x75, 0x6e, 0xdb, 0xe1, 0xa6, 0xcd, 0x8d, 0x07, 0x7f, 0xd2,
-	0x98, 0x73, 0x61, 0x5e, 0x76, 0x8c, 0x45, 0xd0, 0x6d, 0xe4, 0x79, 0x0e, 0x8a, 0x51, 0x5d, 0x6d,
-	0x2a, 0x2b, 0x33, 0x66, 0x2a, 0x1b, 0x4b, 0x50, 0x45, 0x74, 0xcf, 0xb2, 0xc3, 0x7e, 0x10, 0xd7,
-	0xb5, 0xa6, 0xb2, 0x52, 0x32, 0x75, 0x44, 0xf7, 0xd6, 0x99, 0xcc, 0x8c, 0xbe, 0x1b, 0x48, 0x63,
-	0x49, 0x18, 0x7d, 0x37, 0x10, 0xc6, 0x97, 0xa1, 0x6a, 0x7b, 0x2e, 0x0e, 0x78, 0x78, 0xe5, 0xa6,
-	0xb2, 0x52, 0xed, 0xcc, 0x8c, 0x86, 0x0d, 0x7d, 0x9d, 0x2b, 0x37, 0x37, 0x4c, 0x5d, 0x98, 0x37,
-	0x1d, 0x63, 0x13, 0x2a, 0x14, 0x07, 0x0e, 0x26, 0xf5, 0x0a, 0xfb, 0x7c, 0xe7, 0xe2, 0x83, 0x61,
-	0xe3, 0x42, 0xcf, 0x8d, 0x77, 0xfb, 0xdd, 0x96, 0x1d, 0xfa, 0x6d, 0x3b, 0xa4, 0x7e, 0x48, 0xe5,
-	0xbf, 0x0b, 0xd4, 0xd9, 0x93, 0x79, 0x58, 0xb3, 0xed, 0x35, 0xc7, 0x21, 0x98, 0x52, 0x53, 0xbe,
-	0x60, 0xb5, 0xf4, 0xeb, 0x9d, 0x86, 0x72, 0xf6, 0x5b, 0x15, 0x66, 0x39, 0x68, 0x51, 0x48, 0x04,
-	0x66, 0x97, 0x01, 0x88, 0x80, 0x30, 0x43, 0x6b, 0x71, 0x34, 0x6c, 0x54, 0x25, 0xb0, 0x1c, 0xa8,
-	0x4c, 0x30, 0xab, 0xd2, 0x7b, 0xd3, 0x31, 0xb6, 0xa0, 0x46, 0xd0, 0x81, 0x45, 0xf8, 0xcb, 0x68,
-	0x5d, 0x6d, 0x6a, 0x2b, 0xb5, 0x4b, 0xe7, 0x5a, 0x63, 0xb2, 0xdf, 0x32, 0xd1, 0x81, 0xf8, 0x76,
-	0xa7, 0x74, 0x77, 0xd8, 0x98, 0x32, 0x81, 0x24, 0x0a, 0x6a, 0x5c, 0x87, 0xea, 0x3e, 0xf2, 0x5c,
-	0x07, 0xc5, 0x21, 0xe1, 0x88, 0x3e, 0xf9, 0x7e, 0x6f, 0x22, 0x2f, 0xd9, 0x6f, 0xf6, 0x0e, 0x63,
-	0x0b, 0x74, 0x11, 0x1b, 0x26, 0x3c, 0x09, 0x47, 0xc2, 0x2f, 0x7d, 0x85, 0x44, 0xf0, 0x13, 0x15,
-	0x4e, 0x6c, 0xd1, 0xde, 0x3a, 0xc1, 0x28, 0xc6, 0x0c, 0xc1, 0x9d, 0xb0, 0x4f, 0x6c, 0x6c, 0x5c,
-	0x83, 0x72, 0x78, 0x10, 0x60, 0xc2, 0x21, 0x3c, 0xd2, 0x97, 0xc4, 0x7a, 0xc3, 0x80, 0x52, 0x80,
-	0x7c, 0xcc, 0x09, 0x57, 0x35, 0xf9, 0xb3, 0xd1, 0x84, 0x9a, 0x83, 0x05, 0xa7, 0xdd, 0x30, 0xe0,
-	0xe0, 0x54, 0xcd, 0xbc, 0xca, 0x58, 0x06, 0xc0, 0x03, 0x6c, 0xf7, 0x63, 0xd4, 0xf5, 0xb0, 0xd8,
-	0xad, 0x99, 0xd3, 0xe4, 0x98, 0x54, 0x9e, 0x0c, 0x93, 0xbe, 0x53, 0xe1, 0xf8, 0x16, 0xed, 0x5d,
-	0x71, 0xdc, 0x38, 0x87, 0xc2, 0x55, 0x98, 0x63, 0xd5, 0x61, 0x51, 0x2e, 0x66, 0x8c, 0x6a, 0x8e,
-	0x86, 0x8d, 0x99, 0xcc, 0x8f, 0x93, 0xaa, 0x20, 0x9b, 0x33, 0x4e, 0x26, 0x39, 0x19, 0x9a, 0xea,
-	0x84, 0xd0, 0xd4, 0xfe, 0x1a, 0xcd, 0xd2, 0xe3, 0xd0, 0x2c, 0x8f, 0x41, 0x73, 0x42, 0x75, 0xf9,
-	0xbd, 0x0a, 0xa7, 0x52, 0x56, 0xe5, 0xbb, 0xd2, 0xf3, 0xe6, 0x95, 0x01, 0x25, 0x3b, 0x74, 0x12,
-	0x46, 0xf1, 0x67, 0x63, 0x01, 0x2a, 0xd4, 0xde, 0xc5, 0x3e, 0x12, 0xdd, 0xcb, 0x94, 0x92, 0x71,
-	0x19, 0x8e, 0xc9, 0xbc, 0x33, 0x37, 0xab, 0x4f, 0x3c, 0x0e, 0x4f, 0xb5, 0x73, 0x7c, 0x34, 0x6c,
-	0xcc, 0x8a, 0xdc, 0xae, 0x87, 0x0e, 0x7e, 0xdf, 0x7c, 0xc7, 0x9c, 0xa5, 0x99, 0x48, 0xbc, 0x1c,
-	0xa0, 0xd3, 0x93, 0x01, 0xf4, 0x33, 0x8d, 0x97, 0x29, 0xa3, 0x67, 0x01, 0xce, 0x49, 0x1f, 0x11,
-	0xcf, 0x99, 0xa8, 0x49, 0x7a, 0xca, 0x8f, 0x4c, 0x4f, 0xe5, 0x71, 0xe9, 0x99, 0x7e, 0xea, 0xf4,
-	0xe8, 0x93, 0x49, 0x8f, 0x03, 0xb5, 0x2d, 0xda, 0x5b, 0xb3, 0x63, 0x77, 0x1f, 0xc5, 0xb8, 0xd8,
-	0xfa, 0x95, 0x67, 0x6f, 0xfd, 0xf2, 0x2b, 0x5f, 0x2b, 0xfc, 0x8a, 0xb0, 0xe6, 0x38, 0xa6, 0x6c,
-	0xe2, 0x13, 0xff, 0x52, 0xe1, 0x90, 0x51, 0x27, 0x75, 0xc8, 0x7c, 0xa3, 0xf0, 0xe6, 0x6a, 0x62,
-	0x3f, 0xdc, 0xc7, 0xff, 0xb2, 0xd8, 0xbf, 0x50, 0x00, 0x5e, 0x9c, 0x73, 0x71, 0x11, 0xf4, 0x5b,
-	0xae, 0x87, 0xf9, 0x4a, 0x51, 0x3f, 0xa9, 0x2c, 0xe3, 0xfd, 0x58, 0x85, 0x99, 0x17, 0xa9, 0xe3,
-	0x8e, 0x89, 0xf8, 0x6f, 0xe8, 0xbc, 0x12, 0x84, 0xaf, 0x14, 0x00, 0x7e, 0x37, 0xe3, 0x77, 0x3b,
-	0xe3, 0x4d, 0xa8, 0xe1, 0x41, 0x8c, 0x49, 0x80, 0xbc, 0xac, 0x41, 0xfe, 0x67, 0x34, 0x6c, 0xc0,
-	0x15, 0xa9, 0xe6, 0xcd, 0x31, 0x27, 0xb1, 0xe3, 0x51, 0x3e, 0x3b, 0x8f, 0xb8, 0x05, 0xa8, 0x47,
-	0xba, 0x05, 0xe4, 0xef, 0xdf, 0x5a, 0xf1, 0xfe, 0x2d, 0xe3, 0xfe, 0x48, 0x81, 0x6a, 0x7a, 0xa7,
-	0x7c, 0xd6, 0xb0, 0x97, 0xa0, 0x8a, 0x07, 0x6e, 0xcc, 0x31, 0xe4, 0x11, 0xcf, 0x9a, 0x3a, 0x53,
-	0x30, 0xa8, 0x58, 0x32, 0x73, 0x71, 0x94, 0x72, 0x31, 0xfc, 0xa6, 0xc1, 0x74, 0x02, 0xdc, 0x3f,
-	0x39, 0x81, 0x38, 0x70, 0x52, 0xde, 0xc5, 0xb1, 0x63, 0xa5, 0x45, 0x4d, 0xeb, 0x5a, 0x53, 0x3b,
-	0x5a, 0x67, 0x38, 0x91, 0xbe, 0xee, 0x66, 0xfa, 0xb6, 0xf1, 0xa3, 0xcc, 0xff, 0x61, 0x2e, 0x19,
-	0x1e, 0x76, 0xb1, 0xdb, 0xdb, 0x8d, 0x39, 0x2f, 0x35, 0x73, 0x56, 0x6a, 0xdf, 0xe6, 0x4a, 0xe3,
-	0x1a, 0xcc, 0x24, 0x6e, 0x6c, 0x8a, 0xe3, 0xdc, 0xac, 0x5d, 0x5a, 0x6c, 0x89, 0x11, 0xaf, 0x95,
-	0x8c, 0x78, 0xad, 0x1b, 0xc9, 0x88, 0xd7, 0xd1, 0xd9, 0x74, 0x70, 0xfb, 0xe7, 0x86, 0x62, 0xd6,
-	0xe4, 0x4a, 0x66, 0x2b, 0x8e, 0x4e, 0xd3, 0x63, 0x47, 0xa7, 0x6d, 0x98, 0x11, 0xc3, 0x09, 0x5f,
-	0x4d, 0xeb, 0x3a, 0x9f, 0x4e, 0x5e, 0x7a, 0xfc, 0x74, 0xc2, 0xfd, 0xe5, 0x78, 0x52, 0x23, 0xa9,
-	0x86, 0xca, 0x6c, 0xff, 0xa4, 0x40, 0x45, 0xd2, 0x6d, 0xe2, 0xfd, 0xf8, 0x3c, 0x1c, 0x77, 0x03,
-	0xab, 0x8b, 0x6f, 0x85, 0x04, 0x5b, 0x04, 0xd3, 0xd0, 0xdb, 0x17, 0x44, 0xd4, 0xcd, 0x63, 0x6e,
-	0xd0, 0xe1, 0x7a, 0x53, 0xa8, 0x1f, 0x1e, 0xbe, 0xb4, 0x67, 0x1b, 0xbe, 0xe4, 0xe6, 0x7e, 0x57,
-	0xe0, 0xb4, 0x60, 0xa4, 0xdc, 0xf5, 0x36, 0xb2, 0xf7, 0xb0, 0x18, 0x14, 0x0b, 0xd8, 0x2b, 0x63,
-	0xb1, 0x7f, 0x54, 0x15, 0xa8, 0x13, 0xaa, 0x02, 0x6d, 0xdc, 0x1c, 0x5e, 0x1a, 0x37, 0x87, 0x97,
-	0x8b, 0xe4, 0x95, 0x5b, 0xfe, 0x41, 0x85, 0x7a, 0xb2, 0x65, 0x1a, 0x85, 0x01, 0xc5, 0x47, 0xdb,
-	0x73, 0x71, 0x8e, 0x56, 0x9f, 0x66, 0x8e, 0x66, 0x5b, 0x08, 0xe8, 0x43, 0x3f, 0x25, 0x04, 0x54,
-	0x6c, 0xe1, 0xbf, 0x0f, 0xd5, 0x4e, 0x89, 0x17, 0x58, 0xa1, 0x2a, 0xb8, 0x0b, 0x67, 0x85, 0x70,
-	0x29, 0x27, 0x2e, 0x5c, 0xc7, 0x5d, 0xde, 0x63, 0x85, 0x2a, 0x5c, 0x68, 0x8c, 0xe2, 0x3e, 0xe5,
-	0x35, 0x38, 0x77, 0xe9, 0xfc, 0x78, 0xc2, 0x88, 0x25, 0x3b, 0x7c, 0x05, 0x2b, 0xea, 0x9c, 0xc8,
-	0xce, 0x22, 0x82, 0x69, 0xdf, 0x8b, 0xc5, 0x95, 0xdd, 0x94, 0x92, 0x84, 0x35, 0x82, 0x63, 0x69,
-	0x13, 0x91, 0x0b, 0x96, 0xa0, 0xea, 0x52, 0x0b, 0xb1, 0x3b, 0x1f, 0xe6, 0x60, 0xea, 0xa6, 0xee,
-	0x52, 0x7e, 0x07, 0xc4, 0xc6, 0x2a, 0x94, 0xa9, 0x1b, 0xd8, 0x82, 0xee, 0x4f, 0xda, 0x1b, 0xc4,
-	0x12, 0xf9, 0xc5, 0xcf, 0x35, 0xa8, 0x6c, 0x23, 0x82, 0x7c, 0x6a, 0x5c, 0x84, 0x53, 0x3e, 0x1a,
-	0x58, 0xb9, 0xfa, 0x97, 0xe0, 0x2a, 0x1c, 0x5c, 0xc3, 0x47, 0x83, 0xac, 0xd4, 0x05, 0xcc, 0x67,
-	0x61, 0x96, 0x2d, 0xc9, 0xa8, 0xa4, 0x72, 0xd7, 0x9a, 0x8f, 0x06, 0x6b, 0x09, 0x9b, 0x5e, 0x83,
-	0x05, 0x3c, 0x88, 0x5c, 0x82, 0xd8, 0x39, 0x6d, 0x75, 0xbd, 0xd0, 0x2e, 0xfe, 0xfe, 0x73, 0x32,
-	0xb3, 0x76, 0x98, 0x51, 0xac, 0x5a, 0x81, 0xf9, 0x2e, 0xa2, 0x38, 0x8d, 0xa4, 0x87, 0xa8, 0xe4,
-	0xe9, 0x1c, 0xd3, 0xcb, 0x28, 0xae, 0x21, 0x6a, 0x5c, 0x86, 0x33, 0x11, 0x26, 0x59, 0x2b, 0x2f,
-	0x2c, 0x11, 0xec, 0x5d, 0x88, 0x30, 0x49, 0x71, 0xcd, 0x2d, 0x7d, 0x05, 0x0c, 0x8a, 0xfc, 0xc8,
-	0x73, 0x83, 0x9e, 0x15, 0x93, 0x43, 0x19, 0x56, 0x85, 0xaf, 0x99, 0x4f, 0x2c, 0x37, 0xc8, 0xa1,
-	0x08, 0xe9, 0x0d, 0xa8, 0xcb, 0xfa, 0x24, 0xf8, 0x00, 0x11, 0xc7, 0x8a, 0x30, 0xb1, 0x71, 0x10,
-	0xa3, 0x1e, 0xe6, 0xc9, 0x2c, 0x99, 0x0b, 0xa1, 0x2c, 0x09, 0x66, 0xde, 0x4e, 0xad, 0xc6, 0x2a,
-	0x9c, 0x71, 0x03, 0x91, 0x42, 0x2b, 0xc2, 0x01, 0xf2, 0xe2, 0x43, 0xcb, 0xe9, 0x8b, 0x3d, 0xf3,
-	0xd9, 0xa0, 0x64, 0x9e, 0x4e, 0x1c, 0xb6, 0x85, 0x7d, 0x43, 0x9a, 0x57, 0xf5, 0x4f, 0xef, 0x34,
-	0xa6, 0x58, 0xaa, 0xce, 0xbf, 0x05, 0xb3, 0x05, 0x6a, 0x19, 0x3a, 0x94, 0xae, 0x47, 0x38, 0x98,
-	0x9f, 0x32, 0x6a, 0x30, 0xbd, 0xd3, 0xb7, 0x6d, 0x4c, 0xe9, 0xbc, 0xc2, 0x84, 0xab, 0xc8, 0xf5,
-	0xfa, 0x04, 0xcf, 0xab, 0x4c, 0xb8, 0xc2, 0xf0, 0xc5, 0xce, 0xbc, 0xd6, 0xd9, 0xbe, 0x3b, 0x5a,
-	0x56, 0xee, 0x8d, 0x96, 0x95, 0x5f, 0x46, 0xcb, 0xca, 0xed, 0xfb, 0xcb, 0x53, 0xf7, 0xee, 0x2f,
-	0x4f, 0xfd, 0x78, 0x7f, 0x79, 0xea, 0x83, 0xd7, 0x73, 0xdd, 0x97, 0x71, 0x9b, 0x13, 0xc8, 0x0e,
-	0xbd, 0x76, 0x4a, 0xf4, 0xb6, 0xf8, 0x5b, 0xfc, 0x01, 0xb3, 0x5b, 0xe1, 0x8e, 0xaf, 0xfe, 0x11,
-	0x00, 0x00, 0xff, 0xff, 0x9a, 0x36, 0xe7, 0x80, 0xd9, 0x14, 0x00, 0x00,
+func (m *Params) GetPruneResult() bool {
+	if m != nil {
+		return m.PruneResult
+	}
+	return false
 }
 
-func (this *MsgRequestData) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Params) GetCommitBlockCount() uint64 {
+	if m != nil {
+		return m.CommitBlockCount
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgRequestData)
-	if !ok {
-		that2, ok := that.(MsgRequestData)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Params) GetReportWindow() uint64 {
+	if m != nil {
+		return m.ReportWindow
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *Params) GetMinReportPercentage() uint64 {
+	if m != nil {
+		return m.MinReportPercentage
 	}
-	if this.OracleScriptID != that1.OracleScriptID {
-		return false
+	return 0
+}
+
+func (m *Params) GetMissedReportSlashPercentage() uint64 {
+	if m != nil {
+		return m.MissedReportSlashPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetRewardWeightingMode() uint64 {
+	if m != nil {
+		return m.RewardWeightingMode
+	}
+	return 0
+}
+
+func (m *Params) GetInBeforeResolveBonusPercentage() uint64 {
+	if m != nil {
+		return m.InBeforeResolveBonusPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetStandingRequestFee() uint64 {
+	if m != nil {
+		return m.StandingRequestFee
+	}
+	return 0
+}
+
+func (m *Params) GetMinStandingRequestInterval() uint64 {
+	if m != nil {
+		return m.MinStandingRequestInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStandingRequestsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxStandingRequestsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStandingRequestFailures() uint64 {
+	if m != nil {
+		return m.MaxStandingRequestFailures
+	}
+	return 0
+}
+
+func (m *Params) GetMissedReportJailDuration() uint64 {
+	if m != nil {
+		return m.MissedReportJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetRewardPeriod() uint64 {
+	if m != nil {
+		return m.RewardPeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("bandchain.chain.x.oracle.v1.ResolveStatus", ResolveStatus_name, ResolveStatus_value)
+	proto.RegisterType((*MsgRequestData)(nil), "bandchain.chain.x.oracle.v1.MsgRequestData")
+	proto.RegisterType((*MsgReportData)(nil), "bandchain.chain.x.oracle.v1.MsgReportData")
+	proto.RegisterType((*MsgCommitReport)(nil), "bandchain.chain.x.oracle.v1.MsgCommitReport")
+	proto.RegisterType((*MsgRevealReport)(nil), "bandchain.chain.x.oracle.v1.MsgRevealReport")
+	proto.RegisterType((*MsgCreateDataSource)(nil), "bandchain.chain.x.oracle.v1.MsgCreateDataSource")
+	proto.RegisterType((*MsgEditDataSource)(nil), "bandchain.chain.x.oracle.v1.MsgEditDataSource")
+	proto.RegisterType((*MsgCreateOracleScript)(nil), "bandchain.chain.x.oracle.v1.MsgCreateOracleScript")
+	proto.RegisterType((*MsgEditOracleScript)(nil), "bandchain.chain.x.oracle.v1.MsgEditOracleScript")
+	proto.RegisterType((*MsgActivate)(nil), "bandchain.chain.x.oracle.v1.MsgActivate")
+	proto.RegisterType((*MsgAddReporter)(nil), "bandchain.chain.x.oracle.v1.MsgAddReporter")
+	proto.RegisterType((*MsgRemoveReporter)(nil), "bandchain.chain.x.oracle.v1.MsgRemoveReporter")
+	proto.RegisterType((*MsgCreateStandingRequest)(nil), "bandchain.chain.x.oracle.v1.MsgCreateStandingRequest")
+	proto.RegisterType((*MsgCancelStandingRequest)(nil), "bandchain.chain.x.oracle.v1.MsgCancelStandingRequest")
+	proto.RegisterType((*DataSource)(nil), "bandchain.chain.x.oracle.v1.DataSource")
+	proto.RegisterType((*DataSourceVersion)(nil), "bandchain.chain.x.oracle.v1.DataSourceVersion")
+	proto.RegisterType((*OracleScript)(nil), "bandchain.chain.x.oracle.v1.OracleScript")
+	proto.RegisterType((*OracleScriptVersion)(nil), "bandchain.chain.x.oracle.v1.OracleScriptVersion")
+	proto.RegisterType((*RawRequest)(nil), "bandchain.chain.x.oracle.v1.RawRequest")
+	proto.RegisterType((*RawReport)(nil), "bandchain.chain.x.oracle.v1.RawReport")
+	proto.RegisterType((*Request)(nil), "bandchain.chain.x.oracle.v1.Request")
+	proto.RegisterType((*StandingRequest)(nil), "bandchain.chain.x.oracle.v1.StandingRequest")
+	proto.RegisterType((*Report)(nil), "bandchain.chain.x.oracle.v1.Report")
+	proto.RegisterType((*ReportCommit)(nil), "bandchain.chain.x.oracle.v1.ReportCommit")
+	proto.RegisterType((*OracleRequestPacketData)(nil), "bandchain.chain.x.oracle.v1.OracleRequestPacketData")
+	proto.RegisterType((*OracleResponsePacketData)(nil), "bandchain.chain.x.oracle.v1.OracleResponsePacketData")
+	proto.RegisterType((*ValidatorStatus)(nil), "bandchain.chain.x.oracle.v1.ValidatorStatus")
+	proto.RegisterType((*ReportInfo)(nil), "bandchain.chain.x.oracle.v1.ReportInfo")
+	proto.RegisterType((*ValidatorReportStat)(nil), "bandchain.chain.x.oracle.v1.ValidatorReportStat")
+	proto.RegisterType((*Params)(nil), "bandchain.chain.x.oracle.v1.Params")
+}
+
+func init() { proto.RegisterFile("x/oracle/types/types.proto", fileDescriptor_53e65fd95a58412c) }
+
+var fileDescriptor_53e65fd95a58412c = []byte{
+	// 2240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xfb, 0x2b, 0xf6, 0xb3, 0x9d, 0x8f, 0x76, 0x26, 0xe3, 0x49, 0x20, 0x0e, 0xb3, 0xb0,
+	0x84, 0x11, 0xeb, 0x30, 0xc3, 0x82, 0x76, 0x46, 0x5a, 0x89, 0x38, 0x99, 0x99, 0xcd, 0x6a, 0xc3,
+	0x84, 0xce, 0x32, 0x2b, 0xed, 0xa5, 0x55, 0xee, 0x2e, 0xdb, 0x45, 0xfa, 0xc3, 0x54, 0xb5, 0x13,
+	0xe7, 0x08, 0x07, 0x6e, 0x48, 0x2b, 0x71, 0x60, 0x0f, 0x48, 0xec, 0x9f, 0x80, 0x38, 0x20, 0x71,
+	0xda, 0x23, 0x7b, 0x82, 0x3d, 0x80, 0x84, 0x90, 0x08, 0xc8, 0x23, 0x24, 0x8e, 0x9c, 0x38, 0xec,
+	0x09, 0xd5, 0x47, 0xb7, 0xbb, 0xdb, 0xc1, 0x33, 0x93, 0x58, 0x9a, 0x9d, 0xbd, 0x78, 0xba, 0x5e,
+	0xbd, 0xaa, 0x7a, 0xf5, 0x7b, 0x9f, 0xf5, 0x26, 0xb0, 0x36, 0xdc, 0xf6, 0x29, 0xb2, 0x1c, 0xbc,
+	0x1d, 0x9c, 0xf5, 0x31, 0x93, 0xbf, 0xcd, 0x3e, 0xf5, 0x03, 0x5f, 0x5f, 0x6f, 0x23, 0xcf, 0xb6,
+	0x7a, 0x88, 0x78, 0x4d, 0xf9, 0x3b, 0x6c, 0x4a, 0xde, 0xe6, 0xc9, 0xed, 0xb5, 0x57, 0x83, 0x1e,
+	0xa1, 0xb6, 0xd9, 0x47, 0x34, 0x38, 0xdb, 0x16, 0xfc, 0xdb, 0x5d, 0xbf, 0xeb, 0x8f, 0xbf, 0xe4,
+	0x26, 0x6b, 0x8d, 0xae, 0xef, 0x77, 0x1d, 0x2c, 0x59, 0xda, 0x83, 0xce, 0x76, 0x40, 0x5c, 0xcc,
+	0x02, 0xe4, 0xf6, 0x25, 0xc3, 0xcd, 0x3f, 0x65, 0x60, 0xe1, 0x80, 0x75, 0x0d, 0xfc, 0xe3, 0x01,
+	0x66, 0xc1, 0x1e, 0x0a, 0x90, 0xfe, 0x7d, 0x58, 0x92, 0x07, 0x99, 0xcc, 0xa2, 0xa4, 0x1f, 0x98,
+	0xc4, 0xae, 0x6b, 0x9b, 0xda, 0x56, 0xb6, 0xf5, 0xd5, 0xd1, 0x79, 0x63, 0xe1, 0x91, 0x98, 0x3b,
+	0x12, 0x53, 0xfb, 0x7b, 0x9f, 0x4d, 0x50, 0x8c, 0x05, 0x3f, 0x3e, 0xb6, 0xf5, 0x35, 0x28, 0x5a,
+	0xc8, 0x71, 0x6c, 0x14, 0xa0, 0x7a, 0x66, 0x53, 0xdb, 0xaa, 0x18, 0xd1, 0x58, 0x5f, 0x87, 0x12,
+	0x62, 0xc7, 0xa6, 0xe5, 0x0f, 0xbc, 0xa0, 0x9e, 0xdd, 0xd4, 0xb6, 0x72, 0x46, 0x11, 0xb1, 0xe3,
+	0x5d, 0x3e, 0xe6, 0x93, 0x2e, 0xf1, 0xd4, 0x64, 0x4e, 0x4e, 0xba, 0xc4, 0x93, 0x93, 0xdf, 0x80,
+	0x92, 0xe5, 0x10, 0xec, 0x09, 0xf1, 0xf2, 0x9b, 0xda, 0x56, 0xa9, 0x55, 0x19, 0x9d, 0x37, 0x8a,
+	0xbb, 0x82, 0xb8, 0xbf, 0x67, 0x14, 0xe5, 0xf4, 0xbe, 0xad, 0xef, 0x43, 0x81, 0x61, 0xcf, 0xc6,
+	0xb4, 0x5e, 0xe0, 0xc7, 0xb7, 0x6e, 0x7f, 0x76, 0xde, 0x78, 0xad, 0x4b, 0x82, 0xde, 0xa0, 0xdd,
+	0xb4, 0x7c, 0x77, 0xdb, 0xf2, 0x99, 0xeb, 0x33, 0xf5, 0xcf, 0x6b, 0xcc, 0x3e, 0x56, 0x7a, 0xd8,
+	0xb1, 0xac, 0x1d, 0xdb, 0xa6, 0x98, 0x31, 0x43, 0x6d, 0xc0, 0x45, 0xea, 0x60, 0x6c, 0x3a, 0xc4,
+	0x25, 0x41, 0x7d, 0x9e, 0x9f, 0x6a, 0x14, 0x3b, 0x18, 0xbf, 0xc3, 0xc7, 0xf7, 0x72, 0xff, 0xfe,
+	0xa8, 0xa1, 0xdd, 0xfc, 0x38, 0x03, 0x55, 0x81, 0x68, 0xdf, 0xa7, 0x12, 0xd0, 0xbb, 0x00, 0x54,
+	0xe2, 0x3b, 0x86, 0x72, 0x6d, 0x74, 0xde, 0x28, 0x29, 0xd4, 0x05, 0x8a, 0xe3, 0x81, 0x51, 0x52,
+	0xdc, 0xfb, 0xb6, 0x7e, 0x00, 0x65, 0x8a, 0x4e, 0x4d, 0x2a, 0x36, 0x63, 0xf5, 0xcc, 0x66, 0x76,
+	0xab, 0x7c, 0xe7, 0xd5, 0xe6, 0x14, 0xd3, 0x68, 0x1a, 0xe8, 0x54, 0x9e, 0xdd, 0xca, 0x7d, 0x72,
+	0xde, 0x98, 0x33, 0x80, 0x86, 0x04, 0xa6, 0x3f, 0x82, 0xd2, 0x09, 0x72, 0x88, 0x8d, 0x02, 0x9f,
+	0xd6, 0xb3, 0xcf, 0x05, 0xc6, 0x63, 0xe4, 0x84, 0x60, 0x8c, 0xf7, 0xd0, 0x0f, 0xa0, 0x28, 0x65,
+	0xc3, 0xb4, 0x9e, 0x7b, 0xae, 0xfd, 0x62, 0xe0, 0x46, 0x5b, 0x28, 0x04, 0x7f, 0x91, 0x81, 0xc5,
+	0x03, 0xd6, 0xdd, 0xf5, 0x5d, 0x97, 0x04, 0x52, 0xf4, 0xab, 0x60, 0xd8, 0x80, 0xb2, 0x25, 0xb6,
+	0x32, 0x7b, 0x88, 0xf5, 0x94, 0x09, 0x82, 0x24, 0xbd, 0x85, 0x58, 0xef, 0x25, 0x41, 0xe5, 0x2f,
+	0x12, 0x15, 0x03, 0x9f, 0x60, 0xe4, 0x5c, 0x1d, 0x95, 0x19, 0x5b, 0x96, 0x0e, 0x39, 0x86, 0x1c,
+	0xe9, 0xc3, 0x15, 0x43, 0x7c, 0x27, 0x71, 0xcd, 0xcd, 0x18, 0xd7, 0xfc, 0xac, 0x70, 0xfd, 0x65,
+	0x06, 0x6a, 0xdc, 0xda, 0x28, 0x46, 0x01, 0xe6, 0xfe, 0x7a, 0xe4, 0x0f, 0xa8, 0x85, 0xf5, 0x87,
+	0x90, 0xf7, 0x4f, 0x3d, 0x4c, 0xeb, 0xda, 0x65, 0x4f, 0x92, 0xeb, 0x39, 0x34, 0x1e, 0x72, 0xb1,
+	0x30, 0xbc, 0x92, 0x21, 0xbe, 0xf5, 0x4d, 0x28, 0xdb, 0x58, 0x86, 0x57, 0xe2, 0x7b, 0x02, 0xb5,
+	0x92, 0x11, 0x27, 0xe9, 0x1b, 0x00, 0x78, 0x88, 0xad, 0x41, 0x80, 0xda, 0x0e, 0x96, 0xe8, 0x19,
+	0x31, 0x4a, 0x2c, 0xa8, 0xe5, 0xaf, 0x1a, 0xd4, 0x96, 0x20, 0xdb, 0xc1, 0x58, 0x04, 0xc7, 0x92,
+	0xc1, 0x3f, 0x15, 0x32, 0x7f, 0xcf, 0xc0, 0xf2, 0x01, 0xeb, 0xde, 0xb7, 0x49, 0x10, 0xc3, 0xe5,
+	0x01, 0x2c, 0xf0, 0xd0, 0x6d, 0x32, 0x31, 0x1c, 0xdb, 0xdd, 0xe6, 0xe8, 0xbc, 0x51, 0x19, 0xf3,
+	0x09, 0xd3, 0x4b, 0x8c, 0x8d, 0x8a, 0x3d, 0x1e, 0xd9, 0x63, 0x7c, 0x33, 0x33, 0xc2, 0x37, 0xfb,
+	0xff, 0xf1, 0xcd, 0x3d, 0x0d, 0xdf, 0xfc, 0x14, 0x7c, 0x0b, 0x33, 0xc2, 0x77, 0x3e, 0x8d, 0xef,
+	0x1f, 0x33, 0x70, 0x2d, 0xb2, 0xbc, 0x78, 0x12, 0x7d, 0xd1, 0xb6, 0xa7, 0x43, 0xce, 0xf2, 0xed,
+	0xd0, 0xea, 0xc4, 0xb7, 0xbe, 0x0a, 0x05, 0x66, 0xf5, 0xb0, 0x8b, 0x64, 0xb2, 0x35, 0xd4, 0x48,
+	0xbf, 0x0b, 0x8b, 0xca, 0x12, 0x38, 0x9b, 0x39, 0xa0, 0x8e, 0x34, 0xa4, 0xd6, 0xf2, 0xe8, 0xbc,
+	0x51, 0x95, 0xda, 0xde, 0xf5, 0x6d, 0xfc, 0x43, 0xe3, 0x1d, 0xa3, 0xca, 0xc6, 0x43, 0xea, 0xc4,
+	0x20, 0x9e, 0xbf, 0x22, 0xc4, 0x0a, 0xd0, 0x5f, 0x65, 0xa1, 0xa6, 0x0c, 0x36, 0x01, 0xe7, 0xac,
+	0x2b, 0x9a, 0x17, 0x6c, 0xba, 0xa1, 0x7a, 0xf2, 0x17, 0xaa, 0xa7, 0xf0, 0x34, 0xf5, 0xcc, 0x3f,
+	0xb7, 0x7a, 0x8a, 0xb3, 0x51, 0x8f, 0x0d, 0xe5, 0x03, 0xd6, 0xdd, 0xb1, 0x02, 0x72, 0x82, 0x02,
+	0x9c, 0x4c, 0x0f, 0xda, 0xd5, 0xd3, 0x83, 0x3a, 0xe5, 0x77, 0x9a, 0xa8, 0x68, 0x77, 0x6c, 0xdb,
+	0x50, 0x81, 0x7e, 0xe6, 0x27, 0x25, 0x12, 0x51, 0x66, 0x56, 0x89, 0xe8, 0xf7, 0x9a, 0x08, 0xb7,
+	0x06, 0x76, 0xfd, 0x13, 0xfc, 0x92, 0xc9, 0xfe, 0xf3, 0x2c, 0xd4, 0xa3, 0x50, 0x76, 0x14, 0x20,
+	0xcf, 0x26, 0x5e, 0xf8, 0xa8, 0xf8, 0xe2, 0x3d, 0x28, 0xd6, 0xa0, 0x48, 0xbc, 0x00, 0xd3, 0x13,
+	0x24, 0x83, 0x5d, 0xce, 0x88, 0xc6, 0xdc, 0x11, 0xdb, 0x03, 0xbb, 0x8b, 0xc3, 0xe7, 0x81, 0x1a,
+	0x25, 0x5f, 0x0e, 0xc5, 0xe4, 0xcb, 0x21, 0xe6, 0x6a, 0xa5, 0xd9, 0xb8, 0xda, 0x1f, 0x34, 0xa9,
+	0x0f, 0xe4, 0x59, 0xd8, 0x49, 0xeb, 0xe3, 0x7d, 0xa8, 0x31, 0x45, 0x32, 0x27, 0xca, 0xc7, 0x5b,
+	0xa3, 0xf3, 0xc6, 0x72, 0x6a, 0x85, 0xd0, 0xca, 0x24, 0xd1, 0x58, 0x66, 0x29, 0x52, 0xfc, 0xad,
+	0x95, 0x99, 0xcd, 0x4d, 0x3e, 0xd6, 0x00, 0x3e, 0x3f, 0x55, 0xd9, 0x1a, 0x14, 0x3b, 0xc4, 0xc1,
+	0x62, 0x65, 0x4e, 0x29, 0x51, 0x8d, 0xc3, 0x34, 0x9f, 0x4f, 0xa7, 0xf9, 0x0f, 0x35, 0x58, 0x1e,
+	0xdf, 0xe0, 0x31, 0xa6, 0x2c, 0xbd, 0x93, 0x96, 0xda, 0x69, 0x1f, 0x0a, 0xd8, 0x26, 0xdc, 0xe1,
+	0x2f, 0x0f, 0xa2, 0xdc, 0x80, 0x9b, 0x63, 0x0f, 0x93, 0x6e, 0x4f, 0x3a, 0x43, 0xd6, 0x50, 0x23,
+	0x25, 0xda, 0x4f, 0x33, 0x50, 0xf9, 0x3c, 0x15, 0x1e, 0xd3, 0xe0, 0x9d, 0x7d, 0x01, 0xa2, 0x40,
+	0xf8, 0xad, 0x06, 0xb5, 0x38, 0x08, 0xcf, 0xa2, 0xa1, 0xb1, 0x30, 0x99, 0x84, 0x30, 0x63, 0xcd,
+	0x65, 0x67, 0xa7, 0xb9, 0xdc, 0x05, 0x9a, 0xfb, 0x97, 0x06, 0x20, 0xde, 0x63, 0xd2, 0xa5, 0xdf,
+	0x84, 0x32, 0x1e, 0x06, 0x98, 0x7a, 0xc8, 0x19, 0xbb, 0xf2, 0x97, 0x46, 0xe7, 0x0d, 0xb8, 0xaf,
+	0xc8, 0xc2, 0x87, 0x63, 0x23, 0x5e, 0xec, 0xaa, 0x6f, 0xfb, 0x82, 0x9a, 0x3e, 0x73, 0xa9, 0x9a,
+	0x3e, 0x1e, 0x99, 0xb3, 0xa9, 0xc8, 0xdc, 0x84, 0x5a, 0xfc, 0x8c, 0x13, 0x89, 0xb2, 0xba, 0xdc,
+	0xb2, 0x9d, 0x76, 0x10, 0x75, 0xcf, 0x9f, 0x68, 0x50, 0x8a, 0xde, 0x9d, 0x57, 0xbd, 0xe6, 0x3a,
+	0x94, 0xf0, 0x90, 0x04, 0xc2, 0x50, 0xc4, 0x0d, 0xab, 0x46, 0x91, 0x13, 0xb8, 0x3d, 0x70, 0x8b,
+	0x8d, 0xc9, 0x2d, 0xbe, 0x95, 0x0c, 0xbf, 0xce, 0xc1, 0xfc, 0x8b, 0xc8, 0x65, 0x36, 0xac, 0xa8,
+	0xf0, 0x8c, 0x6d, 0x33, 0x4a, 0xe0, 0xac, 0x9e, 0xdd, 0xcc, 0x5e, 0xae, 0x0a, 0xa8, 0x45, 0xdb,
+	0x3d, 0x8e, 0x76, 0x9b, 0x9e, 0x14, 0xbf, 0x06, 0x0b, 0x61, 0x86, 0x50, 0xc6, 0x98, 0x17, 0xfa,
+	0xaa, 0x2a, 0xea, 0x5b, 0x82, 0xa8, 0x3f, 0x84, 0x4a, 0xc8, 0xc6, 0x1b, 0x8c, 0xc2, 0x01, 0xcb,
+	0x77, 0xd6, 0x9a, 0xb2, 0xfb, 0xd8, 0x0c, 0xbb, 0x8f, 0xcd, 0x77, 0xc3, 0xee, 0x63, 0xab, 0xc8,
+	0x3b, 0x08, 0x1f, 0xfc, 0xa3, 0xa1, 0x19, 0x65, 0xb5, 0x92, 0xcf, 0x25, 0x93, 0xf0, 0xfc, 0xd4,
+	0x24, 0x7c, 0x08, 0x15, 0xd9, 0xc0, 0x10, 0xab, 0x59, 0xbd, 0x28, 0x3a, 0x18, 0x5f, 0x7f, 0x7a,
+	0x07, 0x43, 0xf0, 0xab, 0x16, 0x46, 0x99, 0x46, 0x14, 0xa6, 0xdf, 0x81, 0x6b, 0x49, 0xdd, 0x86,
+	0x36, 0x5a, 0x12, 0x77, 0xae, 0xf9, 0x93, 0x41, 0x42, 0x59, 0xc8, 0x6f, 0xb2, 0xb0, 0x98, 0xce,
+	0xb2, 0x33, 0x0b, 0xa5, 0x17, 0x99, 0x5c, 0x66, 0x46, 0x26, 0x97, 0x9d, 0x56, 0x3e, 0xe5, 0xa6,
+	0x95, 0x4f, 0xf9, 0x69, 0xe5, 0x53, 0xe1, 0x99, 0xcb, 0xa7, 0xf9, 0x54, 0xf9, 0x34, 0xb5, 0x4c,
+	0x6a, 0x40, 0xd9, 0xc3, 0xc3, 0xc8, 0x14, 0xa5, 0x5a, 0x80, 0x93, 0x94, 0x1d, 0xbe, 0x02, 0xd5,
+	0x0e, 0x22, 0xce, 0x80, 0x62, 0x25, 0x25, 0x88, 0xed, 0x2b, 0x8a, 0x28, 0x24, 0x55, 0x2a, 0xfb,
+	0x9b, 0x06, 0x05, 0x15, 0x55, 0x66, 0x5e, 0x62, 0xdf, 0x82, 0x65, 0xe2, 0x99, 0x6d, 0xdc, 0xf1,
+	0x29, 0x36, 0x29, 0x66, 0xbe, 0x73, 0x22, 0xe3, 0x4d, 0xd1, 0x58, 0x24, 0x5e, 0x4b, 0xd0, 0x0d,
+	0x49, 0x4e, 0xf7, 0xe1, 0xb2, 0x57, 0xeb, 0xc3, 0xa9, 0xcb, 0xfd, 0x4c, 0x83, 0x8a, 0xa4, 0xc8,
+	0x26, 0xea, 0xec, 0xaf, 0xf8, 0xb4, 0xa6, 0xaa, 0x12, 0xe4, 0x3f, 0x1a, 0x5c, 0x97, 0xe6, 0xa8,
+	0xdc, 0xe2, 0x10, 0x59, 0xc7, 0x58, 0xb6, 0xc5, 0x13, 0x16, 0xa3, 0x4d, 0xb5, 0x98, 0x97, 0xc2,
+	0x05, 0xd4, 0x95, 0xff, 0x9c, 0x81, 0x7a, 0x78, 0x65, 0xd6, 0xf7, 0x3d, 0x86, 0x2f, 0x77, 0xe7,
+	0x64, 0x6f, 0x37, 0xf3, 0x3c, 0xbd, 0x5d, 0x7e, 0x05, 0x8f, 0xa5, 0x1e, 0x41, 0x1e, 0x93, 0x57,
+	0xf8, 0x4a, 0x2a, 0x56, 0xcb, 0x04, 0x9c, 0x88, 0xc2, 0x82, 0x45, 0x98, 0xa7, 0x64, 0xc9, 0x87,
+	0x2c, 0x82, 0x26, 0x58, 0x7e, 0x00, 0x0b, 0x6a, 0x68, 0xb2, 0x00, 0x05, 0x03, 0x26, 0x7c, 0x7e,
+	0xe1, 0xce, 0xad, 0xe9, 0x96, 0x2b, 0x97, 0x1c, 0x89, 0x15, 0x3c, 0x89, 0xc4, 0x86, 0xbc, 0xe0,
+	0xa1, 0x98, 0x0d, 0x1c, 0xf9, 0x72, 0xaa, 0x18, 0x6a, 0xa4, 0x60, 0xed, 0xc3, 0x62, 0x94, 0xb4,
+	0xd4, 0x82, 0x75, 0x28, 0x11, 0x66, 0x22, 0xde, 0x4f, 0x90, 0x15, 0x5a, 0xd1, 0x28, 0x12, 0x26,
+	0xfa, 0x0b, 0x58, 0xbf, 0x07, 0x79, 0x46, 0x3c, 0x4b, 0xfa, 0xdd, 0xb3, 0xe6, 0x22, 0xb9, 0x44,
+	0x9d, 0x78, 0x0c, 0x20, 0x7d, 0x68, 0xdf, 0xeb, 0xf8, 0x1c, 0x13, 0xe2, 0xd9, 0x78, 0x68, 0xfa,
+	0x9d, 0x0e, 0xc3, 0x81, 0x4c, 0xfa, 0x46, 0x59, 0xd0, 0x1e, 0x09, 0x92, 0xfe, 0x3a, 0xac, 0xba,
+	0x84, 0x31, 0x6c, 0x87, 0xde, 0x2c, 0x35, 0xa0, 0xde, 0x42, 0x59, 0x63, 0x45, 0xce, 0x2a, 0x57,
+	0xdd, 0x95, 0x73, 0xea, 0xb0, 0x01, 0xd4, 0xa2, 0xeb, 0x49, 0x06, 0x7e, 0x49, 0xa9, 0x09, 0x3e,
+	0x52, 0xca, 0xd4, 0x84, 0x32, 0xcb, 0x54, 0xf9, 0x36, 0xd7, 0xe7, 0x77, 0xe0, 0xfa, 0x44, 0xb0,
+	0x51, 0xdc, 0x19, 0xc1, 0xbd, 0x92, 0x0a, 0x39, 0x71, 0x63, 0xfd, 0x6f, 0x09, 0x0a, 0x87, 0x88,
+	0x22, 0x97, 0xe9, 0xb7, 0xe1, 0x9a, 0x8b, 0x86, 0x66, 0x2c, 0xa7, 0x26, 0xce, 0xd4, 0x5d, 0x34,
+	0x1c, 0xa7, 0x4f, 0x79, 0xf4, 0x4d, 0xa8, 0xf2, 0x25, 0x63, 0x77, 0x91, 0x07, 0x96, 0x5d, 0x34,
+	0xdc, 0x09, 0x3d, 0xe6, 0x75, 0x58, 0xc5, 0xc3, 0x3e, 0xa1, 0x88, 0x17, 0xf8, 0x66, 0xdb, 0xf1,
+	0xad, 0xe4, 0xeb, 0x7c, 0x65, 0x3c, 0xdb, 0xe2, 0x93, 0x72, 0xd5, 0x16, 0x2c, 0xb5, 0x11, 0xc3,
+	0x91, 0x24, 0x5d, 0xc4, 0x94, 0x2f, 0x2e, 0x70, 0xba, 0x92, 0xe2, 0x21, 0x62, 0xfa, 0x5d, 0xb8,
+	0xd1, 0xc7, 0x74, 0x5c, 0x1e, 0x25, 0x96, 0x48, 0x0f, 0x5d, 0xed, 0x63, 0x1a, 0x03, 0x37, 0x5a,
+	0xfa, 0x4d, 0xd0, 0x19, 0x72, 0xfb, 0x0e, 0x7f, 0x07, 0x07, 0xf4, 0x4c, 0x89, 0x25, 0x1f, 0xf4,
+	0x4b, 0xe1, 0xcc, 0xbb, 0xf4, 0x4c, 0x8a, 0xf4, 0x06, 0xd4, 0x55, 0x0c, 0xa2, 0xf8, 0x14, 0xf1,
+	0xff, 0x7c, 0xc5, 0xd4, 0xc2, 0x5e, 0x80, 0xba, 0x58, 0x65, 0xb1, 0x55, 0x5f, 0xb9, 0x3d, 0x9f,
+	0x3e, 0x8c, 0x66, 0xf5, 0x7b, 0x70, 0x83, 0x78, 0xd2, 0x4c, 0xcd, 0x3e, 0xf6, 0x90, 0x13, 0x9c,
+	0x99, 0xf6, 0x40, 0xde, 0x59, 0xe4, 0xb8, 0x9c, 0x71, 0x3d, 0x64, 0x38, 0x94, 0xf3, 0x7b, 0x6a,
+	0x5a, 0xdf, 0x81, 0x2f, 0x87, 0x17, 0xa2, 0x38, 0xc0, 0xde, 0x04, 0x8a, 0x25, 0xb1, 0x7e, 0x4d,
+	0x31, 0x19, 0x21, 0x4f, 0x0c, 0xcb, 0x37, 0xe0, 0x06, 0xd7, 0x52, 0x9f, 0x0e, 0x3c, 0x65, 0x18,
+	0x5c, 0x74, 0xb9, 0x89, 0x4a, 0x90, 0x5c, 0xf3, 0x87, 0x7c, 0x5e, 0xac, 0x38, 0xc4, 0x54, 0x2c,
+	0xe7, 0xd6, 0x27, 0x57, 0x29, 0xbf, 0x2c, 0x0b, 0x1f, 0x2b, 0x0b, 0x9a, 0x21, 0x48, 0x1c, 0x43,
+	0x95, 0x07, 0xe2, 0x42, 0x55, 0x24, 0x86, 0x72, 0x26, 0x26, 0xca, 0x2b, 0x50, 0x55, 0xe6, 0x7c,
+	0x4a, 0x3c, 0xdb, 0x3f, 0xad, 0x57, 0x65, 0x7e, 0x96, 0xc4, 0xf7, 0x04, 0x8d, 0x97, 0x61, 0x3c,
+	0xc6, 0x2a, 0xc6, 0x18, 0xca, 0x0b, 0x82, 0xb9, 0xe6, 0x12, 0x4f, 0x7a, 0x48, 0x0c, 0xe2, 0x5d,
+	0xd8, 0x48, 0xb8, 0x9e, 0xc9, 0x1c, 0xc4, 0x7a, 0xf1, 0xc5, 0x8b, 0x62, 0xf1, 0x7a, 0xdc, 0x05,
+	0x8f, 0x38, 0x4f, 0x6c, 0x93, 0x3b, 0x70, 0x4d, 0xa9, 0xf6, 0x54, 0x94, 0x13, 0xdc, 0x2e, 0x5c,
+	0xfe, 0x54, 0x58, 0x92, 0x07, 0xcb, 0xc9, 0xf7, 0xc2, 0xb9, 0x03, 0xfe, 0x6a, 0x78, 0x1b, 0x6e,
+	0x4e, 0x7a, 0x5f, 0xdb, 0xf7, 0x06, 0x2c, 0x7e, 0xf8, 0xb2, 0xd8, 0x60, 0x23, 0xe5, 0x88, 0x2d,
+	0xce, 0x16, 0x3b, 0xff, 0x5b, 0xb0, 0x32, 0xd1, 0x97, 0xe1, 0x1d, 0x05, 0x5d, 0x3a, 0x60, 0xaa,
+	0xd9, 0xf2, 0x00, 0x63, 0x6e, 0x1d, 0x1c, 0xaa, 0x89, 0x55, 0x51, 0x79, 0x55, 0x93, 0xd6, 0xe1,
+	0x12, 0x2f, 0xdd, 0xbd, 0x51, 0x1c, 0xfa, 0x1e, 0x34, 0xb8, 0x75, 0xa4, 0xb7, 0x60, 0x31, 0x1b,
+	0x59, 0x51, 0xd0, 0xa1, 0x61, 0x6a, 0x13, 0x16, 0x59, 0x0a, 0x17, 0xe4, 0x82, 0x5d, 0x4c, 0x55,
+	0x78, 0xb1, 0xfa, 0x35, 0x25, 0xc8, 0xc4, 0x1e, 0x0f, 0x14, 0x87, 0xfe, 0x26, 0xac, 0x27, 0x55,
+	0xf8, 0x23, 0x44, 0x9c, 0xb1, 0x9f, 0xac, 0x8a, 0x0d, 0xea, 0x71, 0xfd, 0xbd, 0x8d, 0x88, 0x13,
+	0x39, 0x8a, 0x30, 0xad, 0xd0, 0x2f, 0x89, 0x6f, 0xd7, 0xaf, 0x87, 0xa6, 0xa5, 0xbc, 0x91, 0xf8,
+	0xf6, 0xbd, 0xe2, 0x87, 0x1f, 0x35, 0xe6, 0x78, 0xe0, 0xbb, 0xf5, 0x3d, 0xa8, 0x26, 0x92, 0x91,
+	0x5e, 0x84, 0xdc, 0xa3, 0x3e, 0xf6, 0x96, 0xe6, 0xf4, 0x32, 0xcc, 0x1f, 0x0d, 0x2c, 0x0b, 0x33,
+	0xb6, 0xa4, 0xf1, 0x81, 0x92, 0x70, 0x29, 0xc3, 0x07, 0xf7, 0x79, 0xb4, 0xc2, 0xf6, 0x52, 0xb6,
+	0x75, 0xf8, 0xc9, 0x68, 0x43, 0xfb, 0x74, 0xb4, 0xa1, 0xfd, 0x73, 0xb4, 0xa1, 0x7d, 0xf0, 0x64,
+	0x63, 0xee, 0xd3, 0x27, 0x1b, 0x73, 0x7f, 0x7d, 0xb2, 0x31, 0xf7, 0xfe, 0x77, 0x63, 0x55, 0x15,
+	0xcf, 0x86, 0x22, 0xe5, 0x58, 0xbe, 0xb3, 0x1d, 0xa5, 0xc6, 0x6d, 0xf9, 0x9b, 0xfc, 0xeb, 0x8f,
+	0x76, 0x41, 0x30, 0x7e, 0xfb, 0x7f, 0x03, 0x00, 0xa5, 0xca, 0xd4, 0x85, 0x16, 0x22, 0x00, 0x00,
+}
+
+func (this *MsgRequestData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRequestData)
+	if !ok {
+		that2, ok := that.(MsgRequestData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
 	}
 	if !bytes.Equal(this.Calldata, that1.Calldata) {
 		return false
@@ -1704,6 +2682,9 @@ func (this *MsgRequestData) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Sender, that1.Sender) {
 		return false
 	}
+	if this.FeeLimit != that1.FeeLimit {
+		return false
+	}
 	return true
 }
 func (this *MsgReportData) Equal(that interface{}) bool {
@@ -1744,6 +2725,80 @@ func (this *MsgReportData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgCommitReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCommitReport)
+	if !ok {
+		that2, ok := that.(MsgCommitReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if !bytes.Equal(this.CommitHash, that1.CommitHash) {
+		return false
+	}
+	if !bytes.Equal(this.Validator, that1.Validator) {
+		return false
+	}
+	if !bytes.Equal(this.Reporter, that1.Reporter) {
+		return false
+	}
+	return true
+}
+func (this *MsgRevealReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevealReport)
+	if !ok {
+		that2, ok := that.(MsgRevealReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if len(this.RawReports) != len(that1.RawReports) {
+		return false
+	}
+	for i := range this.RawReports {
+		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Salt, that1.Salt) {
+		return false
+	}
+	if !bytes.Equal(this.Validator, that1.Validator) {
+		return false
+	}
+	if !bytes.Equal(this.Reporter, that1.Reporter) {
+		return false
+	}
+	return true
+}
 func (this *MsgCreateDataSource) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1778,6 +2833,9 @@ func (this *MsgCreateDataSource) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Sender, that1.Sender) {
 		return false
 	}
+	if this.Fee != that1.Fee {
+		return false
+	}
 	return true
 }
 func (this *MsgEditDataSource) Equal(that interface{}) bool {
@@ -1817,6 +2875,9 @@ func (this *MsgEditDataSource) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Sender, that1.Sender) {
 		return false
 	}
+	if this.Fee != that1.Fee {
+		return false
+	}
 	return true
 }
 func (this *MsgCreateOracleScript) Equal(that interface{}) bool {
@@ -1984,14 +3045,14 @@ func (this *MsgRemoveReporter) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DataSource) Equal(that interface{}) bool {
+func (this *MsgCreateStandingRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataSource)
+	that1, ok := that.(*MsgCreateStandingRequest)
 	if !ok {
-		that2, ok := that.(DataSource)
+		that2, ok := that.(MsgCreateStandingRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2003,28 +3064,70 @@ func (this *DataSource) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Owner, that1.Owner) {
+	if this.OracleScriptID != that1.OracleScriptID {
 		return false
 	}
-	if this.Name != that1.Name {
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
 		return false
 	}
-	if this.Description != that1.Description {
+	if this.AskCount != that1.AskCount {
 		return false
 	}
-	if this.Filename != that1.Filename {
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.Budget != that1.Budget {
+		return false
+	}
+	if this.FeeLimit != that1.FeeLimit {
+		return false
+	}
+	if !bytes.Equal(this.Sender, that1.Sender) {
 		return false
 	}
 	return true
 }
-func (this *OracleScript) Equal(that interface{}) bool {
+func (this *MsgCancelStandingRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OracleScript)
+	that1, ok := that.(*MsgCancelStandingRequest)
 	if !ok {
-		that2, ok := that.(OracleScript)
+		that2, ok := that.(MsgCancelStandingRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StandingRequestID != that1.StandingRequestID {
+		return false
+	}
+	if !bytes.Equal(this.Sender, that1.Sender) {
+		return false
+	}
+	return true
+}
+func (this *DataSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSource)
+	if !ok {
+		that2, ok := that.(DataSource)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2048,22 +3151,19 @@ func (this *OracleScript) Equal(that interface{}) bool {
 	if this.Filename != that1.Filename {
 		return false
 	}
-	if this.Schema != that1.Schema {
-		return false
-	}
-	if this.SourceCodeURL != that1.SourceCodeURL {
+	if this.Fee != that1.Fee {
 		return false
 	}
 	return true
 }
-func (this *RawRequest) Equal(that interface{}) bool {
+func (this *DataSourceVersion) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RawRequest)
+	that1, ok := that.(*DataSourceVersion)
 	if !ok {
-		that2, ok := that.(RawRequest)
+		that2, ok := that.(DataSourceVersion)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2075,25 +3175,25 @@ func (this *RawRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ExternalID != that1.ExternalID {
+	if this.Filename != that1.Filename {
 		return false
 	}
-	if this.DataSourceID != that1.DataSourceID {
+	if !bytes.Equal(this.Editor, that1.Editor) {
 		return false
 	}
-	if !bytes.Equal(this.Calldata, that1.Calldata) {
+	if this.Height != that1.Height {
 		return false
 	}
 	return true
 }
-func (this *RawReport) Equal(that interface{}) bool {
+func (this *OracleScript) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RawReport)
+	that1, ok := that.(*OracleScript)
 	if !ok {
-		that2, ok := that.(RawReport)
+		that2, ok := that.(OracleScript)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2105,25 +3205,34 @@ func (this *RawReport) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ExternalID != that1.ExternalID {
+	if !bytes.Equal(this.Owner, that1.Owner) {
 		return false
 	}
-	if this.ExitCode != that1.ExitCode {
+	if this.Name != that1.Name {
 		return false
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	if this.SourceCodeURL != that1.SourceCodeURL {
 		return false
 	}
 	return true
 }
-func (this *Request) Equal(that interface{}) bool {
+func (this *OracleScriptVersion) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Request)
+	that1, ok := that.(*OracleScriptVersion)
 	if !ok {
-		that2, ok := that.(Request)
+		that2, ok := that.(OracleScriptVersion)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2135,10 +3244,106 @@ func (this *Request) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.OracleScriptID != that1.OracleScriptID {
+	if this.Filename != that1.Filename {
 		return false
 	}
-	if !bytes.Equal(this.Calldata, that1.Calldata) {
+	if this.Schema != that1.Schema {
+		return false
+	}
+	if !bytes.Equal(this.Editor, that1.Editor) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *RawRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RawRequest)
+	if !ok {
+		that2, ok := that.(RawRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ExternalID != that1.ExternalID {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.DataSourceVersion != that1.DataSourceVersion {
+		return false
+	}
+	return true
+}
+func (this *RawReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RawReport)
+	if !ok {
+		that2, ok := that.(RawReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ExternalID != that1.ExternalID {
+		return false
+	}
+	if this.ExitCode != that1.ExitCode {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *Request) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request)
+	if !ok {
+		that2, ok := that.(Request)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
 		return false
 	}
 	if len(this.RequestedValidators) != len(that1.RequestedValidators) {
@@ -2169,6 +3374,60 @@ func (this *Request) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
+	return true
+}
+func (this *StandingRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StandingRequest)
+	if !ok {
+		that2, ok := that.(StandingRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.FeeLimit != that1.FeeLimit {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.FailureCount != that1.FailureCount {
+		return false
+	}
 	return true
 }
 func (this *Report) Equal(that interface{}) bool {
@@ -2206,6 +3465,33 @@ func (this *Report) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ReportCommit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportCommit)
+	if !ok {
+		that2, ok := that.(ReportCommit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Validator, that1.Validator) {
+		return false
+	}
+	if !bytes.Equal(this.CommitHash, that1.CommitHash) {
+		return false
+	}
+	return true
+}
 func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2311,6 +3597,60 @@ func (this *ValidatorStatus) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ReportInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportInfo)
+	if !ok {
+		that2, ok := that.(ReportInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IndexOffset != that1.IndexOffset {
+		return false
+	}
+	if this.MissedReportsCounter != that1.MissedReportsCounter {
+		return false
+	}
+	return true
+}
+func (this *ValidatorReportStat) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorReportStat)
+	if !ok {
+		that2, ok := that.(ValidatorReportStat)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ReportCount != that1.ReportCount {
+		return false
+	}
+	if this.InBeforeResolveCount != that1.InBeforeResolveCount {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2354,6 +3694,51 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.InactivePenaltyDuration != that1.InactivePenaltyDuration {
 		return false
 	}
+	if this.RequestRetentionBlockCount != that1.RequestRetentionBlockCount {
+		return false
+	}
+	if this.MaxPruneCountPerBlock != that1.MaxPruneCountPerBlock {
+		return false
+	}
+	if this.PruneResult != that1.PruneResult {
+		return false
+	}
+	if this.CommitBlockCount != that1.CommitBlockCount {
+		return false
+	}
+	if this.ReportWindow != that1.ReportWindow {
+		return false
+	}
+	if this.MinReportPercentage != that1.MinReportPercentage {
+		return false
+	}
+	if this.MissedReportSlashPercentage != that1.MissedReportSlashPercentage {
+		return false
+	}
+	if this.RewardWeightingMode != that1.RewardWeightingMode {
+		return false
+	}
+	if this.InBeforeResolveBonusPercentage != that1.InBeforeResolveBonusPercentage {
+		return false
+	}
+	if this.StandingRequestFee != that1.StandingRequestFee {
+		return false
+	}
+	if this.MinStandingRequestInterval != that1.MinStandingRequestInterval {
+		return false
+	}
+	if this.MaxStandingRequestsPerBlock != that1.MaxStandingRequestsPerBlock {
+		return false
+	}
+	if this.MaxStandingRequestFailures != that1.MaxStandingRequestFailures {
+		return false
+	}
+	if this.MissedReportJailDuration != that1.MissedReportJailDuration {
+		return false
+	}
+	if this.RewardPeriod != that1.RewardPeriod {
+		return false
+	}
 	return true
 }
 func (m *MsgRequestData) Marshal() (dAtA []byte, err error) {
@@ -2376,6 +3761,13 @@ func (m *MsgRequestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeLimit) > 0 {
+		i -= len(m.FeeLimit)
+		copy(dAtA[i:], m.FeeLimit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FeeLimit)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -2471,7 +3863,7 @@ func (m *MsgReportData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDataSource) Marshal() (dAtA []byte, err error) {
+func (m *MsgCommitReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2481,27 +3873,146 @@ func (m *MsgCreateDataSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDataSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCommitReport) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCommitReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reporter)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
-	if len(m.Executable) > 0 {
-		i -= len(m.Executable)
-		copy(dAtA[i:], m.Executable)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Executable)))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CommitHash) > 0 {
+		i -= len(m.CommitHash)
+		copy(dAtA[i:], m.CommitHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CommitHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RawReports) > 0 {
+		for iNdEx := len(m.RawReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDataSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDataSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Executable) > 0 {
+		i -= len(m.Executable)
+		copy(dAtA[i:], m.Executable)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Executable)))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -2549,6 +4060,13 @@ func (m *MsgEditDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -2845,6 +4363,119 @@ func (m *MsgRemoveReporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateStandingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStandingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStandingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.FeeLimit) > 0 {
+		i -= len(m.FeeLimit)
+		copy(dAtA[i:], m.FeeLimit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FeeLimit)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Budget) > 0 {
+		i -= len(m.Budget)
+		copy(dAtA[i:], m.Budget)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Budget)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStandingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStandingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStandingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StandingRequestID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StandingRequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DataSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2865,6 +4496,13 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Filename) > 0 {
 		i -= len(m.Filename)
 		copy(dAtA[i:], m.Filename)
@@ -2896,6 +4534,48 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DataSourceVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSourceVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSourceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OracleScript) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2961,7 +4641,7 @@ func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RawRequest) Marshal() (dAtA []byte, err error) {
+func (m *OracleScriptVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2971,30 +4651,84 @@ func (m *RawRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RawRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleScriptVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleScriptVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
-		copy(dAtA[i:], m.Calldata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Calldata)))
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
-	if m.DataSourceID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.DataSourceID))
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Editor)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.ExternalID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExternalID))
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataSourceVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DataSourceVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExternalID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExternalID))
 		i--
 		dAtA[i] = 0x8
 	}
@@ -3061,6 +4795,11 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.RawRequests) > 0 {
 		for iNdEx := len(m.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3124,6 +4863,87 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StandingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StandingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StandingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailureCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.FeeLimit) > 0 {
+		i -= len(m.FeeLimit)
+		copy(dAtA[i:], m.FeeLimit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FeeLimit)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Report) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3178,6 +4998,43 @@ func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReportCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitHash) > 0 {
+		i -= len(m.CommitHash)
+		copy(dAtA[i:], m.CommitHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CommitHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OracleRequestPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3333,6 +5190,72 @@ func (m *ValidatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReportInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedReportsCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedReportsCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorReportStat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReportStat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReportStat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InBeforeResolveCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InBeforeResolveCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReportCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReportCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3353,6 +5276,102 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RewardPeriod != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RewardPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MissedReportJailDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedReportJailDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxStandingRequestFailures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxStandingRequestFailures))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MaxStandingRequestsPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxStandingRequestsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MinStandingRequestInterval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinStandingRequestInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.StandingRequestFee != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StandingRequestFee))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.InBeforeResolveBonusPercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InBeforeResolveBonusPercentage))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.RewardWeightingMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RewardWeightingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MissedReportSlashPercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedReportSlashPercentage))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MinReportPercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinReportPercentage))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ReportWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReportWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CommitBlockCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitBlockCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PruneResult {
+		i--
+		if m.PruneResult {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxPruneCountPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPruneCountPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RequestRetentionBlockCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestRetentionBlockCount))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.InactivePenaltyDuration != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.InactivePenaltyDuration))
 		i--
@@ -3434,6 +5453,10 @@ func (m *MsgRequestData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.FeeLimit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3463,17 +5486,71 @@ func (m *MsgReportData) Size() (n int) {
 	return n
 }
 
-func (m *MsgCreateDataSource) Size() (n int) {
+func (m *MsgCommitReport) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Owner)
+	if m.RequestID != 0 {
+		n += 1 + sovTypes(uint64(m.RequestID))
+	}
+	l = len(m.CommitHash)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Name)
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTypes(uint64(m.RequestID))
+	}
+	if len(m.RawReports) > 0 {
+		for _, e := range m.RawReports {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDataSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
@@ -3489,6 +5566,10 @@ func (m *MsgCreateDataSource) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3521,6 +5602,10 @@ func (m *MsgEditDataSource) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3648,6 +5733,63 @@ func (m *MsgRemoveReporter) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateStandingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTypes(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovTypes(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovTypes(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	l = len(m.Budget)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.FeeLimit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelStandingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StandingRequestID != 0 {
+		n += 1 + sovTypes(uint64(m.StandingRequestID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DataSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3670,6 +5812,30 @@ func (m *DataSource) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DataSourceVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
 	return n
 }
 
@@ -3706,6 +5872,30 @@ func (m *OracleScript) Size() (n int) {
 	return n
 }
 
+func (m *OracleScriptVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *RawRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3722,6 +5912,9 @@ func (m *RawRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.DataSourceVersion != 0 {
+		n += 1 + sovTypes(uint64(m.DataSourceVersion))
+	}
 	return n
 }
 
@@ -3781,6 +5974,52 @@ func (m *Request) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovTypes(uint64(m.OracleScriptVersion))
+	}
+	return n
+}
+
+func (m *StandingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTypes(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovTypes(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovTypes(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	l = len(m.FeeLimit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextHeight))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovTypes(uint64(m.FailureCount))
+	}
 	return n
 }
 
@@ -3806,6 +6045,23 @@ func (m *Report) Size() (n int) {
 	return n
 }
 
+func (m *ReportCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CommitHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *OracleRequestPacketData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3878,6 +6134,36 @@ func (m *ValidatorStatus) Size() (n int) {
 	return n
 }
 
+func (m *ReportInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexOffset != 0 {
+		n += 1 + sovTypes(uint64(m.IndexOffset))
+	}
+	if m.MissedReportsCounter != 0 {
+		n += 1 + sovTypes(uint64(m.MissedReportsCounter))
+	}
+	return n
+}
+
+func (m *ValidatorReportStat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReportCount != 0 {
+		n += 1 + sovTypes(uint64(m.ReportCount))
+	}
+	if m.InBeforeResolveCount != 0 {
+		n += 1 + sovTypes(uint64(m.InBeforeResolveCount))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3908,6 +6194,51 @@ func (m *Params) Size() (n int) {
 	if m.InactivePenaltyDuration != 0 {
 		n += 1 + sovTypes(uint64(m.InactivePenaltyDuration))
 	}
+	if m.RequestRetentionBlockCount != 0 {
+		n += 1 + sovTypes(uint64(m.RequestRetentionBlockCount))
+	}
+	if m.MaxPruneCountPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPruneCountPerBlock))
+	}
+	if m.PruneResult {
+		n += 2
+	}
+	if m.CommitBlockCount != 0 {
+		n += 1 + sovTypes(uint64(m.CommitBlockCount))
+	}
+	if m.ReportWindow != 0 {
+		n += 1 + sovTypes(uint64(m.ReportWindow))
+	}
+	if m.MinReportPercentage != 0 {
+		n += 1 + sovTypes(uint64(m.MinReportPercentage))
+	}
+	if m.MissedReportSlashPercentage != 0 {
+		n += 1 + sovTypes(uint64(m.MissedReportSlashPercentage))
+	}
+	if m.RewardWeightingMode != 0 {
+		n += 2 + sovTypes(uint64(m.RewardWeightingMode))
+	}
+	if m.InBeforeResolveBonusPercentage != 0 {
+		n += 2 + sovTypes(uint64(m.InBeforeResolveBonusPercentage))
+	}
+	if m.StandingRequestFee != 0 {
+		n += 2 + sovTypes(uint64(m.StandingRequestFee))
+	}
+	if m.MinStandingRequestInterval != 0 {
+		n += 2 + sovTypes(uint64(m.MinStandingRequestInterval))
+	}
+	if m.MaxStandingRequestsPerBlock != 0 {
+		n += 2 + sovTypes(uint64(m.MaxStandingRequestsPerBlock))
+	}
+	if m.MaxStandingRequestFailures != 0 {
+		n += 2 + sovTypes(uint64(m.MaxStandingRequestFailures))
+	}
+	if m.MissedReportJailDuration != 0 {
+		n += 2 + sovTypes(uint64(m.MissedReportJailDuration))
+	}
+	if m.RewardPeriod != 0 {
+		n += 2 + sovTypes(uint64(m.RewardPeriod))
+	}
 	return n
 }
 
@@ -4103,16 +6434,48 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				m.Sender = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if (iNdEx + skippy) > l {
@@ -4301,7 +6664,7 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4324,17 +6687,17 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			var byteLen int
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4344,31 +6707,16 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4378,59 +6726,29 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CommitHash = append(m.CommitHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommitHash == nil {
+				m.CommitHash = []byte{}
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4457,14 +6775,14 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
-			if m.Executable == nil {
-				m.Executable = []byte{}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4491,9 +6809,9 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4520,7 +6838,7 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4543,17 +6861,17 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.DataSourceID = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4563,16 +6881,16 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4582,31 +6900,31 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			m.RawReports = append(m.RawReports, RawReport{})
+			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4616,59 +6934,29 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4695,14 +6983,14 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
-			if m.Executable == nil {
-				m.Executable = []byte{}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4729,9 +7017,9 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4758,7 +7046,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4781,10 +7069,10 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4887,7 +7175,7 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4914,16 +7202,16 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
+			if m.Executable == nil {
+				m.Executable = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4933,27 +7221,29 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4981,52 +7271,18 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if (iNdEx + skippy) > l {
@@ -5041,7 +7297,7 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
+func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5064,17 +7320,17 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			m.OracleScriptID = 0
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5084,7 +7340,7 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5189,7 +7445,7 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5216,16 +7472,16 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
+			if m.Executable == nil {
+				m.Executable = []byte{}
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5235,27 +7491,29 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5283,41 +7541,7 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
-			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5343,7 +7567,7 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5366,15 +7590,15 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5401,69 +7625,16 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Validator == nil {
-				m.Validator = []byte{}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddReporter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddReporter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddReporter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5473,31 +7644,29 @@ func (m *MsgAddReporter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Validator == nil {
-				m.Validator = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5507,82 +7676,27 @@ func (m *MsgAddReporter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Reporter == nil {
-				m.Reporter = []byte{}
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveReporter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveReporter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveReporter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5609,135 +7723,14 @@ func (m *MsgRemoveReporter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Validator == nil {
-				m.Validator = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Reporter == nil {
-				m.Reporter = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DataSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5765,11 +7758,11 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5797,13 +7790,13 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5813,23 +7806,25 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5855,7 +7850,7 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleScript) Unmarshal(dAtA []byte) error {
+func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5878,13 +7873,32 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -5918,7 +7932,7 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -5950,7 +7964,7 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -5982,11 +7996,11 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5996,25 +8010,27 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
@@ -6046,7 +8062,7 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
 			}
@@ -6078,6 +8094,40 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -6102,7 +8152,7 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6125,17 +8175,17 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.ExternalID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6145,16 +8195,84 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.DataSourceID = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddReporter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddReporter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddReporter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6164,14 +8282,29 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6198,9 +8331,9 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6227,7 +8360,7 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawReport) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveReporter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6250,17 +8383,17 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveReporter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveReporter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.ExternalID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6270,33 +8403,29 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.ExitCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExitCode |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6323,9 +8452,9 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6352,7 +8481,7 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateStandingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6375,10 +8504,10 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateStandingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateStandingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6435,10 +8564,48 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6448,29 +8615,29 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedValidators = append(m.RequestedValidators, make([]byte, postIndex-iNdEx))
-			copy(m.RequestedValidators[len(m.RequestedValidators)-1], dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.MinCount = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6480,16 +8647,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
 			}
-			m.RequestHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6499,16 +8666,29 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6518,30 +8698,1888 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RequestTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FeeLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelStandingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelStandingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelStandingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandingRequestID", wireType)
+			}
+			m.StandingRequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StandingRequestID |= StandingRequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSourceVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSourceVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSourceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = append(m.Editor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Editor == nil {
+				m.Editor = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleScriptVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleScriptVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleScriptVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = append(m.Editor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Editor == nil {
+				m.Editor = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			}
+			m.ExternalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalID |= ExternalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
+			}
+			m.DataSourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			}
+			m.ExternalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalID |= ExternalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedValidators = append(m.RequestedValidators, make([]byte, postIndex-iNdEx))
+			copy(m.RequestedValidators[len(m.RequestedValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			}
+			m.RequestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RequestTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawRequests = append(m.RawRequests, RawRequest{})
+			if err := m.RawRequests[len(m.RawRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+			}
+			m.OracleScriptVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StandingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StandingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StandingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+			}
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Report) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6551,27 +10589,15 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			m.InBeforeResolve = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6598,8 +10624,8 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawRequests = append(m.RawRequests, RawRequest{})
-			if err := m.RawRequests[len(m.RawRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RawReports = append(m.RawReports, RawReport{})
+			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6627,7 +10653,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Report) Unmarshal(dAtA []byte) error {
+func (m *ReportCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6650,10 +10676,10 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6691,30 +10717,10 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InBeforeResolve = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6724,24 +10730,24 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawReports = append(m.RawReports, RawReport{})
-			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CommitHash = append(m.CommitHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommitHash == nil {
+				m.CommitHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -7133,7 +11139,204 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 			if m.Result == nil {
 				m.Result = []byte{}
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedReportsCounter", wireType)
+			}
+			m.MissedReportsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedReportsCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -7158,7 +11361,7 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
+func (m *ValidatorReportStat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7181,17 +11384,17 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorReportStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorReportStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
 			}
-			var v int
+			m.ReportCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7201,17 +11404,16 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ReportCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolveCount", wireType)
 			}
-			var msglen int
+			m.InBeforeResolveCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7221,25 +11423,11 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InBeforeResolveCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -7445,6 +11633,292 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestRetentionBlockCount", wireType)
+			}
+			m.RequestRetentionBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestRetentionBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPruneCountPerBlock", wireType)
+			}
+			m.MaxPruneCountPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPruneCountPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneResult", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PruneResult = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitBlockCount", wireType)
+			}
+			m.CommitBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportWindow", wireType)
+			}
+			m.ReportWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReportPercentage", wireType)
+			}
+			m.MinReportPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReportPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedReportSlashPercentage", wireType)
+			}
+			m.MissedReportSlashPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedReportSlashPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightingMode", wireType)
+			}
+			m.RewardWeightingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightingMode |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolveBonusPercentage", wireType)
+			}
+			m.InBeforeResolveBonusPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InBeforeResolveBonusPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandingRequestFee", wireType)
+			}
+			m.StandingRequestFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StandingRequestFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStandingRequestInterval", wireType)
+			}
+			m.MinStandingRequestInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStandingRequestInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStandingRequestsPerBlock", wireType)
+			}
+			m.MaxStandingRequestsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStandingRequestsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStandingRequestFailures", wireType)
+			}
+			m.MaxStandingRequestFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStandingRequestFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedReportJailDuration", wireType)
+			}
+			m.MissedReportJailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedReportJailDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriod", wireType)
+			}
+			m.RewardPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])